@@ -143,7 +143,7 @@ func NewKeyStoreTransactorWithChainID(keystore *keystore.KeyStore, account accou
 			}
 			// Quorum
 			signer := latestSigner
-			if tx.IsPrivate() {
+			if tx.IsPrivate() && tx.Type() == types.LegacyTxType {
 				signer = types.QuorumPrivateTxSigner{}
 			}
 			// / Quorum
@@ -172,7 +172,7 @@ func NewKeyedTransactorWithChainID(key *ecdsa.PrivateKey, chainID *big.Int) (*Tr
 			}
 			// Quorum
 			signer := latestSigner
-			if tx.IsPrivate() {
+			if tx.IsPrivate() && tx.Type() == types.LegacyTxType {
 				signer = types.QuorumPrivateTxSigner{}
 			}
 			// / Quorum
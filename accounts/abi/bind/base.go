@@ -29,6 +29,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/private/engine"
 )
 
 // SignerFn is a signer function callback when a contract requires a method to
@@ -39,7 +40,8 @@ type SignerFn func(common.Address, *types.Transaction) (*types.Transaction, erro
 //
 // Additional arguments in order to support transaction privacy
 type PrivateTxArgs struct {
-	PrivateFor []string `json:"privateFor"`
+	PrivateFor  []string               `json:"privateFor"`
+	PrivacyFlag engine.PrivacyFlagType `json:"privacyFlag,omitempty"`
 }
 
 // CallOpts is the collection of options to fine tune a contract call request.
@@ -69,6 +71,8 @@ type TransactOpts struct {
 	PrivateFrom              string   // The public key of the Tessera/Constellation identity to send this tx from.
 	PrivateFor               []string // The public keys of the Tessera/Constellation identities this tx is intended for.
 	IsUsingPrivacyPrecompile bool
+	UsePrivateTxType         bool                   // Send private transactions as EIP-2718 typed private transactions, the chain ID is set by the Signer
+	PrivacyFlag              engine.PrivacyFlagType // Privacy flag of the private transaction, also signed in typed private transactions
 }
 
 // FilterOpts is the collection of options to fine tune filtering for events
@@ -279,10 +283,14 @@ func (c *BoundContract) transact(opts *TransactOpts, contract *common.Address, i
 			return nil, err
 		}
 		payload = hash.Bytes()
-		rawTx = c.createPrivateTransaction(rawTx, payload)
+		if opts.UsePrivateTxType {
+			rawTx = c.createTypedPrivateTransaction(rawTx, payload, opts.PrivacyFlag)
+		} else {
+			rawTx = c.createPrivateTransaction(rawTx, payload)
+		}
 
 		if opts.IsUsingPrivacyPrecompile {
-			rawTx, _ = c.createMarkerTx(opts, rawTx, PrivateTxArgs{PrivateFor: opts.PrivateFor})
+			rawTx, _ = c.createMarkerTx(opts, rawTx, c.privateTxArgs(opts, rawTx))
 			opts.PrivateFor = nil
 		}
 	}
@@ -298,7 +306,7 @@ func (c *BoundContract) transact(opts *TransactOpts, contract *common.Address, i
 	if opts.NoSend {
 		return signedTx, nil
 	}
-	if err := c.transactor.SendTransaction(ensureContext(opts.Context), signedTx, PrivateTxArgs{PrivateFor: opts.PrivateFor}); err != nil {
+	if err := c.transactor.SendTransaction(ensureContext(opts.Context), signedTx, c.privateTxArgs(opts, signedTx)); err != nil {
 		return nil, err
 	}
 	return signedTx, nil
@@ -429,6 +437,31 @@ func (c *BoundContract) createPrivateTransaction(tx *types.Transaction, payload
 	return privateTx
 }
 
+// (Quorum) createTypedPrivateTransaction replaces the payload of private transaction to the hash from Tessera/Constellation
+// and signs the privacy flag along with it. The chain ID is left for the Signer to set
+func (c *BoundContract) createTypedPrivateTransaction(tx *types.Transaction, payload []byte, privacyFlag engine.PrivacyFlagType) *types.Transaction {
+	return types.NewTx(&types.PrivateTx{
+		Nonce:       tx.Nonce(),
+		GasPrice:    tx.GasPrice(),
+		Gas:         tx.Gas(),
+		To:          tx.To(),
+		Value:       tx.Value(),
+		Data:        payload,
+		PrivacyFlag: privacyFlag,
+		AccessList:  tx.AccessList(),
+	})
+}
+
+// (Quorum) privateTxArgs returns the arguments sent along with the private transaction. The privacy flag
+// must match the one signed in typed private transactions
+func (c *BoundContract) privateTxArgs(opts *TransactOpts, tx *types.Transaction) PrivateTxArgs {
+	args := PrivateTxArgs{PrivateFor: opts.PrivateFor, PrivacyFlag: opts.PrivacyFlag}
+	if tx.Type() == types.PrivateTxType {
+		args.PrivacyFlag = tx.PrivacyFlag()
+	}
+	return args
+}
+
 // (Quorum) createMarkerTx creates a new public privacy marker transaction for the given private tx, distributing tx to the specified privateFor recipients
 func (c *BoundContract) createMarkerTx(opts *TransactOpts, tx *types.Transaction, args PrivateTxArgs) (*types.Transaction, error) {
 	// Choose signer to sign transaction
@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/private/engine"
 	"github.com/stretchr/testify/require"
 )
 
@@ -191,6 +192,34 @@ func TestBoundContract_Transact_Transaction_PrivacyPrecompile(t *testing.T) {
 	require.Equal(t, []string{"tm1"}, pvtTxArgs.PrivateFor)
 }
 
+func TestBoundContract_Transact_MessageCall_TypedPrivateTransaction(t *testing.T) {
+	transactor := &mockTransactor{}
+	contractAddr := common.HexToAddress("1234567890abcdef1234567890abcdef12345678")
+	c := NewBoundContract(contractAddr, abi.ABI{}, nil, transactor, nil)
+	key, _ := crypto.GenerateKey()
+	opts, err := NewKeyedTransactorWithChainID(key, big.NewInt(10))
+	require.NoError(t, err)
+	opts.Nonce = big.NewInt(1)
+	opts.PrivateFor = []string{"tm1"}
+	opts.UsePrivateTxType = true
+	opts.PrivacyFlag = engine.PrivacyFlagStateValidation
+	// arbitrary values to skip the logic we're not testing
+	opts.GasPrice = big.NewInt(0)
+	opts.GasLimit = uint64(1)
+
+	tx, err := c.transact(opts, &contractAddr, nil)
+
+	require.NoError(t, err)
+	require.Equal(t, uint8(types.PrivateTxType), tx.Type())
+	require.Equal(t, tmPrivatePayloadHash.Bytes(), tx.Data())
+	require.Equal(t, engine.PrivacyFlagStateValidation, tx.PrivacyFlag())
+	require.Equal(t, big.NewInt(10), tx.ChainId())
+	from, err := types.Sender(types.NewEIP2930Signer(big.NewInt(10)), tx)
+	require.NoError(t, err)
+	require.Equal(t, opts.From, from)
+	require.Equal(t, PrivateTxArgs{PrivateFor: []string{"tm1"}, PrivacyFlag: engine.PrivacyFlagStateValidation}, transactor.capturedSendTransactionArgs)
+}
+
 func passthroughSigner(_ common.Address, tx *types.Transaction) (*types.Transaction, error) {
 	return tx, nil
 }
@@ -198,6 +227,7 @@ func passthroughSigner(_ common.Address, tx *types.Transaction) (*types.Transact
 type mockTransactor struct {
 	capturedInternalPrivateTransaction     *types.Transaction
 	capturedInternalPrivateTransactionArgs PrivateTxArgs
+	capturedSendTransactionArgs            PrivateTxArgs
 }
 
 func (s *mockTransactor) PreparePrivateTransaction(_ []byte, _ string) (common.EncryptedPayloadHash, error) {
@@ -210,7 +240,8 @@ func (s *mockTransactor) DistributeTransaction(_ context.Context, tx *types.Tran
 	return tmPrivateTxHash.Hex(), nil
 }
 
-func (s *mockTransactor) SendTransaction(_ context.Context, _ *types.Transaction, _ PrivateTxArgs) error {
+func (s *mockTransactor) SendTransaction(_ context.Context, _ *types.Transaction, args PrivateTxArgs) error {
+	s.capturedSendTransactionArgs = args
 	return nil
 }
 
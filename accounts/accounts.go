@@ -25,6 +25,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/private/engine"
 	"golang.org/x/crypto/sha3"
 )
 
@@ -153,6 +154,40 @@ type Wallet interface {
 	SignTxWithPassphrase(account Account, passphrase string, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
 }
 
+// Quorum
+
+// PrivateTxInfo describes the privacy of a private transaction, or of the private
+// transaction wrapped by a privacy marker transaction
+type PrivateTxInfo struct {
+	PrivateFrom         string
+	PrivateFor          []string
+	PrivacyFlag         engine.PrivacyFlagType
+	MandatoryRecipients []string
+}
+
+// PrivateTxWallet is implemented by wallets which sign private transactions and privacy
+// marker transactions given their privacy details, e.g. to apply signing policies.
+type PrivateTxWallet interface {
+	// SignPrivateTx is identical to Wallet.SignTx, but also takes the privacy details of tx.
+	SignPrivateTx(account Account, tx *types.Transaction, chainID *big.Int, info *PrivateTxInfo) (*types.Transaction, error)
+
+	// SignPrivateTxWithPassphrase is identical to SignPrivateTx, but also takes a password
+	SignPrivateTxWithPassphrase(account Account, passphrase string, tx *types.Transaction, chainID *big.Int, info *PrivateTxInfo) (*types.Transaction, error)
+}
+
+// TypedDataWallet is implemented by wallets which sign EIP-712 typed data given its
+// content rather than its hash only.
+type TypedDataWallet interface {
+	// SignTypedData signs keccak256(rawData), where typedData is the JSON encoded EIP-712
+	// typed data and rawData its preimage 0x19 0x01 || domainSeparator || hashStruct(message).
+	SignTypedData(account Account, typedData []byte, rawData []byte) ([]byte, error)
+
+	// SignTypedDataWithPassphrase is identical to SignTypedData, but also takes a password
+	SignTypedDataWithPassphrase(account Account, passphrase string, typedData []byte, rawData []byte) ([]byte, error)
+}
+
+// End Quorum
+
 // Backend is a "wallet provider" that may contain a batch of accounts they can
 // sign transactions with and upon request, do so.
 type Backend interface {
@@ -192,6 +192,13 @@ type signTransactionResult struct {
 }
 
 func (api *ExternalSigner) SignTx(account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return api.SignPrivateTx(account, tx, chainID, nil)
+}
+
+// SignPrivateTx implements accounts.PrivateTxWallet, giving the privacy details of a private
+// transaction, or of the private transaction wrapped by a privacy marker transaction, to the
+// external signer so they can be used by its approval rules
+func (api *ExternalSigner) SignPrivateTx(account accounts.Account, tx *types.Transaction, chainID *big.Int, info *accounts.PrivateTxInfo) (*types.Transaction, error) {
 	data := hexutil.Bytes(tx.Data())
 	var to *common.MixedcaseAddress
 	if tx.To() != nil {
@@ -209,6 +216,17 @@ func (api *ExternalSigner) SignTx(account accounts.Account, tx *types.Transactio
 		// Quorum
 		IsPrivate: tx.IsPrivate(),
 	}
+	if info != nil {
+		args.PrivateFrom = info.PrivateFrom
+		args.PrivateFor = info.PrivateFor
+		args.PrivacyFlag = info.PrivacyFlag
+		args.MandatoryFor = info.MandatoryRecipients
+	}
+	// the privacy flag of typed private transactions is signed so it can't differ from the one given
+	if tx.Type() == types.PrivateTxType {
+		args.IsTypedPrivate = true
+		args.PrivacyFlag = tx.PrivacyFlag()
+	}
 	// We should request the default chain id that we're operating with
 	// (the chain we're executing on)
 	if chainID != nil {
@@ -219,7 +237,7 @@ func (api *ExternalSigner) SignTx(account accounts.Account, tx *types.Transactio
 	if tx.Type() != types.LegacyTxType && tx.ChainId() != nil {
 		args.ChainID = (*hexutil.Big)(tx.ChainId())
 	}
-	if tx.Type() == types.AccessListTxType {
+	if tx.Type() == types.AccessListTxType || tx.Type() == types.PrivateTxType {
 		accessList := tx.AccessList()
 		args.AccessList = &accessList
 	}
@@ -237,6 +255,10 @@ func (api *ExternalSigner) SignTextWithPassphrase(account accounts.Account, pass
 func (api *ExternalSigner) SignTxWithPassphrase(account accounts.Account, passphrase string, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
 	return nil, fmt.Errorf("password-operations not supported on external signers")
 }
+
+func (api *ExternalSigner) SignPrivateTxWithPassphrase(account accounts.Account, passphrase string, tx *types.Transaction, chainID *big.Int, info *accounts.PrivateTxInfo) (*types.Transaction, error) {
+	return nil, fmt.Errorf("password-operations not supported on external signers")
+}
 func (api *ExternalSigner) SignDataWithPassphrase(account accounts.Account, passphrase, mimeType string, data []byte) ([]byte, error) {
 	return nil, fmt.Errorf("password-operations not supported on external signers")
 }
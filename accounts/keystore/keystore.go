@@ -286,7 +286,7 @@ func (ks *KeyStore) SignTx(a accounts.Account, tx *types.Transaction, chainID *b
 	}
 
 	// start quorum specific
-	if tx.IsPrivate() {
+	if tx.IsPrivate() && tx.Type() == types.LegacyTxType {
 		log.Info("Private transaction signing with QuorumPrivateTxSigner")
 		return types.SignTx(tx, types.QuorumPrivateTxSigner{}, unlockedKey.PrivateKey)
 	} // End quorum specific
@@ -316,7 +316,7 @@ func (ks *KeyStore) SignTxWithPassphrase(a accounts.Account, passphrase string,
 		return nil, err
 	}
 	defer zeroKey(key.PrivateKey)
-	if tx.IsPrivate() {
+	if tx.IsPrivate() && tx.Type() == types.LegacyTxType {
 		return types.SignTx(tx, types.QuorumPrivateTxSigner{}, key.PrivateKey)
 	}
 	// Depending on the presence of the chain ID, sign with or without replay protection.
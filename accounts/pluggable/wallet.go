@@ -79,9 +79,27 @@ func (w *wallet) SignTextWithPassphrase(account accounts.Account, passphrase str
 }
 
 func (w *wallet) SignTx(account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return w.SignPrivateTx(account, tx, chainID, nil)
+}
+
+func (w *wallet) SignTxWithPassphrase(account accounts.Account, passphrase string, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return w.SignPrivateTxWithPassphrase(account, passphrase, tx, chainID, nil)
+}
+
+// SignPrivateTx implements accounts.PrivateTxWallet, giving the privacy details of tx to plugins
+// implementing plugin.SigningService
+func (w *wallet) SignPrivateTx(account accounts.Account, tx *types.Transaction, chainID *big.Int, info *accounts.PrivateTxInfo) (*types.Transaction, error) {
 	toSign, signer := prepareTxForSign(tx, chainID)
 
-	sig, err := w.pluginService.Sign(context.Background(), account, toSign.Bytes())
+	var (
+		sig []byte
+		err error
+	)
+	if s, ok := w.pluginService.(plugin.SigningService); ok {
+		sig, err = s.SignTransaction(context.Background(), account, tx, chainID, info, toSign.Bytes())
+	} else {
+		sig, err = w.pluginService.Sign(context.Background(), account, toSign.Bytes())
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -89,10 +107,18 @@ func (w *wallet) SignTx(account accounts.Account, tx *types.Transaction, chainID
 	return tx.WithSignature(signer, sig)
 }
 
-func (w *wallet) SignTxWithPassphrase(account accounts.Account, passphrase string, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+func (w *wallet) SignPrivateTxWithPassphrase(account accounts.Account, passphrase string, tx *types.Transaction, chainID *big.Int, info *accounts.PrivateTxInfo) (*types.Transaction, error) {
 	toSign, signer := prepareTxForSign(tx, chainID)
 
-	sig, err := w.pluginService.UnlockAndSign(context.Background(), account, toSign.Bytes(), passphrase)
+	var (
+		sig []byte
+		err error
+	)
+	if s, ok := w.pluginService.(plugin.SigningService); ok {
+		sig, err = s.UnlockAndSignTransaction(context.Background(), account, tx, chainID, info, toSign.Bytes(), passphrase)
+	} else {
+		sig, err = w.pluginService.UnlockAndSign(context.Background(), account, toSign.Bytes(), passphrase)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -100,6 +126,22 @@ func (w *wallet) SignTxWithPassphrase(account accounts.Account, passphrase strin
 	return tx.WithSignature(signer, sig)
 }
 
+// SignTypedData implements accounts.TypedDataWallet, giving the typed data to plugins
+// implementing plugin.SigningService
+func (w *wallet) SignTypedData(account accounts.Account, typedData []byte, rawData []byte) ([]byte, error) {
+	if s, ok := w.pluginService.(plugin.SigningService); ok {
+		return s.SignTypedData(context.Background(), account, typedData, rawData)
+	}
+	return w.pluginService.Sign(context.Background(), account, crypto.Keccak256(rawData))
+}
+
+func (w *wallet) SignTypedDataWithPassphrase(account accounts.Account, passphrase string, typedData []byte, rawData []byte) ([]byte, error) {
+	if s, ok := w.pluginService.(plugin.SigningService); ok {
+		return s.UnlockAndSignTypedData(context.Background(), account, typedData, rawData, passphrase)
+	}
+	return w.pluginService.UnlockAndSign(context.Background(), account, crypto.Keccak256(rawData), passphrase)
+}
+
 func (w *wallet) timedUnlock(account accounts.Account, password string, duration time.Duration) error {
 	return w.pluginService.TimedUnlock(context.Background(), account, password, duration)
 }
@@ -120,7 +162,7 @@ func (w *wallet) importRawKey(rawKey string, newAccountConfig interface{}) (acco
 func prepareTxForSign(tx *types.Transaction, chainID *big.Int) (common.Hash, types.Signer) {
 	var s types.Signer
 
-	if tx.IsPrivate() {
+	if tx.IsPrivate() && tx.Type() == types.LegacyTxType {
 		s = types.QuorumPrivateTxSigner{}
 	} else {
 		s = types.LatestSignerForChainID(chainID)
@@ -1,6 +1,7 @@
 package pluggable
 
 import (
+	"context"
 	"math/big"
 	"math/rand"
 	"testing"
@@ -409,3 +410,95 @@ func TestWallet_SignTxWithPassphrase(t *testing.T) {
 		})
 	}
 }
+
+// signingService is a plugin service implementing plugin.SigningService
+type signingService struct {
+	*mock_plugin.MockService
+	sig          []byte
+	gotTx        *types.Transaction
+	gotInfo      *accounts.PrivateTxInfo
+	gotToSign    []byte
+	gotTypedData []byte
+	gotRawData   []byte
+}
+
+func (s *signingService) SignTransaction(_ context.Context, _ accounts.Account, tx *types.Transaction, _ *big.Int, info *accounts.PrivateTxInfo, toSign []byte) ([]byte, error) {
+	s.gotTx, s.gotInfo, s.gotToSign = tx, info, toSign
+	return s.sig, nil
+}
+
+func (s *signingService) UnlockAndSignTransaction(ctx context.Context, account accounts.Account, tx *types.Transaction, chainID *big.Int, info *accounts.PrivateTxInfo, toSign []byte, _ string) ([]byte, error) {
+	return s.SignTransaction(ctx, account, tx, chainID, info, toSign)
+}
+
+func (s *signingService) SignTypedData(_ context.Context, _ accounts.Account, typedData []byte, rawData []byte) ([]byte, error) {
+	s.gotTypedData, s.gotRawData = typedData, rawData
+	return s.sig, nil
+}
+
+func (s *signingService) UnlockAndSignTypedData(ctx context.Context, account accounts.Account, typedData []byte, rawData []byte, _ string) ([]byte, error) {
+	return s.SignTypedData(ctx, account, typedData, rawData)
+}
+
+func TestWallet_SignPrivateTx(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	toSign := types.NewTransaction(1, common.HexToAddress("0x2332f90a329c2c55ba120b1449d36a144d1f9fe4"), big.NewInt(1), 0, big.NewInt(1), nil)
+	toSign.SetPrivate()
+	info := &accounts.PrivateTxInfo{
+		PrivateFrom: "arbitrary from",
+		PrivateFor:  []string{"arbitrary for"},
+	}
+	mockSig := make([]byte, 65)
+	rand.Read(mockSig)
+	s := &signingService{MockService: mock_plugin.NewMockService(ctrl), sig: mockSig}
+	w := &wallet{url: wltUrl, pluginService: s}
+
+	got, err := w.SignPrivateTx(acct1, toSign, nil, info)
+
+	require.NoError(t, err)
+	assert.Equal(t, toSign, s.gotTx)
+	assert.Equal(t, info, s.gotInfo)
+	assert.Equal(t, types.QuorumPrivateTxSigner{}.Hash(toSign).Bytes(), s.gotToSign)
+	wantR, wantS, wantV, err := types.QuorumPrivateTxSigner{}.SignatureValues(toSign, mockSig)
+	require.NoError(t, err)
+	gotV, gotR, gotS := got.RawSignatureValues()
+	assert.Equal(t, wantV, gotV)
+	assert.Equal(t, wantR, gotR)
+	assert.Equal(t, wantS, gotS)
+}
+
+func TestWallet_SignTypedData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	typedData, rawData := []byte(`{"primaryType":"Mail"}`), []byte("\x19\x01arbitrary hashes")
+	s := &signingService{MockService: mock_plugin.NewMockService(ctrl), sig: []byte("signed data")}
+	w := &wallet{url: wltUrl, pluginService: s}
+
+	got, err := w.SignTypedDataWithPassphrase(acct1, "pwd", typedData, rawData)
+
+	require.NoError(t, err)
+	assert.Equal(t, []byte("signed data"), got)
+	assert.Equal(t, typedData, s.gotTypedData)
+	assert.Equal(t, rawData, s.gotRawData)
+}
+
+func TestWallet_SignTypedData_whenPluginOnlySignsHashes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rawData := []byte("\x19\x01arbitrary hashes")
+	mockClient := mock_plugin.NewMockService(ctrl)
+	mockClient.
+		EXPECT().
+		Sign(gomock.Any(), acct1, crypto.Keccak256(rawData)).
+		Return([]byte("signed data"), nil)
+
+	w := validWallet(mockClient)
+	got, err := w.SignTypedData(acct1, []byte(`{"primaryType":"Mail"}`), rawData)
+
+	require.NoError(t, err)
+	assert.Equal(t, []byte("signed data"), got)
+}
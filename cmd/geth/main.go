@@ -167,6 +167,7 @@ var (
 		utils.EmitCheckpointsFlag,
 		utils.IstanbulRequestTimeoutFlag,
 		utils.IstanbulBlockPeriodFlag,
+		utils.IstanbulBLSKeyFlag,
 		utils.PluginSettingsFlag,
 		utils.PluginSkipVerifyFlag,
 		utils.PluginLocalVerifyFlag,
@@ -298,6 +299,12 @@ func init() {
 		utils.ShowDeprecated,
 		// See snapshot.go
 		snapshotCommand,
+		// See raftcmd.go
+		raftCommand,
+		// See permissioncmd.go
+		permissionCommand,
+		// See plugincmd.go
+		pluginCommand,
 	}
 	sort.Sort(cli.CommandsByName(app.Commands))
 
@@ -424,8 +431,8 @@ func startNode(ctx *cli.Context, stack *node.Node, backend ethapi.Backend) {
 	ethClient := ethclient.NewClient(rpcClient)
 
 	// Quorum
-	if ctx.GlobalBool(utils.MultitenancyFlag.Name) && !stack.PluginManager().IsEnabled(plugin.SecurityPluginInterfaceName) {
-		utils.Fatalf("multitenancy requires RPC Security Plugin to be configured")
+	if ctx.GlobalBool(utils.MultitenancyFlag.Name) && !stack.PluginManager().IsEnabled(plugin.SecurityPluginInterfaceName) && stack.Config().JWTAuth == nil {
+		utils.Fatalf("multitenancy requires RPC Security Plugin or built-in JWT authentication to be configured")
 	}
 	// End Quorum
 
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/ethereum/go-ethereum/cmd/utils"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/params"
+	pcore "github.com/ethereum/go-ethereum/permission/core"
+	ptype "github.com/ethereum/go-ethereum/permission/core/types"
+	"github.com/ethereum/go-ethereum/permission/simulate"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var (
+	permissionGenesisFlag = cli.StringFlag{
+		Name:  "genesis",
+		Usage: "Genesis file to load the permission contracts from instead of the chain database",
+	}
+	permissionJSONFlag = cli.BoolFlag{
+		Name:  "json",
+		Usage: "Print the full report as JSON",
+	}
+
+	permissionCommand = cli.Command{
+		Name:      "permission",
+		Usage:     "Offline permission config validation and simulation",
+		ArgsUsage: "",
+		Category:  "PERMISSION COMMANDS",
+		Description: `
+The permission commands load the v2 permission contracts from the chain database of a
+stopped node, or from a genesis file, into an in-memory EVM. The permission-config.json
+of the data directory gives the contract addresses. If the network is not booted yet it
+is booted as a node would, from permission-config.json and static-nodes.json. Nothing
+is written back to the chain database.`,
+		Subcommands: []cli.Command{
+			permissionValidateCmd,
+			permissionSimulateCmd,
+		},
+	}
+	permissionValidateCmd = cli.Command{
+		Action: utils.MigrateFlags(permissionValidate),
+		Name:   "validate",
+		Usage:  "Check the permission graph for invariant violations",
+		Flags: []cli.Flag{
+			utils.DataDirFlag,
+			utils.RaftModeFlag,
+			permissionGenesisFlag,
+			permissionJSONFlag,
+		},
+	}
+	permissionSimulateCmd = cli.Command{
+		Action:    utils.MigrateFlags(permissionSimulate),
+		Name:      "simulate",
+		Usage:     "Apply a list of permission actions and check the resulting permission graph",
+		ArgsUsage: "<actions file>",
+		Flags: []cli.Flag{
+			utils.DataDirFlag,
+			utils.RaftModeFlag,
+			permissionGenesisFlag,
+			permissionJSONFlag,
+		},
+		Description: `
+The actions file holds a JSON list of quorumPermission API calls, applied in order:
+
+  [{"method": "addOrg", "from": "0x...", "orgId": "ORG1", "url": "enode://...", "account": "0x..."},
+   {"method": "approveOrg", "from": "0x...", "orgId": "ORG1", "url": "enode://...", "account": "0x..."}]
+
+The method is the name of the quorumPermission console function and from the account
+sending it. The other fields are orgId, parentOrgId, url, roleId, account, access,
+isVoter, isAdmin, status, target, selector, allowed and valueCap, matching the
+parameters of the console function. Failed actions are reported and skipped.`,
+	}
+)
+
+// newPermissionSimulator loads the permission contracts and boots the
+// network in the sandbox if needed. The chain database stays open until
+// the node is closed.
+func newPermissionSimulator(ctx *cli.Context, stack *node.Node) (*simulate.Simulator, error) {
+	config, err := ptype.ParsePermissionConfig(stack.DataDir())
+	if err != nil {
+		return nil, err
+	}
+	var (
+		statedb     *state.StateDB
+		header      *types.Header
+		chainConfig *params.ChainConfig
+	)
+	if genesisPath := ctx.String(permissionGenesisFlag.Name); genesisPath != "" {
+		file, err := os.Open(genesisPath)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+
+		genesis := new(core.Genesis)
+		if err := json.NewDecoder(file).Decode(genesis); err != nil {
+			return nil, fmt.Errorf("invalid genesis file: %v", err)
+		}
+		if genesis.Config == nil {
+			return nil, fmt.Errorf("invalid genesis file: %s has no chain config", genesisPath)
+		}
+		// Quorum
+		file.Seek(0, 0)
+		genesis.Config.IsQuorum = getIsQuorum(file)
+
+		statedb, header, chainConfig, err = simulate.LoadGenesisState(genesis)
+	} else {
+		db := utils.MakeChainDatabase(ctx, stack, true)
+		statedb, header, chainConfig, err = simulate.LoadChainState(db)
+	}
+	if err != nil {
+		return nil, err
+	}
+	isRaft := ctx.Bool(utils.RaftModeFlag.Name)
+	sim, err := simulate.New(statedb, header, chainConfig, &config, isRaft)
+	if err != nil {
+		return nil, err
+	}
+	if booted, err := sim.Booted(); err != nil {
+		return nil, err
+	} else if !booted {
+		fmt.Println("Network not booted, booting from permission-config.json")
+		if err := sim.Boot(stack.Config().StaticNodes()); err != nil {
+			return nil, err
+		}
+	}
+	return sim, nil
+}
+
+func permissionValidate(ctx *cli.Context) error {
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	sim, err := newPermissionSimulator(ctx, stack)
+	if err != nil {
+		utils.Fatalf("Failed to load permission contracts: %v", err)
+	}
+	return printPermissionReport(ctx, sim, nil)
+}
+
+func permissionSimulate(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		utils.Fatalf("This command requires an argument.")
+	}
+	blob, err := ioutil.ReadFile(ctx.Args().First())
+	if err != nil {
+		utils.Fatalf("Failed to read actions file: %v", err)
+	}
+	var actions []simulate.Action
+	if err := json.Unmarshal(blob, &actions); err != nil {
+		utils.Fatalf("Invalid actions file: %v", err)
+	}
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	sim, err := newPermissionSimulator(ctx, stack)
+	if err != nil {
+		utils.Fatalf("Failed to load permission contracts: %v", err)
+	}
+	return printPermissionReport(ctx, sim, sim.Apply(actions))
+}
+
+func printPermissionReport(ctx *cli.Context, sim *simulate.Simulator, results []simulate.ActionResult) error {
+	report, err := sim.Report()
+	if err != nil {
+		return err
+	}
+	report.Actions = results
+
+	if ctx.Bool(permissionJSONFlag.Name) {
+		out, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+	} else {
+		for _, r := range report.Actions {
+			if r.Error != "" {
+				fmt.Printf("Action %d:        %s failed: %s\n", r.Index, r.Method, r.Error)
+			} else {
+				fmt.Printf("Action %d:        %s applied\n", r.Index, r.Method)
+			}
+		}
+		for _, o := range report.OrgList {
+			fmt.Printf("Org:             %s level %v, %s\n", o.FullOrgId, o.Level, orgStatusName(o.Status))
+		}
+		fmt.Printf("Roles:           %d\n", len(report.RoleList))
+		fmt.Printf("Nodes:           %d\n", len(report.NodeList))
+		fmt.Printf("Accounts:        %d\n", len(report.AcctList))
+		fmt.Printf("Access rules:    %d\n", len(report.AccessRules))
+		for _, v := range report.Violations {
+			fmt.Printf("Violation:       %v\n", v)
+		}
+	}
+	if report.Failed() {
+		return fmt.Errorf("%d invariant violations, %d failed actions", len(report.Violations), len(report.Actions)-appliedActions(report.Actions))
+	}
+	return nil
+}
+
+func appliedActions(results []simulate.ActionResult) int {
+	applied := 0
+	for _, r := range results {
+		if r.Error == "" {
+			applied++
+		}
+	}
+	return applied
+}
+
+func orgStatusName(status pcore.OrgStatus) string {
+	switch status {
+	case pcore.OrgPendingApproval:
+		return "pending approval"
+	case pcore.OrgApproved:
+		return "approved"
+	case pcore.OrgPendingSuspension:
+		return "pending suspension"
+	case pcore.OrgSuspended:
+		return "suspended"
+	}
+	return fmt.Sprintf("status %d", status)
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/cmd/utils"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/plugin"
+	"golang.org/x/crypto/openpgp" // nolint:staticcheck
+	"gopkg.in/urfave/cli.v1"
+)
+
+var (
+	pluginBundleDirFlag = cli.StringFlag{
+		Name:  "dir",
+		Usage: "Directory to write the plugin bundle to",
+		Value: "plugin-bundle",
+	}
+	pluginBundleSigningKeyFlag = cli.StringFlag{
+		Name:  "signingkey",
+		Usage: "Armored PGP private key file signing the bundle manifest",
+	}
+	pluginBundlePublicKeyFlag = cli.StringFlag{
+		Name:  "bundlepubkey",
+		Usage: "File to write the armored PGP public key of the signing key to (default: <dir>.pub)",
+	}
+
+	pluginCommand = cli.Command{
+		Name:      "plugin",
+		Usage:     "Manage plugin distributions",
+		ArgsUsage: "",
+		Category:  "PLUGIN COMMANDS",
+		Subcommands: []cli.Command{
+			pluginBundleCmd,
+		},
+	}
+	pluginBundleCmd = cli.Command{
+		Action: utils.MigrateFlags(pluginBundle),
+		Name:   "bundle",
+		Usage:  "Download and verify the plugins of a plugin settings file into a local bundle",
+		Flags: []cli.Flag{
+			utils.PluginSettingsFlag,
+			pluginBundleDirFlag,
+			pluginBundleSigningKeyFlag,
+			pluginBundlePublicKeyFlag,
+		},
+		Description: `
+The bundle command downloads the distribution and signature of every plugin in the
+plugin settings file, together with the PGP public key, from the Plugin Central set in
+the settings. Each distribution is verified against its signature before being added
+to the bundle. A plugin-bundle.json manifest lists the bundled plugins, it is signed
+with the PGP private key given by --signingkey into plugin-bundle.json.asc. The public
+key of the signing key is written outside of the bundle, to the --bundlepubkey file.
+
+The bundle directory and the public key file can be copied to an air-gapped site and
+used as a local Plugin Central mirror by setting the "central" plugin settings to:
+
+  {"baseURL": "file:///path/to/bundle/", "bundlePublicKeyURI": "file:///path/to/bundle.pub"}
+
+The command prints these settings. Plugins are then resolved from the mirror. The
+manifest signature is verified with the public key of the signing key, and the plugin
+signatures with the configured Plugin Central public key, not with the copy in the bundle.`,
+	}
+)
+
+func pluginBundle(ctx *cli.Context) error {
+	if !ctx.GlobalIsSet(utils.PluginSettingsFlag.Name) {
+		utils.Fatalf("--%s is required", utils.PluginSettingsFlag.Name)
+	}
+	cfg := &node.Config{}
+	if err := utils.SetPlugins(ctx, cfg); err != nil {
+		utils.Fatalf("%v", err)
+	}
+	dir, err := filepath.Abs(ctx.GlobalString(pluginBundleDirFlag.Name))
+	if err != nil {
+		utils.Fatalf("Invalid bundle directory: %v", err)
+	}
+	signer, err := readBundleSigningKey(ctx.GlobalString(pluginBundleSigningKeyFlag.Name))
+	if err != nil {
+		utils.Fatalf("Invalid bundle signing key: %v", err)
+	}
+	manifest, err := plugin.CreateBundle(plugin.NewPluginCentralClient(cfg.Plugins.CentralConfig), cfg.Plugins.Providers, dir, signer)
+	if err != nil {
+		utils.Fatalf("Failed to create plugin bundle: %v", err)
+	}
+	for _, entry := range manifest.Plugins {
+		fmt.Printf("Bundled %s: %s %s (sha256 %s)\n", entry.Provider, entry.Name, entry.Version, entry.Checksum)
+	}
+	pubKeyFile := ctx.GlobalString(pluginBundlePublicKeyFlag.Name)
+	if pubKeyFile == "" {
+		pubKeyFile = dir + ".pub"
+	}
+	if pubKeyFile, err = filepath.Abs(pubKeyFile); err != nil {
+		utils.Fatalf("Invalid bundle public key file: %v", err)
+	}
+	if err := plugin.WriteBundlePublicKey(pubKeyFile, signer); err != nil {
+		utils.Fatalf("Failed to write bundle public key: %v", err)
+	}
+	central, err := json.MarshalIndent(plugin.BundleMirrorCentralConfiguration(dir, pubKeyFile), "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Printf("\nPlugin bundle written to %s and its public key to %s\nUse it as Plugin Central with the \"central\" plugin settings:\n%s\n", dir, pubKeyFile, central)
+	return nil
+}
+
+// readBundleSigningKey returns the first private key of the armored PGP key file
+func readBundleSigningKey(file string) (*openpgp.Entity, error) {
+	if file == "" {
+		return nil, fmt.Errorf("--%s is required", pluginBundleSigningKeyFlag.Name)
+	}
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	keyring, err := openpgp.ReadArmoredKeyRing(f)
+	if err != nil {
+		return nil, err
+	}
+	for _, entity := range keyring {
+		if entity.PrivateKey == nil {
+			continue
+		}
+		if entity.PrivateKey.Encrypted {
+			return nil, fmt.Errorf("passphrase protected private keys are not supported")
+		}
+		return entity, nil
+	}
+	return nil, fmt.Errorf("no private key in %s", file)
+}
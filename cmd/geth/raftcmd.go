@@ -0,0 +1,173 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/coreos/etcd/raft/raftpb"
+	"github.com/ethereum/go-ethereum/cmd/utils"
+	"github.com/ethereum/go-ethereum/raft"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var (
+	raftEntriesFlag = cli.BoolFlag{
+		Name:  "entries",
+		Usage: "List every entry of the WAL",
+	}
+	raftKeepSnapshotsFlag = cli.IntFlag{
+		Name:  "keep",
+		Usage: "Number of snapshots to keep",
+		Value: 5,
+	}
+
+	raftCommand = cli.Command{
+		Name:      "raft",
+		Usage:     "Offline raft log operations",
+		ArgsUsage: "",
+		Category:  "RAFT COMMANDS",
+		Description: `
+The raft commands operate on the raft-wal, raft-snap and quorum-raft-state folders of
+a stopped node, found in --raftlogdir or the data directory.`,
+		Subcommands: []cli.Command{
+			raftInspectCmd,
+			raftVerifyCmd,
+			raftCompactCmd,
+			raftRebuildCmd,
+		},
+	}
+	raftInspectCmd = cli.Command{
+		Action: utils.MigrateFlags(raftInspect),
+		Name:   "inspect",
+		Usage:  "Show the latest snapshot, the WAL content and the applied index",
+		Flags: []cli.Flag{
+			utils.DataDirFlag,
+			utils.RaftLogDirFlag,
+			raftEntriesFlag,
+		},
+	}
+	raftVerifyCmd = cli.Command{
+		Action: utils.MigrateFlags(raftVerify),
+		Name:   "verify",
+		Usage:  "Check the integrity of the WAL and snapshots",
+		Flags: []cli.Flag{
+			utils.DataDirFlag,
+			utils.RaftLogDirFlag,
+		},
+	}
+	raftCompactCmd = cli.Command{
+		Action: utils.MigrateFlags(raftCompact),
+		Name:   "compact",
+		Usage:  "Snapshot the raft log at the applied index and discard what precedes it",
+		Flags: []cli.Flag{
+			utils.DataDirFlag,
+			utils.RaftLogDirFlag,
+			raftKeepSnapshotsFlag,
+		},
+		Description: `
+Takes a snapshot at the last index applied to the chain, then removes the WAL segments
+and all but the newest --keep snapshots made obsolete by it.`,
+	}
+	raftRebuildCmd = cli.Command{
+		Action:    utils.MigrateFlags(raftRebuild),
+		Name:      "rebuild",
+		Usage:     "Rebuild the raft state of this node from a peer's snapshot",
+		ArgsUsage: "<raft ID> <snapshot file>",
+		Flags: []cli.Flag{
+			utils.DataDirFlag,
+			utils.RaftLogDirFlag,
+		},
+		Description: `
+Replaces the WAL and snapshots of the node with the given raft ID by a snapshot file
+copied from the raft-snap folder of a healthy peer. The node keeps its raft ID and
+catches up from the leader once restarted. The previous folders are kept as backups.`,
+	}
+)
+
+func raftLogDir(ctx *cli.Context) *raft.LogDir {
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	return raft.NewLogDir(stack.Config().RaftLogDir)
+}
+
+func raftInspect(ctx *cli.Context) error {
+	summary, err := raftLogDir(ctx).Inspect()
+	if err != nil {
+		return err
+	}
+	if s := summary.Snapshot; s != nil {
+		fmt.Printf("Snapshot:        index %d, term %d, head block %x\n", s.Index, s.Term, s.HeadBlockHash)
+		fmt.Printf("Voters:          %v\n", s.ConfState.Nodes)
+		fmt.Printf("Learners:        %v\n", s.ConfState.Learners)
+		fmt.Printf("Removed:         %v\n", s.RemovedRaftIds)
+		for _, address := range s.Addresses {
+			fmt.Printf("  raft ID %d:     %s raft port %d, p2p port %d\n", address.RaftId, address.Hostname, address.RaftPort, address.P2pPort)
+		}
+	} else {
+		fmt.Println("Snapshot:        none")
+	}
+	fmt.Printf("WAL entries:     %d (index %d to %d)\n", len(summary.Entries), summary.FirstIndex, summary.LastIndex)
+	fmt.Printf("Hard state:      term %d, vote %d, commit %d\n", summary.HardState.Term, summary.HardState.Vote, summary.HardState.Commit)
+	fmt.Printf("Applied index:   %d\n", summary.AppliedIndex)
+
+	confChanges := 0
+	for _, entry := range summary.Entries {
+		if entry.ConfChange != nil {
+			confChanges++
+			fmt.Printf("Conf change:     index %d, %s raft ID %d\n", entry.Index, raftpb.ConfChangeType_name[int32(entry.ConfChange.Type)], entry.ConfChange.NodeID)
+		}
+	}
+	fmt.Printf("Conf changes:    %d\n", confChanges)
+
+	if ctx.Bool(raftEntriesFlag.Name) {
+		for _, entry := range summary.Entries {
+			switch entry.Type {
+			case "block":
+				fmt.Printf("%d\tterm %d\tblock %d %x\n", entry.Index, entry.Term, entry.BlockNumber, entry.BlockHash)
+			case "confChange":
+				fmt.Printf("%d\tterm %d\t%s %d\n", entry.Index, entry.Term, raftpb.ConfChangeType_name[int32(entry.ConfChange.Type)], entry.ConfChange.NodeID)
+			default:
+				fmt.Printf("%d\tterm %d\t%s\n", entry.Index, entry.Term, entry.Type)
+			}
+		}
+	}
+	return nil
+}
+
+func raftVerify(ctx *cli.Context) error {
+	problems := raftLogDir(ctx).Verify()
+	for _, problem := range problems {
+		fmt.Println(problem)
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("found %d problems in the raft log", len(problems))
+	}
+	fmt.Println("Raft log is consistent")
+	return nil
+}
+
+func raftCompact(ctx *cli.Context) error {
+	index, err := raftLogDir(ctx).Compact(ctx.Int(raftKeepSnapshotsFlag.Name))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Raft log compacted up to index %d\n", index)
+	return nil
+}
+
+func raftRebuild(ctx *cli.Context) error {
+	if ctx.NArg() != 2 {
+		return fmt.Errorf("required arguments: %v", ctx.Command.ArgsUsage)
+	}
+	raftId, err := strconv.ParseUint(ctx.Args().Get(0), 10, 16)
+	if err != nil {
+		return fmt.Errorf("invalid raft ID: %v", err)
+	}
+	suffix, err := raftLogDir(ctx).Rebuild(uint16(raftId), ctx.Args().Get(1))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Raft state rebuilt, previous folders kept with the %s suffix\n", suffix)
+	return nil
+}
@@ -39,12 +39,14 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/fdlimit"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	http2 "github.com/ethereum/go-ethereum/common/http"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/clique"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/consensus/istanbul"
 	istanbulBackend "github.com/ethereum/go-ethereum/consensus/istanbul/backend"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/bls"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -893,10 +895,14 @@ var (
 		Usage: "[Deprecated] Default minimum difference between two consecutive block's timestamps in seconds",
 		Value: ethconfig.Defaults.Istanbul.BlockPeriod,
 	}
+	IstanbulBLSKeyFlag = cli.StringFlag{
+		Name:  "istanbul.blskey",
+		Usage: "File containing the hex encoded BLS secret key used to sign committed seals once qbft BLS seals are enabled",
+	}
 	// Multitenancy setting
 	MultitenancyFlag = cli.BoolFlag{
 		Name:  "multitenancy",
-		Usage: "Enable multitenancy support for this node. This requires RPC Security Plugin to also be configured.",
+		Usage: "Enable multitenancy support for this node. This requires RPC Security Plugin or built-in JWT authentication to also be configured.",
 	}
 
 	// Revert Reason
@@ -1837,6 +1843,18 @@ func setIstanbul(ctx *cli.Context, cfg *eth.Config) {
 		log.Warn("WARNING: The flag --istanbul.blockperiod is deprecated and will be removed in the future, please use ibft.blockperiodseconds on genesis file")
 		cfg.Istanbul.BlockPeriod = ctx.GlobalUint64(IstanbulBlockPeriodFlag.Name)
 	}
+	if file := ctx.GlobalString(IstanbulBLSKeyFlag.Name); file != "" {
+		key, err := bls.LoadKey(file)
+		if err != nil {
+			Fatalf("Option %q: %v", IstanbulBLSKeyFlag.Name, err)
+		}
+		proof, err := key.ProvePossession()
+		if err != nil {
+			Fatalf("Option %q: %v", IstanbulBLSKeyFlag.Name, err)
+		}
+		log.Info("Loaded BLS key to sign committed seals", "publicKey", hexutil.Encode(key.PublicKey()), "proofOfPossession", hexutil.Encode(proof))
+		cfg.Istanbul.BLSKey = key
+	}
 }
 
 func setRaft(ctx *cli.Context, cfg *eth.Config) {
@@ -55,6 +55,9 @@ type Backend interface {
 	// SignWithoutHashing sign input data with the backend's private key without hashing the input data
 	SignWithoutHashing([]byte) ([]byte, error)
 
+	// SignBLS signs input data with the backend's BLS key, used for aggregated committed seals
+	SignBLS([]byte) ([]byte, error)
+
 	// CheckSignature verifies the signature by checking if it's signed by
 	// the given validator
 	CheckSignature(data []byte, addr common.Address, sig []byte) error
@@ -18,14 +18,21 @@ package backend
 
 import (
 	"errors"
+	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus"
 	istanbulcommon "github.com/ethereum/go-ethereum/consensus/istanbul/common"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/qbft/finality"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
+// maxFinalityProofHeaders caps the number of headers returned by a single finality proof
+const maxFinalityProofHeaders = 1024
+
 // API is a user facing RPC API to dump Istanbul state
 type API struct {
 	chain   consensus.ChainHeaderReader
@@ -50,6 +57,14 @@ func (api *API) NodeAddress() common.Address {
 	return api.backend.Address()
 }
 
+// BLSPublicKey returns the BLS public key used for aggregated committed seals, to be registered in a transition
+func (api *API) BLSPublicKey() (hexutil.Bytes, error) {
+	if api.backend.config.BLSKey == nil {
+		return nil, istanbulcommon.ErrMissingBLSKey
+	}
+	return api.backend.config.BLSKey.PublicKey(), nil
+}
+
 // GetSignersFromBlock returns the signers and minter for a given block number, or the
 // latest block available if none is specified
 func (api *API) GetSignersFromBlock(number *rpc.BlockNumber) (*BlockSigners, error) {
@@ -84,7 +99,7 @@ func (api *API) signers(header *types.Header) (*BlockSigners, error) {
 		return nil, err
 	}
 
-	committers, err := api.backend.Signers(header)
+	committers, err := api.backend.signers(api.chain, header)
 	if err != nil {
 		return nil, err
 	}
@@ -268,3 +283,65 @@ func (api *API) IsValidator(blockNum *rpc.BlockNumber) (bool, error) {
 	}
 	return false, nil
 }
+
+// GetFinalityProof returns the headers from block from to block to together with every
+// validator set change in that range. The proof can be checked with the finality
+// package starting from block from-1 and the validators returned by GetValidators for it.
+func (api *API) GetFinalityProof(from rpc.BlockNumber, to rpc.BlockNumber) (*finality.FinalityProof, error) {
+	current := api.chain.CurrentHeader().Number.Uint64()
+	if to == rpc.LatestBlockNumber {
+		to = rpc.BlockNumber(current)
+	}
+	if from < 1 || to < from {
+		return nil, errors.New("invalid block range, from should be at least 1 and not greater than to")
+	}
+	if uint64(to) > current {
+		return nil, errors.New("end block number should be less than or equal to current block height")
+	}
+	if uint64(to-from) >= maxFinalityProofHeaders {
+		return nil, fmt.Errorf("block range too large, at most %d headers per proof", maxFinalityProofHeaders)
+	}
+
+	proof := &finality.FinalityProof{
+		Headers:       make([]*types.Header, 0, to-from+1),
+		ValidatorSets: []*finality.ValidatorSetChange{},
+	}
+	var previous []common.Address
+	for n := uint64(from); n <= uint64(to); n++ {
+		header := api.chain.GetHeaderByNumber(n)
+		if header == nil {
+			return nil, istanbulcommon.ErrUnknownBlock
+		}
+		if !api.backend.IsQBFTConsensusAt(header.Number) {
+			return nil, fmt.Errorf("block %d is not a QBFT block", n)
+		}
+		snap, err := api.backend.snapshot(api.chain, n-1, header.ParentHash, nil)
+		if err != nil {
+			return nil, err
+		}
+		validators := snap.validators()
+		if previous != nil && !sameAddresses(previous, validators) {
+			parent := new(big.Int).SetUint64(n - 1)
+			proof.ValidatorSets = append(proof.ValidatorSets, &finality.ValidatorSetChange{
+				Block:      n,
+				Mode:       api.backend.config.GetValidatorSelectionMode(parent),
+				Validators: validators,
+			})
+		}
+		previous = validators
+		proof.Headers = append(proof.Headers, header)
+	}
+	return proof, nil
+}
+
+func sameAddresses(a, b []common.Address) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -68,6 +68,10 @@ func New(config *istanbul.Config, privateKey *ecdsa.PrivateKey, db ethdb.Databas
 		knownMessages:    knownMessages,
 	}
 
+	for _, validator := range sb.config.DropUnprovenBLSPublicKeys() {
+		sb.logger.Error("BFT: ignoring BLS public key registered without a valid proof of possession", "validator", validator)
+	}
+
 	sb.qbftEngine = qbftengine.NewEngine(sb.config, sb.address, sb.Sign)
 	sb.ibftEngine = ibftengine.NewEngine(sb.config, sb.address, sb.Sign)
 
@@ -289,6 +293,14 @@ func (sb *Backend) SignWithoutHashing(data []byte) ([]byte, error) {
 	return crypto.Sign(data, sb.privateKey)
 }
 
+// SignBLS implements istanbul.Backend.SignBLS and signs input data with the backend's BLS key
+func (sb *Backend) SignBLS(data []byte) ([]byte, error) {
+	if sb.config.BLSKey == nil {
+		return nil, istanbulcommon.ErrMissingBLSKey
+	}
+	return sb.config.BLSKey.Sign(data)
+}
+
 // CheckSignature implements istanbul.Backend.CheckSignature
 func (sb *Backend) CheckSignature(data []byte, address common.Address, sig []byte) error {
 	signer, err := istanbul.GetSignatureAddress(data, sig)
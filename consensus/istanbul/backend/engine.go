@@ -55,6 +55,18 @@ func (sb *Backend) Author(header *types.Header) (common.Address, error) {
 // It will extract for each seal who signed it, regardless of if the seal is
 // repeated
 func (sb *Backend) Signers(header *types.Header) ([]common.Address, error) {
+	return sb.signers(sb.chain, header)
+}
+
+func (sb *Backend) signers(chain consensus.ChainHeaderReader, header *types.Header) ([]common.Address, error) {
+	// Aggregated seals only identify their signers relative to the parent validator set
+	if sb.IsQBFTConsensusAt(header.Number) && sb.config.GetBLSSealsEnabled(header.Number) {
+		snap, err := sb.snapshot(chain, header.Number.Uint64()-1, header.ParentHash, nil)
+		if err != nil {
+			return nil, err
+		}
+		return sb.qbftEngine.AggregatedSigners(header, snap.ValSet)
+	}
 	return sb.EngineForBlockNumber(header.Number).Signers(header)
 }
 
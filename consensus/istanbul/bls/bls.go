@@ -0,0 +1,312 @@
+// Package bls implements the BLS signatures used to aggregate QBFT committed seals.
+//
+// Public keys live in G1 and signatures in G2 of the BLS12-381 curve so that all the
+// committed seals of a block can be folded into a single G2 point. Messages are mapped
+// to G2 with the hash_to_curve suite BLS12381G2_XMD:SHA-256_SSWU_RO_ of RFC 9380.
+//
+// Aggregate verification follows the proof of possession scheme: it is only safe for
+// public keys whose proof of possession has been checked with VerifyPossession, which
+// rules out rogue key attacks.
+package bls
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto/bls12381"
+)
+
+const (
+	SecretKeyLength = 32  // Length in bytes of a serialized secret key
+	PublicKeyLength = 96  // Length in bytes of an uncompressed G1 public key
+	SignatureLength = 192 // Length in bytes of an uncompressed G2 signature
+)
+
+var (
+	// sealDomain is the hash_to_curve domain separation tag of committed seals.
+	sealDomain = []byte("QBFT_BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+	// possessionDomain is the hash_to_curve domain separation tag of proofs of possession.
+	possessionDomain = []byte("QBFT_BLS_POP_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+	// fieldModulus is the characteristic p of the BLS12-381 base field.
+	fieldModulus, _ = new(big.Int).SetString("1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab", 16)
+)
+
+var (
+	ErrInvalidSecretKey  = errors.New("invalid BLS secret key")
+	ErrInvalidPublicKey  = errors.New("invalid BLS public key")
+	ErrInvalidSignature  = errors.New("invalid BLS signature")
+	ErrNoSignatures      = errors.New("no BLS signatures to aggregate")
+	ErrInvalidPossession = errors.New("invalid BLS proof of possession")
+)
+
+// SecretKey is a BLS12-381 secret scalar.
+type SecretKey struct {
+	k *big.Int
+}
+
+// GenerateKey returns a new random secret key.
+func GenerateKey() (*SecretKey, error) {
+	order := bls12381.NewG1().Q()
+	for {
+		k, err := rand.Int(rand.Reader, order)
+		if err != nil {
+			return nil, err
+		}
+		if k.Sign() > 0 {
+			return &SecretKey{k: k}, nil
+		}
+	}
+}
+
+// SecretKeyFromBytes decodes a big endian secret key.
+func SecretKeyFromBytes(b []byte) (*SecretKey, error) {
+	if len(b) != SecretKeyLength {
+		return nil, ErrInvalidSecretKey
+	}
+	k := new(big.Int).SetBytes(b)
+	if k.Sign() == 0 || k.Cmp(bls12381.NewG1().Q()) >= 0 {
+		return nil, ErrInvalidSecretKey
+	}
+	return &SecretKey{k: k}, nil
+}
+
+// LoadKey loads a hex encoded secret key from the given file.
+func LoadKey(file string) (*SecretKey, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	b, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(string(data)), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSecretKey, err)
+	}
+	return SecretKeyFromBytes(b)
+}
+
+// Bytes returns the big endian encoding of the secret key.
+func (sk *SecretKey) Bytes() []byte {
+	b := make([]byte, SecretKeyLength)
+	return sk.k.FillBytes(b)
+}
+
+// PublicKey returns the serialized G1 public key matching the secret key.
+func (sk *SecretKey) PublicKey() []byte {
+	g1 := bls12381.NewG1()
+	return g1.ToBytes(g1.MulScalar(g1.New(), g1.One(), sk.k))
+}
+
+// Sign signs msg for use as a committed seal.
+func (sk *SecretKey) Sign(msg []byte) ([]byte, error) {
+	return sk.sign(msg, sealDomain)
+}
+
+// ProvePossession returns the proof of possession of the secret key, to be registered
+// together with the public key.
+func (sk *SecretKey) ProvePossession() ([]byte, error) {
+	return sk.sign(sk.PublicKey(), possessionDomain)
+}
+
+func (sk *SecretKey) sign(msg []byte, dst []byte) ([]byte, error) {
+	g2 := bls12381.NewG2()
+	h, err := hashToG2(g2, msg, dst)
+	if err != nil {
+		return nil, err
+	}
+	return g2.ToBytes(g2.MulScalar(g2.New(), h, sk.k)), nil
+}
+
+// VerifyPossession checks the proof of possession of the secret key matching pubkey.
+func VerifyPossession(pubkey []byte, proof []byte) error {
+	g1, g2 := bls12381.NewG1(), bls12381.NewG2()
+	p, err := decodePublicKey(g1, pubkey)
+	if err != nil {
+		return err
+	}
+	s, err := decodeSignature(g2, proof)
+	if err != nil {
+		return ErrInvalidPossession
+	}
+	if !verifyPairing(g1, g2, p, s, pubkey, possessionDomain) {
+		return ErrInvalidPossession
+	}
+	return nil
+}
+
+// Aggregate folds the given signatures into a single one.
+func Aggregate(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, ErrNoSignatures
+	}
+	g2 := bls12381.NewG2()
+	acc := g2.Zero()
+	for _, sig := range sigs {
+		p, err := decodeSignature(g2, sig)
+		if err != nil {
+			return nil, err
+		}
+		g2.Add(acc, acc, p)
+	}
+	return g2.ToBytes(acc), nil
+}
+
+// Verify checks a single signature of msg against a public key.
+func Verify(pubkey []byte, msg []byte, sig []byte) error {
+	return VerifyAggregate([][]byte{pubkey}, msg, sig)
+}
+
+// VerifyAggregate checks that sig is the aggregate of signatures of msg by every
+// one of the given public keys. The proof of possession of each key must have been
+// verified beforehand.
+func VerifyAggregate(pubkeys [][]byte, msg []byte, sig []byte) error {
+	if len(pubkeys) == 0 {
+		return ErrInvalidPublicKey
+	}
+	g1, g2 := bls12381.NewG1(), bls12381.NewG2()
+	aggKey := g1.Zero()
+	for _, pubkey := range pubkeys {
+		p, err := decodePublicKey(g1, pubkey)
+		if err != nil {
+			return err
+		}
+		g1.Add(aggKey, aggKey, p)
+	}
+	s, err := decodeSignature(g2, sig)
+	if err != nil {
+		return err
+	}
+	if !verifyPairing(g1, g2, aggKey, s, msg, sealDomain) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// verifyPairing checks e(pubkey, H(msg)) == e(g1, sig).
+func verifyPairing(g1 *bls12381.G1, g2 *bls12381.G2, pubkey *bls12381.PointG1, sig *bls12381.PointG2, msg []byte, dst []byte) bool {
+	h, err := hashToG2(g2, msg, dst)
+	if err != nil {
+		return false
+	}
+	engine := bls12381.NewPairingEngine()
+	engine.AddPair(pubkey, h)
+	engine.AddPairInv(g1.One(), sig)
+	return engine.Check()
+}
+
+// ValidatePublicKey checks that pubkey is a well formed, non trivial G1 point.
+func ValidatePublicKey(pubkey []byte) error {
+	_, err := decodePublicKey(bls12381.NewG1(), pubkey)
+	return err
+}
+
+func decodePublicKey(g1 *bls12381.G1, b []byte) (*bls12381.PointG1, error) {
+	if len(b) != PublicKeyLength {
+		return nil, ErrInvalidPublicKey
+	}
+	p, err := g1.FromBytes(b)
+	if err != nil || g1.IsZero(p) || !g1.InCorrectSubgroup(p) {
+		return nil, ErrInvalidPublicKey
+	}
+	return p, nil
+}
+
+func decodeSignature(g2 *bls12381.G2, b []byte) (*bls12381.PointG2, error) {
+	if len(b) != SignatureLength {
+		return nil, ErrInvalidSignature
+	}
+	p, err := g2.FromBytes(b)
+	if err != nil || g2.IsZero(p) || !g2.InCorrectSubgroup(p) {
+		return nil, ErrInvalidSignature
+	}
+	return p, nil
+}
+
+// hashToG2 implements hash_to_curve of RFC 9380 for the BLS12381G2_XMD:SHA-256_SSWU_RO_
+// suite. MapToCurve of the curve package applies the simplified SWU map, the 3-isogeny
+// and clears the cofactor, so the sum of both mapped points is already in G2.
+func hashToG2(g2 *bls12381.G2, msg []byte, dst []byte) (*bls12381.PointG2, error) {
+	u, err := hashToField(msg, dst)
+	if err != nil {
+		return nil, err
+	}
+	q0, err := g2.MapToCurve(u[0])
+	if err != nil {
+		return nil, err
+	}
+	q1, err := g2.MapToCurve(u[1])
+	if err != nil {
+		return nil, err
+	}
+	return g2.Affine(g2.Add(g2.New(), q0, q1)), nil
+}
+
+// hashToField returns two Fp2 elements derived from msg, serialized the way MapToCurve
+// expects them: the imaginary part followed by the real part.
+func hashToField(msg []byte, dst []byte) ([2][]byte, error) {
+	const elementLength = 64 // ceil((ceil(log2(p)) + 128) / 8)
+	var u [2][]byte
+	uniform, err := expandMessageXMD(msg, dst, 2*2*elementLength)
+	if err != nil {
+		return u, err
+	}
+	for i := range u {
+		u[i] = make([]byte, 96)
+		for j := 0; j < 2; j++ {
+			offset := (2*i + j) * elementLength
+			e := new(big.Int).SetBytes(uniform[offset : offset+elementLength])
+			e.Mod(e, fieldModulus)
+			// c0 goes in the second half of the encoding, c1 in the first one
+			e.FillBytes(u[i][(1-j)*48 : (2-j)*48])
+		}
+	}
+	return u, nil
+}
+
+// expandMessageXMD implements expand_message_xmd of RFC 9380 with SHA-256.
+func expandMessageXMD(msg []byte, dst []byte, length int) ([]byte, error) {
+	const (
+		hashLength  = sha256.Size
+		blockLength = sha256.BlockSize
+	)
+	ell := (length + hashLength - 1) / hashLength
+	if ell > 255 || length > 65535 || len(dst) > 255 {
+		return nil, errors.New("invalid expand_message_xmd parameters")
+	}
+	dstPrime := append(append([]byte{}, dst...), byte(len(dst)))
+
+	h := sha256.New()
+	h.Write(make([]byte, blockLength))
+	h.Write(msg)
+	h.Write([]byte{byte(length >> 8), byte(length), 0})
+	h.Write(dstPrime)
+	b0 := h.Sum(nil)
+
+	h.Reset()
+	h.Write(b0)
+	h.Write([]byte{1})
+	h.Write(dstPrime)
+	bi := h.Sum(nil)
+
+	out := make([]byte, 0, ell*hashLength)
+	out = append(out, bi...)
+	for i := 2; i <= ell; i++ {
+		mixed := make([]byte, hashLength)
+		for j := range mixed {
+			mixed[j] = b0[j] ^ bi[j]
+		}
+		h.Reset()
+		h.Write(mixed)
+		h.Write([]byte{byte(i)})
+		h.Write(dstPrime)
+		bi = h.Sum(nil)
+		out = append(out, bi...)
+	}
+	return out[:length], nil
+}
@@ -0,0 +1,168 @@
+package bls
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto/bls12381"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	sk, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := []byte("block hash")
+	sig, err := sk.Sign(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Verify(sk.PublicKey(), msg, sig); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := Verify(sk.PublicKey(), []byte("other hash"), sig); err != ErrInvalidSignature {
+		t.Errorf("error mismatch: have %v, want %v", err, ErrInvalidSignature)
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	msg := []byte("block hash")
+	var (
+		pubkeys [][]byte
+		sigs    [][]byte
+	)
+	for i := 0; i < 4; i++ {
+		sk, err := GenerateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		sig, err := sk.Sign(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pubkeys = append(pubkeys, sk.PublicKey())
+		sigs = append(sigs, sig)
+	}
+	agg, err := Aggregate(sigs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(agg) != SignatureLength {
+		t.Fatalf("aggregate length mismatch: have %d, want %d", len(agg), SignatureLength)
+	}
+	if err := VerifyAggregate(pubkeys, msg, agg); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := VerifyAggregate(pubkeys[1:], msg, agg); err != ErrInvalidSignature {
+		t.Errorf("error mismatch: have %v, want %v", err, ErrInvalidSignature)
+	}
+}
+
+func TestSecretKeyFromBytes(t *testing.T) {
+	sk, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := SecretKeyFromBytes(sk.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(decoded.PublicKey()) != string(sk.PublicKey()) {
+		t.Errorf("public key mismatch after round trip")
+	}
+	if _, err := SecretKeyFromBytes(make([]byte, SecretKeyLength)); err != ErrInvalidSecretKey {
+		t.Errorf("error mismatch: have %v, want %v", err, ErrInvalidSecretKey)
+	}
+}
+
+func TestHashToG2(t *testing.T) {
+	// Test vectors of RFC 9380, appendix J.10.1
+	dst := []byte("QUUX-V01-CS02-with-BLS12381G2_XMD:SHA-256_SSWU_RO_")
+	tests := []struct {
+		msg    string
+		x0, x1 string
+		y0, y1 string
+	}{
+		{
+			msg: "",
+			x0:  "0141ebfbdca40eb85b87142e130ab689c673cf60f1a3e98d69335266f30d9b8d4ac44c1038e9dcdd5393faf5c41fb78a",
+			x1:  "05cb8437535e20ecffaef7752baddf98034139c38452458baeefab379ba13dff5bf5dd71b72418717047f5b0f37da03d",
+			y0:  "0503921d7f6a12805e72940b963c0cf3471c7b2a524950ca195d11062ee75ec076daf2d4bc358c4b190c0c98064fdd92",
+			y1:  "12424ac32561493f3fe3c260708a12b7c620e7be00099a974e259ddc7d1f6395c3c811cdd19f1e8dbf3e9ecfdcbab8d6",
+		},
+		{
+			msg: "abc",
+			x0:  "02c2d18e033b960562aae3cab37a27ce00d80ccd5ba4b7fe0e7a210245129dbec7780ccc7954725f4168aff2787776e6",
+			x1:  "139cddbccdc5e91b9623efd38c49f81a6f83f175e80b06fc374de9eb4b41dfe4ca3a230ed250fbe3a2acf73a41177fd8",
+			y0:  "1787327b68159716a37440985269cf584bcb1e621d3a7202be6ea05c4cfe244aeb197642555a0645fb87bf7466b2ba48",
+			y1:  "00aa65dae3c8d732d10ecd2c50f8a1baf3001578f71c694e03866e9f3d49ac1e1ce70dd94a733534f106d4cec0eddd16",
+		},
+	}
+	g2 := bls12381.NewG2()
+	for _, test := range tests {
+		p, err := hashToG2(g2, []byte(test.msg), dst)
+		if err != nil {
+			t.Fatalf("msg %q: %v", test.msg, err)
+		}
+		want := test.x1 + test.x0 + test.y1 + test.y0
+		if have := hex.EncodeToString(g2.ToBytes(p)); have != want {
+			t.Errorf("msg %q: point mismatch\nhave %s\nwant %s", test.msg, have, want)
+		}
+	}
+}
+
+func TestVerifyPossession(t *testing.T) {
+	sk, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof, err := sk.ProvePossession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyPossession(sk.PublicKey(), proof); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// a seal over the public key must not be accepted as a proof of possession
+	seal, err := sk.Sign(sk.PublicKey())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyPossession(sk.PublicKey(), seal); err != ErrInvalidPossession {
+		t.Errorf("error mismatch: have %v, want %v", err, ErrInvalidPossession)
+	}
+}
+
+func TestVerifyPossession_whenRogueKey(t *testing.T) {
+	honest, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	attacker, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// rogue = attacker - honest, so that honest + rogue aggregates to the attacker key
+	g1 := bls12381.NewG1()
+	honestKey, _ := g1.FromBytes(honest.PublicKey())
+	attackerKey, _ := g1.FromBytes(attacker.PublicKey())
+	rogue := g1.ToBytes(g1.Sub(g1.New(), attackerKey, honestKey))
+
+	msg := []byte("block hash")
+	sig, err := attacker.Sign(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyAggregate([][]byte{honest.PublicKey(), rogue}, msg, sig); err != nil {
+		t.Fatalf("rogue key aggregate should verify without proofs of possession: %v", err)
+	}
+	// the attacker cannot prove possession of the rogue key
+	proof, err := attacker.ProvePossession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyPossession(rogue, proof); err != ErrInvalidPossession {
+		t.Errorf("error mismatch: have %v, want %v", err, ErrInvalidPossession)
+	}
+}
@@ -51,6 +51,9 @@ var (
 	// ErrEmptyCommittedSeals is returned if the field of committed seals is zero.
 	ErrEmptyCommittedSeals = errors.New("zero committed seals")
 
+	// ErrMissingBLSKey is returned if BLS committed seals are enabled but no BLS key is configured.
+	ErrMissingBLSKey = errors.New("missing BLS key")
+
 	// ErrMismatchTxhashes is returned if the TxHash in header is mismatch.
 	ErrMismatchTxhashes = errors.New("mismatch transactions hashes")
 
@@ -23,7 +23,9 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/bls"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/naoina/toml"
 )
@@ -140,6 +142,7 @@ type Config struct {
 	Client                   bind.ContractCaller   `toml:",omitempty"`
 	MaxRequestTimeoutSeconds uint64                `toml:",omitempty"`
 	Transitions              []params.Transition
+	BLSKey                   *bls.SecretKey `toml:"-"` // BLS key used to sign committed seals once BLS seals are enabled
 }
 
 var DefaultConfig = &Config{
@@ -276,6 +279,53 @@ func (c Config) Get2FPlus1Enabled(blockNumber *big.Int) bool {
 	return twoFPlusOneEnabled
 }
 
+// GetBLSSealsEnabled returns whether committed seals are aggregated with BLS signatures at the given block
+func (c Config) GetBLSSealsEnabled(blockNumber *big.Int) bool {
+	blsSealsEnabled := false
+	c.getTransitionValue(blockNumber, func(transition params.Transition) {
+		if transition.BLSSealsEnabled != nil {
+			blsSealsEnabled = *transition.BLSSealsEnabled
+		}
+	})
+	return blsSealsEnabled
+}
+
+// GetBLSPublicKeys returns the BLS public keys registered by transitions up to the given block
+func (c Config) GetBLSPublicKeys(blockNumber *big.Int) map[common.Address][]byte {
+	keys := make(map[common.Address][]byte)
+	c.getTransitionValue(blockNumber, func(transition params.Transition) {
+		for addr, key := range transition.BLSPublicKeys {
+			keys[addr] = key
+		}
+	})
+	return keys
+}
+
+// DropUnprovenBLSPublicKeys removes the BLS public keys registered by transitions without a
+// valid proof of possession and returns the validators they were registered for
+func (c *Config) DropUnprovenBLSPublicKeys() []common.Address {
+	var dropped []common.Address
+	transitions := make([]params.Transition, len(c.Transitions))
+	for i, t := range c.Transitions {
+		transitions[i] = t
+		if len(t.BLSPublicKeys) == 0 {
+			continue
+		}
+		transitions[i].BLSPublicKeys = make(map[common.Address]hexutil.Bytes, len(t.BLSPublicKeys))
+		for addr, key := range t.BLSPublicKeys {
+			if bls.VerifyPossession(key, t.BLSProofsOfPossession[addr]) != nil {
+				dropped = append(dropped, addr)
+				continue
+			}
+			transitions[i].BLSPublicKeys[addr] = key
+		}
+	}
+	if c.Transitions != nil {
+		c.Transitions = transitions
+	}
+	return dropped
+}
+
 func (c *Config) getTransitionValue(num *big.Int, callback func(transition params.Transition)) {
 	if c != nil && num != nil && c.Transitions != nil {
 		for i := 0; i < len(c.Transitions) && c.Transitions[i].Block.Cmp(num) <= 0; i++ {
@@ -22,6 +22,8 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/bls"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/naoina/toml"
 	"github.com/stretchr/testify/assert"
@@ -181,3 +183,42 @@ func TestGetValidatorContractAddress(t *testing.T) {
 		}
 	}
 }
+
+func TestDropUnprovenBLSPublicKeys(t *testing.T) {
+	key, err := bls.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := bls.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof, err := key.ProvePossession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherProof, err := other.ProvePossession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	proven, missing, mismatched := common.HexToAddress("0x1"), common.HexToAddress("0x2"), common.HexToAddress("0x3")
+	transitions := []params.Transition{{
+		Block: big.NewInt(0),
+		BLSPublicKeys: map[common.Address]hexutil.Bytes{
+			proven:     key.PublicKey(),
+			missing:    key.PublicKey(),
+			mismatched: key.PublicKey(),
+		},
+		BLSProofsOfPossession: map[common.Address]hexutil.Bytes{
+			proven:     proof,
+			mismatched: otherProof,
+		},
+	}}
+	config := &Config{Transitions: transitions}
+
+	dropped := config.DropUnprovenBLSPublicKeys()
+
+	assert.ElementsMatch(t, []common.Address{missing, mismatched}, dropped)
+	assert.Equal(t, map[common.Address][]byte{proven: key.PublicKey()}, config.GetBLSPublicKeys(big.NewInt(0)))
+	assert.Len(t, transitions[0].BLSPublicKeys, 3, "the given transitions must not be modified")
+}
@@ -122,6 +122,10 @@ func (self *testSystemBackend) SignWithoutHashing(data []byte) ([]byte, error) {
 	return self.address.Bytes(), nil
 }
 
+func (self *testSystemBackend) SignBLS(data []byte) ([]byte, error) {
+	return nil, nil
+}
+
 func (self *testSystemBackend) CheckSignature([]byte, common.Address, []byte) error {
 	return nil
 }
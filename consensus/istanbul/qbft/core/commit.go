@@ -18,7 +18,10 @@ package core
 
 import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/bls"
+	istanbulcommon "github.com/ethereum/go-ethereum/consensus/istanbul/common"
 	qbfttypes "github.com/ethereum/go-ethereum/consensus/istanbul/qbft/types"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/validator"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
 )
@@ -40,7 +43,13 @@ func (c *core) broadcastCommit() {
 		header = block.Header()
 	}
 	// Create Commit Seal
-	commitSeal, err := c.backend.SignWithoutHashing(PrepareCommittedSeal(header, uint32(c.currentView().Round.Uint64())))
+	var commitSeal []byte
+	seal := PrepareCommittedSeal(header, uint32(c.currentView().Round.Uint64()))
+	if c.config.GetBLSSealsEnabled(sub.View.Sequence) {
+		commitSeal, err = c.backend.SignBLS(seal)
+	} else {
+		commitSeal, err = c.backend.SignWithoutHashing(seal)
+	}
 	if err != nil {
 		logger.Error("QBFT: failed to create COMMIT seal", "sub", sub, "err", err)
 		return
@@ -96,6 +105,14 @@ func (c *core) handleCommitMsg(commit *qbfttypes.Commit) error {
 		return errInvalidMessage
 	}
 
+	// A bad BLS seal would spoil the aggregate so it is checked before being counted
+	if c.config.GetBLSSealsEnabled(commit.Sequence) {
+		if err := c.verifyBLSCommitSeal(commit); err != nil {
+			logger.Error("QBFT: invalid COMMIT message BLS seal", "err", err)
+			return errInvalidMessage
+		}
+	}
+
 	// Add to received msgs
 	if err := c.current.QBFTCommits.Add(commit); err != nil {
 		c.logger.Error("QBFT: failed to save COMMIT message", "err", err)
@@ -125,11 +142,16 @@ func (c *core) commitQBFT() {
 	proposal := c.current.Proposal()
 	if proposal != nil {
 		// Compute committed seals
-		committedSeals := make([][]byte, c.current.QBFTCommits.Size())
-		for i, msg := range c.current.QBFTCommits.Values() {
-			committedSeals[i] = make([]byte, types.IstanbulExtraSeal)
-			commitMsg := msg.(*qbfttypes.Commit)
-			copy(committedSeals[i][:], commitMsg.CommitSeal[:])
+		var committedSeals [][]byte
+		if c.config.GetBLSSealsEnabled(c.current.Sequence()) {
+			committedSeals = c.blsCommittedSeals()
+		} else {
+			committedSeals = make([][]byte, c.current.QBFTCommits.Size())
+			for i, msg := range c.current.QBFTCommits.Values() {
+				committedSeals[i] = make([]byte, types.IstanbulExtraSeal)
+				commitMsg := msg.(*qbfttypes.Commit)
+				copy(committedSeals[i][:], commitMsg.CommitSeal[:])
+			}
 		}
 
 		// Commit proposal to database
@@ -140,3 +162,36 @@ func (c *core) commitQBFT() {
 		}
 	}
 }
+
+// blsCommittedSeals returns the BLS seals of the received commits indexed by the position of their
+// sender in the sorted validator set, which is the layout expected for the aggregated seal bitmap
+func (c *core) blsCommittedSeals() [][]byte {
+	validators := validator.SortedAddresses(c.valSet.List())
+	committedSeals := make([][]byte, len(validators))
+	for _, msg := range c.current.QBFTCommits.Values() {
+		commitMsg := msg.(*qbfttypes.Commit)
+		for i, addr := range validators {
+			if addr == commitMsg.Source() {
+				committedSeals[i] = commitMsg.CommitSeal
+				break
+			}
+		}
+	}
+	return committedSeals
+}
+
+// verifyBLSCommitSeal checks the BLS seal of a commit against the key registered for its sender
+func (c *core) verifyBLSCommitSeal(commit *qbfttypes.Commit) error {
+	key, ok := c.config.GetBLSPublicKeys(commit.Sequence)[commit.Source()]
+	if !ok {
+		return istanbulcommon.ErrInvalidCommittedSeals
+	}
+	var header *types.Header
+	if block, ok := c.current.Proposal().(*types.Block); ok {
+		header = block.Header()
+	}
+	if header == nil {
+		return istanbulcommon.ErrInvalidCommittedSeals
+	}
+	return bls.Verify(key, PrepareCommittedSeal(header, uint32(commit.Round.Uint64())), commit.CommitSeal)
+}
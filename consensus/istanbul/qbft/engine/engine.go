@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/bls"
 	istanbulcommon "github.com/ethereum/go-ethereum/consensus/istanbul/common"
 	"github.com/ethereum/go-ethereum/consensus/istanbul/validator"
 	"github.com/ethereum/go-ethereum/core/state"
@@ -46,6 +47,13 @@ func (e *Engine) Author(header *types.Header) (common.Address, error) {
 }
 
 func (e *Engine) CommitHeader(header *types.Header, seals [][]byte, round *big.Int) error {
+	if e.cfg.GetBLSSealsEnabled(header.Number) {
+		return ApplyHeaderQBFTExtra(
+			header,
+			writeAggregatedSeal(seals),
+			writeRoundNumber(round),
+		)
+	}
 	return ApplyHeaderQBFTExtra(
 		header,
 		writeCommittedSeals(seals),
@@ -53,6 +61,37 @@ func (e *Engine) CommitHeader(header *types.Header, seals [][]byte, round *big.I
 	)
 }
 
+// writeAggregatedSeal writes the extra-data field of a block header with the BLS aggregate of the given seals.
+// Seals are indexed by the position of their signer in the sorted validator set, missing signers are left empty.
+func writeAggregatedSeal(seals [][]byte) ApplyQBFTExtra {
+	return func(qbftExtra *types.QBFTExtra) error {
+		bitmap := make([]byte, (len(seals)+7)/8)
+		signatures := make([][]byte, 0, len(seals))
+		for i, seal := range seals {
+			if len(seal) == 0 {
+				continue
+			}
+			if len(seal) != bls.SignatureLength {
+				return istanbulcommon.ErrInvalidCommittedSeals
+			}
+			bitmap[i/8] |= 1 << uint(i%8)
+			signatures = append(signatures, seal)
+		}
+		if len(signatures) == 0 {
+			return istanbulcommon.ErrInvalidCommittedSeals
+		}
+
+		aggregated, err := bls.Aggregate(signatures)
+		if err != nil {
+			return err
+		}
+		qbftExtra.CommittedSeal = [][]byte{}
+		qbftExtra.AggregatedSeal = aggregated
+		qbftExtra.SealBitmap = bitmap
+		return nil
+	}
+}
+
 // writeCommittedSeals writes the extra-data field of a block header with given committed seals.
 func writeCommittedSeals(committedSeals [][]byte) ApplyQBFTExtra {
 	return func(qbftExtra *types.QBFTExtra) error {
@@ -255,6 +294,12 @@ func (e *Engine) verifyCommittedSeals(chain consensus.ChainHeaderReader, header
 	if err != nil {
 		return err
 	}
+	if e.cfg.GetBLSSealsEnabled(header.Number) {
+		return e.verifyAggregatedSeal(header, extra, validators)
+	}
+	if len(extra.AggregatedSeal) > 0 || len(extra.SealBitmap) > 0 {
+		return istanbulcommon.ErrInvalidCommittedSeals
+	}
 	committedSeal := extra.CommittedSeal
 
 	// The length of Committed seals should be larger than 0
@@ -287,6 +332,70 @@ func (e *Engine) verifyCommittedSeals(chain consensus.ChainHeaderReader, header
 	return nil
 }
 
+// verifyAggregatedSeal checks the BLS aggregate against the registered keys of the validators marked in the bitmap
+func (e *Engine) verifyAggregatedSeal(header *types.Header, extra *types.QBFTExtra, validators istanbul.ValidatorSet) error {
+	if len(extra.AggregatedSeal) == 0 {
+		return istanbulcommon.ErrEmptyCommittedSeals
+	}
+	if len(extra.CommittedSeal) > 0 {
+		return istanbulcommon.ErrInvalidCommittedSeals
+	}
+
+	committers, err := aggregatedSigners(extra, validators)
+	if err != nil {
+		return err
+	}
+
+	keys := e.cfg.GetBLSPublicKeys(header.Number)
+	pubkeys := make([][]byte, 0, len(committers))
+	for _, addr := range committers {
+		key, ok := keys[addr]
+		if !ok {
+			return istanbulcommon.ErrInvalidCommittedSeals
+		}
+		pubkeys = append(pubkeys, key)
+	}
+	if err := bls.VerifyAggregate(pubkeys, PrepareCommittedSeal(header, extra.Round), extra.AggregatedSeal); err != nil {
+		return istanbulcommon.ErrInvalidCommittedSeals
+	}
+
+	// The number of signers should be larger than number of faulty node + 1
+	if len(committers) <= validators.F() {
+		return istanbulcommon.ErrInvalidCommittedSeals
+	}
+
+	return nil
+}
+
+// AggregatedSigners returns the validators marked in the seal bitmap of a header carrying an aggregated seal.
+// validators must be the validator set of the parent block.
+func (e *Engine) AggregatedSigners(header *types.Header, validators istanbul.ValidatorSet) ([]common.Address, error) {
+	extra, err := types.ExtractQBFTExtra(header)
+	if err != nil {
+		return []common.Address{}, err
+	}
+	return aggregatedSigners(extra, validators)
+}
+
+func aggregatedSigners(extra *types.QBFTExtra, validators istanbul.ValidatorSet) ([]common.Address, error) {
+	sorted := validator.SortedAddresses(validators.List())
+	if len(extra.SealBitmap) != (len(sorted)+7)/8 {
+		return nil, istanbulcommon.ErrInvalidCommittedSeals
+	}
+
+	var addrs []common.Address
+	for i := 0; i < len(extra.SealBitmap)*8; i++ {
+		if extra.SealBitmap[i/8]&(1<<uint(i%8)) == 0 {
+			continue
+		}
+		if i >= len(sorted) {
+			return nil, istanbulcommon.ErrInvalidCommittedSeals
+		}
+		addrs = append(addrs, sorted[i])
+	}
+	return addrs, nil
+}
+
 // VerifyUncles verifies that the given block's uncles conform to the consensus
 // rules of a given engine.
 func (e *Engine) VerifyUncles(chain consensus.ChainReader, block *types.Block) error {
@@ -8,8 +8,12 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/bls"
 	istanbulcommon "github.com/ethereum/go-ethereum/consensus/istanbul/common"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/validator"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
 )
 
 func TestPrepareExtra(t *testing.T) {
@@ -159,3 +163,77 @@ func TestWriteValidatorVote(t *testing.T) {
 		t.Errorf("extra data mismatch: have %v, want %v", istExtra, expectedIstExtra)
 	}
 }
+
+func TestVerifyAggregatedSeal(t *testing.T) {
+	var (
+		keys       []*bls.SecretKey
+		validators []common.Address
+	)
+	cfg := &istanbul.Config{
+		Transitions: []params.Transition{{
+			Block:                 big.NewInt(0),
+			BLSSealsEnabled:       new(bool),
+			BLSPublicKeys:         make(map[common.Address]hexutil.Bytes),
+			BLSProofsOfPossession: make(map[common.Address]hexutil.Bytes),
+		}},
+	}
+	*cfg.Transitions[0].BLSSealsEnabled = true
+	for i := 0; i < 4; i++ {
+		key, err := bls.GenerateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		addr := common.BytesToAddress([]byte{byte(i + 1)})
+		keys = append(keys, key)
+		validators = append(validators, addr)
+		proof, err := key.ProvePossession()
+		if err != nil {
+			t.Fatal(err)
+		}
+		cfg.Transitions[0].BLSPublicKeys[addr] = key.PublicKey()
+		cfg.Transitions[0].BLSProofsOfPossession[addr] = proof
+	}
+	valSet := validator.NewSet(validators, istanbul.NewRoundRobinProposerPolicy())
+	engine := NewEngine(cfg, common.Address{}, nil)
+
+	h := &types.Header{Number: big.NewInt(1)}
+	if err := ApplyHeaderQBFTExtra(h, WriteValidators(validators)); err != nil {
+		t.Fatal(err)
+	}
+	seal := PrepareCommittedSeal(h, 0)
+	seals := make([][]byte, len(validators))
+	for i := 0; i < 3; i++ {
+		sig, err := keys[i].Sign(seal)
+		if err != nil {
+			t.Fatal(err)
+		}
+		seals[i] = sig
+	}
+	if err := engine.CommitHeader(h, seals, big.NewInt(0)); err != nil {
+		t.Fatalf("error mismatch: have %v, want nil", err)
+	}
+
+	extra, err := types.ExtractQBFTExtra(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(extra.CommittedSeal) != 0 || !bytes.Equal(extra.SealBitmap, []byte{0x07}) {
+		t.Errorf("seal mismatch: have %d committed seals and bitmap %x", len(extra.CommittedSeal), extra.SealBitmap)
+	}
+	signers, err := engine.AggregatedSigners(h, valSet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(signers, validators[:3]) {
+		t.Errorf("signers mismatch: have %v, want %v", signers, validators[:3])
+	}
+	if err := engine.verifyAggregatedSeal(h, extra, valSet); err != nil {
+		t.Errorf("error mismatch: have %v, want nil", err)
+	}
+
+	// a tampered bitmap no longer matches the aggregate
+	extra.SealBitmap = []byte{0x0e}
+	if err := engine.verifyAggregatedSeal(h, extra, valSet); err != istanbulcommon.ErrInvalidCommittedSeals {
+		t.Errorf("error mismatch: have %v, want %v", err, istanbulcommon.ErrInvalidCommittedSeals)
+	}
+}
@@ -0,0 +1,34 @@
+// Package finality implements self-contained finality proofs for QBFT blocks.
+//
+// A proof is made of a contiguous run of headers together with the lineage of
+// validator sets that sealed them. It can be checked against a trusted checkpoint
+// without access to a running node or the chain database.
+package finality
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Checkpoint is the trusted starting point of a verification, usually a block the
+// verifier has already accepted together with the validator set that seals its child.
+type Checkpoint struct {
+	Number     uint64           `json:"number"`
+	Hash       common.Hash      `json:"hash"`
+	Validators []common.Address `json:"validators"`
+}
+
+// ValidatorSetChange records the validator set in charge of sealing blocks starting
+// at Block, and the selection mode that produced it.
+type ValidatorSetChange struct {
+	Block      uint64           `json:"block"`
+	Mode       string           `json:"mode"`
+	Validators []common.Address `json:"validators"`
+}
+
+// FinalityProof is the header chain between two blocks together with every validator
+// set change that happened in that range.
+type FinalityProof struct {
+	Headers       []*types.Header       `json:"headers"`
+	ValidatorSets []*ValidatorSetChange `json:"validatorSets"`
+}
@@ -0,0 +1,404 @@
+package finality
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/bls"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var (
+	// ErrEmptyProof is returned if the proof does not contain any header.
+	ErrEmptyProof = errors.New("finality proof contains no headers")
+
+	// ErrNoCheckpoint is returned if no trusted checkpoint is given.
+	ErrNoCheckpoint = errors.New("no trusted checkpoint")
+
+	// ErrBrokenHeaderChain is returned if the headers are not contiguous or do not
+	// link back to the trusted checkpoint.
+	ErrBrokenHeaderChain = errors.New("finality proof headers do not form a chain from the checkpoint")
+
+	// ErrInvalidValidatorSetChange is returned if a validator set change is out of
+	// order, out of range or cannot be justified by the previous validator set.
+	ErrInvalidValidatorSetChange = errors.New("invalid validator set change")
+
+	// ErrInsufficientSeals is returned if a header is not sealed by a quorum of its
+	// validator set.
+	ErrInsufficientSeals = errors.New("insufficient committed seals")
+
+	// ErrUnknownSealer is returned if a committed seal is not produced by a member of
+	// the validator set in charge of the header.
+	ErrUnknownSealer = errors.New("committed seal from unknown validator")
+)
+
+// Config holds the subset of the QBFT configuration needed to follow the
+// validator set across transitions.
+type Config struct {
+	ValidatorSelectionMode string              // Selection mode at genesis, defaults to block header mode
+	Epoch                  uint64              // Number of blocks after which pending votes are reset, defaults to 30000
+	Ceil2Nby3Block         *big.Int            // Block from which ceil(2N/3) seals are required instead of 2F+1
+	Transitions            []params.Transition // Transitions as configured in the genesis file
+}
+
+// defaultEpoch is the epoch length used by the Istanbul engines when none is configured.
+const defaultEpoch = 30000
+
+// Verifier checks finality proofs against a trusted checkpoint.
+type Verifier struct {
+	config Config
+}
+
+// NewVerifier returns a verifier following the given consensus configuration. BLS public
+// keys registered without a valid proof of possession are ignored.
+func NewVerifier(config Config) *Verifier {
+	transitions := make([]params.Transition, len(config.Transitions))
+	for i, t := range config.Transitions {
+		transitions[i] = t
+		if len(t.BLSPublicKeys) == 0 {
+			continue
+		}
+		transitions[i].BLSPublicKeys = make(map[common.Address]hexutil.Bytes, len(t.BLSPublicKeys))
+		for addr, key := range t.BLSPublicKeys {
+			if bls.VerifyPossession(key, t.BLSProofsOfPossession[addr]) == nil {
+				transitions[i].BLSPublicKeys[addr] = key
+			}
+		}
+	}
+	config.Transitions = transitions
+	return &Verifier{config: config}
+}
+
+// Verify checks that every header of the proof descends from the checkpoint and is
+// sealed by a quorum of the validator set in charge at its height. In block header
+// mode the validator set is followed by replaying the votes of the headers and the
+// configured transitions, every change of the proof must match it. Pending votes
+// cast before the checkpoint are not known to the verifier, so checkpoints should be
+// taken at epoch boundaries. In contract mode a change must be endorsed by a quorum
+// of the outgoing validators. It returns the last verified header.
+func (v *Verifier) Verify(checkpoint *Checkpoint, proof *FinalityProof) (*types.Header, error) {
+	if proof == nil || len(proof.Headers) == 0 {
+		return nil, ErrEmptyProof
+	}
+	if checkpoint == nil {
+		return nil, ErrNoCheckpoint
+	}
+	if len(checkpoint.Validators) == 0 {
+		return nil, fmt.Errorf("%w: checkpoint has no validators", ErrInvalidValidatorSetChange)
+	}
+
+	changes := make(map[uint64]*ValidatorSetChange, len(proof.ValidatorSets))
+	for _, change := range proof.ValidatorSets {
+		if change.Block <= checkpoint.Number || change.Block > checkpoint.Number+uint64(len(proof.Headers)) {
+			return nil, fmt.Errorf("%w: block %d out of range", ErrInvalidValidatorSetChange, change.Block)
+		}
+		if _, ok := changes[change.Block]; ok {
+			return nil, fmt.Errorf("%w: duplicate change at block %d", ErrInvalidValidatorSetChange, change.Block)
+		}
+		changes[change.Block] = change
+	}
+
+	var (
+		parentHash = checkpoint.Hash
+		validators = checkpoint.Validators
+		derived    = checkpoint.Validators
+		votes      = newVoteTally()
+	)
+	if configured := v.transitionValidators(checkpoint.Number); len(configured) > 0 && v.selectionMode(checkpoint.Number) == params.BlockHeaderMode {
+		derived = configured
+	}
+	for i, header := range proof.Headers {
+		number := checkpoint.Number + uint64(i) + 1
+		if header.Number == nil || header.Number.Uint64() != number || header.ParentHash != parentHash {
+			return nil, ErrBrokenHeaderChain
+		}
+		if header.MixDigest != types.IstanbulDigest {
+			return nil, fmt.Errorf("block %d: invalid Istanbul mix digest", number)
+		}
+		extra, err := types.ExtractQBFTExtra(header)
+		if err != nil {
+			return nil, fmt.Errorf("block %d: %v", number, err)
+		}
+
+		mode := v.selectionMode(number - 1)
+		change, changed := changes[number]
+		current := validators
+		if changed {
+			current = change.Validators
+		}
+		var sealers []common.Address
+		if len(extra.AggregatedSeal) > 0 {
+			sealers, err = v.aggregatedSealers(number, header, extra, current)
+		} else {
+			sealers, err = recoverSealers(header, extra)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("block %d: %w", number, err)
+		}
+
+		if changed {
+			if err := v.checkChange(number, change, mode, validators, derived, sealers); err != nil {
+				return nil, err
+			}
+			validators = current
+		} else if mode == params.BlockHeaderMode && !sameSet(derived, validators) {
+			return nil, fmt.Errorf("%w: block %d misses the change voted by the validators", ErrInvalidValidatorSetChange, number)
+		}
+		if mode == params.BlockHeaderMode && !sameSet(extra.Validators, validators) {
+			return nil, fmt.Errorf("%w: block %d extra-data validators differ from proof", ErrInvalidValidatorSetChange, number)
+		}
+		if err := v.checkQuorum(number, validators, sealers); err != nil {
+			return nil, err
+		}
+		derived = validators
+		if v.selectionMode(number) == params.BlockHeaderMode {
+			if mode != params.BlockHeaderMode {
+				votes = newVoteTally()
+			}
+			if derived, err = v.applyVote(number, header, extra, votes, validators); err != nil {
+				return nil, err
+			}
+		}
+		parentHash = header.Hash()
+	}
+	return proof.Headers[len(proof.Headers)-1], nil
+}
+
+// checkChange validates the hand over from the outgoing validator set to the one
+// sealing block number onwards. In block header mode the incoming set must be the
+// one derived from the votes and transitions up to the parent block, which were all
+// sealed by a quorum of the outgoing set. In contract mode the validator contract
+// cannot be read from headers, so the hand over must be sealed by 2F+1 of the
+// outgoing validators.
+func (v *Verifier) checkChange(number uint64, change *ValidatorSetChange, mode string, previous, derived, sealers []common.Address) error {
+	if change.Mode != mode {
+		return fmt.Errorf("%w: block %d has mode %q, expected %q", ErrInvalidValidatorSetChange, number, change.Mode, mode)
+	}
+	if len(change.Validators) == 0 {
+		return fmt.Errorf("%w: block %d has an empty validator set", ErrInvalidValidatorSetChange, number)
+	}
+	if mode == params.BlockHeaderMode {
+		if !sameSet(derived, change.Validators) {
+			return fmt.Errorf("%w: block %d does not match the votes and transitions of the chain", ErrInvalidValidatorSetChange, number)
+		}
+		return nil
+	}
+	endorsed := 0
+	for _, sealer := range sealers {
+		if contains(previous, sealer) {
+			endorsed++
+		}
+	}
+	if endorsed < 2*faulty(len(previous))+1 {
+		return fmt.Errorf("%w: block %d endorsed by %d of %d outgoing validators", ErrInvalidValidatorSetChange, number, endorsed, len(previous))
+	}
+	return nil
+}
+
+// applyVote tallies the vote carried by the header the same way the Istanbul
+// snapshot does and returns the validator set in charge of the next block.
+func (v *Verifier) applyVote(number uint64, header *types.Header, extra *types.QBFTExtra, votes *voteTally, validators []common.Address) ([]common.Address, error) {
+	if number%v.epoch(number) == 0 {
+		votes.reset()
+	}
+	if !contains(validators, header.Coinbase) {
+		return nil, fmt.Errorf("%w: block %d proposed by %s", ErrUnknownSealer, number, header.Coinbase.Hex())
+	}
+	next := validators
+	if vote := extra.Vote; vote != nil {
+		var authorize bool
+		switch vote.VoteType {
+		case types.QBFTAuthVote:
+			authorize = true
+		case types.QBFTDropVote:
+		default:
+			return nil, fmt.Errorf("%w: block %d has an invalid vote", ErrInvalidValidatorSetChange, number)
+		}
+		next = votes.apply(header.Coinbase, vote.RecipientAddress, authorize, validators)
+	}
+	if configured := v.transitionValidators(number); len(configured) > 0 {
+		next = configured
+	}
+	return next, nil
+}
+
+// checkQuorum ensures that every sealer belongs to validators and that there are
+// enough of them, using the same confirmation formula as the QBFT core.
+func (v *Verifier) checkQuorum(number uint64, validators []common.Address, sealers []common.Address) error {
+	for _, sealer := range sealers {
+		if !contains(validators, sealer) {
+			return fmt.Errorf("%w: block %d sealed by %s", ErrUnknownSealer, number, sealer.Hex())
+		}
+	}
+	quorum := 2*faulty(len(validators)) + 1
+	height := new(big.Int).SetUint64(number)
+	if !v.twoFPlusOneEnabled(height) && v.config.Ceil2Nby3Block != nil && height.Cmp(v.config.Ceil2Nby3Block) >= 0 {
+		quorum = int(math.Ceil(float64(2*len(validators)) / 3))
+	}
+	if len(sealers) < quorum {
+		return fmt.Errorf("%w: block %d has %d seals, need %d", ErrInsufficientSeals, number, len(sealers), quorum)
+	}
+	return nil
+}
+
+// aggregatedSealers returns the validators marked in the seal bitmap once their BLS aggregate has been
+// checked against the public keys registered in the transitions.
+func (v *Verifier) aggregatedSealers(number uint64, header *types.Header, extra *types.QBFTExtra, validators []common.Address) ([]common.Address, error) {
+	sorted := sortedAddresses(validators)
+	if len(extra.SealBitmap) != (len(sorted)+7)/8 {
+		return nil, ErrInsufficientSeals
+	}
+	keys := v.blsPublicKeys(number)
+	var (
+		sealers []common.Address
+		pubkeys [][]byte
+	)
+	for i := 0; i < len(extra.SealBitmap)*8; i++ {
+		if extra.SealBitmap[i/8]&(1<<uint(i%8)) == 0 {
+			continue
+		}
+		if i >= len(sorted) {
+			return nil, ErrUnknownSealer
+		}
+		key, ok := keys[sorted[i]]
+		if !ok {
+			return nil, fmt.Errorf("%w: no BLS key registered for %s", ErrUnknownSealer, sorted[i].Hex())
+		}
+		sealers = append(sealers, sorted[i])
+		pubkeys = append(pubkeys, key)
+	}
+	hash := types.CopyHeader(header).QBFTHashWithRoundNumber(extra.Round)
+	if err := bls.VerifyAggregate(pubkeys, hash.Bytes(), extra.AggregatedSeal); err != nil {
+		return nil, err
+	}
+	return sealers, nil
+}
+
+func (v *Verifier) blsPublicKeys(number uint64) map[common.Address][]byte {
+	keys := make(map[common.Address][]byte)
+	v.eachTransition(new(big.Int).SetUint64(number), func(t params.Transition) {
+		for addr, key := range t.BLSPublicKeys {
+			keys[addr] = key
+		}
+	})
+	return keys
+}
+
+func (v *Verifier) epoch(number uint64) uint64 {
+	epoch := v.config.Epoch
+	if epoch == 0 {
+		epoch = defaultEpoch
+	}
+	v.eachTransition(new(big.Int).SetUint64(number), func(t params.Transition) {
+		if t.EpochLength != 0 {
+			epoch = t.EpochLength
+		}
+	})
+	return epoch
+}
+
+func (v *Verifier) selectionMode(number uint64) string {
+	mode := params.BlockHeaderMode
+	if v.config.ValidatorSelectionMode != "" {
+		mode = v.config.ValidatorSelectionMode
+	}
+	v.eachTransition(new(big.Int).SetUint64(number), func(t params.Transition) {
+		if t.ValidatorSelectionMode != "" {
+			mode = t.ValidatorSelectionMode
+		}
+	})
+	return mode
+}
+
+func (v *Verifier) transitionValidators(number uint64) []common.Address {
+	for _, t := range v.config.Transitions {
+		if t.Block != nil && t.Block.Uint64() == number && len(t.Validators) > 0 {
+			return t.Validators
+		}
+	}
+	return nil
+}
+
+func (v *Verifier) twoFPlusOneEnabled(number *big.Int) bool {
+	enabled := false
+	v.eachTransition(number, func(t params.Transition) {
+		if t.TwoFPlusOneEnabled != nil {
+			enabled = *t.TwoFPlusOneEnabled
+		}
+	})
+	return enabled
+}
+
+func (v *Verifier) eachTransition(number *big.Int, callback func(params.Transition)) {
+	for _, t := range v.config.Transitions {
+		if t.Block == nil || t.Block.Cmp(number) > 0 {
+			break
+		}
+		callback(t)
+	}
+}
+
+// recoverSealers returns the distinct addresses that produced the committed seals of
+// the header.
+func recoverSealers(header *types.Header, extra *types.QBFTExtra) ([]common.Address, error) {
+	if len(extra.CommittedSeal) == 0 {
+		return nil, ErrInsufficientSeals
+	}
+	hash := types.CopyHeader(header).QBFTHashWithRoundNumber(extra.Round)
+	sealers := make([]common.Address, 0, len(extra.CommittedSeal))
+	for _, seal := range extra.CommittedSeal {
+		pubkey, err := crypto.SigToPub(hash.Bytes(), seal)
+		if err != nil {
+			return nil, err
+		}
+		addr := crypto.PubkeyToAddress(*pubkey)
+		if contains(sealers, addr) {
+			return nil, fmt.Errorf("duplicate committed seal from %s", addr.Hex())
+		}
+		sealers = append(sealers, addr)
+	}
+	return sealers, nil
+}
+
+// faulty returns the number of faulty nodes tolerated by a set of n validators.
+func faulty(n int) int {
+	return int(math.Ceil(float64(n)/3)) - 1
+}
+
+func sortedAddresses(addrs []common.Address) []common.Address {
+	sorted := make([]common.Address, len(addrs))
+	copy(sorted, addrs)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i][:], sorted[j][:]) < 0
+	})
+	return sorted
+}
+
+func contains(addrs []common.Address, addr common.Address) bool {
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
+
+func sameSet(a, b []common.Address) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, addr := range a {
+		if !contains(b, addr) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,277 @@
+package finality
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+func newKeys(t *testing.T, n int) ([]*ecdsa.PrivateKey, []common.Address) {
+	keys := make([]*ecdsa.PrivateKey, n)
+	addrs := make([]common.Address, n)
+	for i := range keys {
+		key, err := crypto.GenerateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys[i] = key
+		addrs[i] = crypto.PubkeyToAddress(key.PublicKey)
+	}
+	return keys, addrs
+}
+
+func newHeader(t *testing.T, parent common.Hash, number uint64, validators []common.Address, sealers []*ecdsa.PrivateKey) *types.Header {
+	return newVoteHeader(t, parent, number, validators, sealers, nil)
+}
+
+// newVoteHeader returns a header proposed by the first sealer and carrying the given vote.
+func newVoteHeader(t *testing.T, parent common.Hash, number uint64, validators []common.Address, sealers []*ecdsa.PrivateKey, vote *types.ValidatorVote) *types.Header {
+	header := &types.Header{
+		ParentHash: parent,
+		Number:     new(big.Int).SetUint64(number),
+		Difficulty: big.NewInt(1),
+		MixDigest:  types.IstanbulDigest,
+		Time:       number,
+	}
+	if len(sealers) > 0 {
+		header.Coinbase = crypto.PubkeyToAddress(sealers[0].PublicKey)
+	}
+	extra := &types.QBFTExtra{
+		VanityData:    make([]byte, types.IstanbulExtraVanity),
+		Validators:    validators,
+		Vote:          vote,
+		CommittedSeal: [][]byte{},
+	}
+	payload, err := rlp.EncodeToBytes(extra)
+	if err != nil {
+		t.Fatal(err)
+	}
+	header.Extra = payload
+
+	hash := header.QBFTHashWithRoundNumber(0)
+	for _, key := range sealers {
+		seal, err := crypto.Sign(hash.Bytes(), key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		extra.CommittedSeal = append(extra.CommittedSeal, seal)
+	}
+	if header.Extra, err = rlp.EncodeToBytes(extra); err != nil {
+		t.Fatal(err)
+	}
+	return header
+}
+
+func TestVerify_whenTypical(t *testing.T) {
+	keys, addrs := newKeys(t, 4)
+	checkpoint := &Checkpoint{Number: 10, Hash: common.HexToHash("0x01"), Validators: addrs}
+
+	first := newHeader(t, checkpoint.Hash, 11, addrs, keys[:3])
+	second := newHeader(t, first.Hash(), 12, addrs, keys)
+
+	last, err := NewVerifier(Config{}).Verify(checkpoint, &FinalityProof{Headers: []*types.Header{first, second}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if last.Hash() != second.Hash() {
+		t.Errorf("last verified header mismatch: have %v, want %v", last.Hash(), second.Hash())
+	}
+}
+
+func TestVerify_whenNotEnoughSeals(t *testing.T) {
+	keys, addrs := newKeys(t, 4)
+	checkpoint := &Checkpoint{Number: 10, Hash: common.HexToHash("0x01"), Validators: addrs}
+
+	header := newHeader(t, checkpoint.Hash, 11, addrs, keys[:2])
+
+	_, err := NewVerifier(Config{}).Verify(checkpoint, &FinalityProof{Headers: []*types.Header{header}})
+	if !errors.Is(err, ErrInsufficientSeals) {
+		t.Errorf("error mismatch: have %v, want %v", err, ErrInsufficientSeals)
+	}
+}
+
+func TestVerify_whenNoCheckpoint(t *testing.T) {
+	keys, addrs := newKeys(t, 4)
+
+	header := newHeader(t, common.HexToHash("0x01"), 11, addrs, keys)
+
+	_, err := NewVerifier(Config{}).Verify(nil, &FinalityProof{Headers: []*types.Header{header}})
+	if err != ErrNoCheckpoint {
+		t.Errorf("error mismatch: have %v, want %v", err, ErrNoCheckpoint)
+	}
+}
+
+func TestVerify_whenBrokenChain(t *testing.T) {
+	keys, addrs := newKeys(t, 4)
+	checkpoint := &Checkpoint{Number: 10, Hash: common.HexToHash("0x01"), Validators: addrs}
+
+	first := newHeader(t, checkpoint.Hash, 11, addrs, keys)
+	second := newHeader(t, common.HexToHash("0x02"), 12, addrs, keys)
+
+	_, err := NewVerifier(Config{}).Verify(checkpoint, &FinalityProof{Headers: []*types.Header{first, second}})
+	if err != ErrBrokenHeaderChain {
+		t.Errorf("error mismatch: have %v, want %v", err, ErrBrokenHeaderChain)
+	}
+}
+
+func TestVerify_whenUnknownSealer(t *testing.T) {
+	keys, addrs := newKeys(t, 4)
+	outsiders, _ := newKeys(t, 1)
+	checkpoint := &Checkpoint{Number: 10, Hash: common.HexToHash("0x01"), Validators: addrs}
+
+	header := newHeader(t, checkpoint.Hash, 11, addrs, append(keys[:3], outsiders...))
+
+	_, err := NewVerifier(Config{}).Verify(checkpoint, &FinalityProof{Headers: []*types.Header{header}})
+	if !errors.Is(err, ErrUnknownSealer) {
+		t.Errorf("error mismatch: have %v, want %v", err, ErrUnknownSealer)
+	}
+}
+
+func TestVerify_whenValidatorAddedByVote(t *testing.T) {
+	keys, addrs := newKeys(t, 5)
+	checkpoint := &Checkpoint{Number: 10, Hash: common.HexToHash("0x01"), Validators: addrs[:4]}
+	vote := &types.ValidatorVote{RecipientAddress: addrs[4], VoteType: types.QBFTAuthVote}
+
+	// three of the four validators propose a block voting for the candidate
+	var headers []*types.Header
+	parent := checkpoint.Hash
+	for i := 0; i < 3; i++ {
+		sealers := append([]*ecdsa.PrivateKey{keys[i]}, keys[(i+1)%3], keys[3])
+		header := newVoteHeader(t, parent, uint64(11+i), addrs[:4], sealers, vote)
+		headers = append(headers, header)
+		parent = header.Hash()
+	}
+	headers = append(headers, newHeader(t, parent, 14, addrs, keys[1:]))
+	proof := &FinalityProof{
+		Headers:       headers,
+		ValidatorSets: []*ValidatorSetChange{{Block: 14, Mode: params.BlockHeaderMode, Validators: addrs}},
+	}
+
+	if _, err := NewVerifier(Config{}).Verify(checkpoint, proof); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestVerify_whenValidatorAddedWithoutMajority(t *testing.T) {
+	keys, addrs := newKeys(t, 5)
+	checkpoint := &Checkpoint{Number: 10, Hash: common.HexToHash("0x01"), Validators: addrs[:4]}
+	vote := &types.ValidatorVote{RecipientAddress: addrs[4], VoteType: types.QBFTAuthVote}
+
+	first := newVoteHeader(t, checkpoint.Hash, 11, addrs[:4], keys[:3], vote)
+	second := newVoteHeader(t, first.Hash(), 12, addrs[:4], keys[1:4], vote)
+	third := newHeader(t, second.Hash(), 13, addrs, keys[1:])
+	proof := &FinalityProof{
+		Headers:       []*types.Header{first, second, third},
+		ValidatorSets: []*ValidatorSetChange{{Block: 13, Mode: params.BlockHeaderMode, Validators: addrs}},
+	}
+
+	_, err := NewVerifier(Config{}).Verify(checkpoint, proof)
+	if !errors.Is(err, ErrInvalidValidatorSetChange) {
+		t.Errorf("error mismatch: have %v, want %v", err, ErrInvalidValidatorSetChange)
+	}
+}
+
+func TestVerify_whenHandOverForgedByFPlusOne(t *testing.T) {
+	oldKeys, oldAddrs := newKeys(t, 4)
+	forgedKeys, forgedAddrs := newKeys(t, 2)
+	checkpoint := &Checkpoint{Number: 10, Hash: common.HexToHash("0x01"), Validators: oldAddrs}
+
+	// f+1 outgoing validators colluding with two keys of their own
+	nextKeys := append([]*ecdsa.PrivateKey{oldKeys[0], oldKeys[1]}, forgedKeys...)
+	nextAddrs := append([]common.Address{oldAddrs[0], oldAddrs[1]}, forgedAddrs...)
+
+	for _, mode := range []string{params.BlockHeaderMode, params.ContractMode} {
+		extraValidators := nextAddrs
+		if mode == params.ContractMode {
+			extraValidators = []common.Address{}
+		}
+		header := newHeader(t, checkpoint.Hash, 11, extraValidators, nextKeys)
+		proof := &FinalityProof{
+			Headers:       []*types.Header{header},
+			ValidatorSets: []*ValidatorSetChange{{Block: 11, Mode: mode, Validators: nextAddrs}},
+		}
+
+		_, err := NewVerifier(Config{ValidatorSelectionMode: mode}).Verify(checkpoint, proof)
+		if !errors.Is(err, ErrInvalidValidatorSetChange) {
+			t.Errorf("%s: error mismatch: have %v, want %v", mode, err, ErrInvalidValidatorSetChange)
+		}
+	}
+}
+
+func TestVerify_whenContractModeHandOverEndorsed(t *testing.T) {
+	oldKeys, oldAddrs := newKeys(t, 4)
+	nextKeys, nextAddrs := newKeys(t, 1)
+	checkpoint := &Checkpoint{Number: 10, Hash: common.HexToHash("0x01"), Validators: oldAddrs}
+	config := Config{ValidatorSelectionMode: params.ContractMode}
+
+	// the outgoing validators hand over to a set with one more member
+	nextAddrs = append(oldAddrs[:4:4], nextAddrs...)
+	header := newHeader(t, checkpoint.Hash, 11, []common.Address{}, append(oldKeys[:3:3], nextKeys...))
+	proof := &FinalityProof{
+		Headers:       []*types.Header{header},
+		ValidatorSets: []*ValidatorSetChange{{Block: 11, Mode: params.ContractMode, Validators: nextAddrs}},
+	}
+
+	if _, err := NewVerifier(config).Verify(checkpoint, proof); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestVerify_whenValidatorSetNotInExtraData(t *testing.T) {
+	keys, addrs := newKeys(t, 5)
+	checkpoint := &Checkpoint{Number: 10, Hash: common.HexToHash("0x01"), Validators: addrs[:4]}
+
+	header := newHeader(t, checkpoint.Hash, 11, addrs[:4], keys[1:])
+	proof := &FinalityProof{
+		Headers:       []*types.Header{header},
+		ValidatorSets: []*ValidatorSetChange{{Block: 11, Mode: params.BlockHeaderMode, Validators: addrs}},
+	}
+
+	_, err := NewVerifier(Config{}).Verify(checkpoint, proof)
+	if !errors.Is(err, ErrInvalidValidatorSetChange) {
+		t.Errorf("error mismatch: have %v, want %v", err, ErrInvalidValidatorSetChange)
+	}
+}
+
+func TestVerify_whenContractModeHandOverNotEndorsed(t *testing.T) {
+	oldKeys, oldAddrs := newKeys(t, 4)
+	nextKeys, nextAddrs := newKeys(t, 4)
+	checkpoint := &Checkpoint{Number: 10, Hash: common.HexToHash("0x01"), Validators: oldAddrs}
+	config := Config{ValidatorSelectionMode: params.ContractMode}
+
+	first := newHeader(t, checkpoint.Hash, 11, []common.Address{}, oldKeys)
+	second := newHeader(t, first.Hash(), 12, []common.Address{}, nextKeys)
+	proof := &FinalityProof{
+		Headers:       []*types.Header{first, second},
+		ValidatorSets: []*ValidatorSetChange{{Block: 12, Mode: params.ContractMode, Validators: nextAddrs}},
+	}
+
+	_, err := NewVerifier(config).Verify(checkpoint, proof)
+	if !errors.Is(err, ErrInvalidValidatorSetChange) {
+		t.Errorf("error mismatch: have %v, want %v", err, ErrInvalidValidatorSetChange)
+	}
+}
+
+func TestVerify_whenTransitionReplacesValidators(t *testing.T) {
+	_, oldAddrs := newKeys(t, 4)
+	nextKeys, nextAddrs := newKeys(t, 4)
+	checkpoint := &Checkpoint{Number: 10, Hash: common.HexToHash("0x01"), Validators: oldAddrs}
+	config := Config{Transitions: []params.Transition{{Block: big.NewInt(10), Validators: nextAddrs}}}
+
+	header := newHeader(t, checkpoint.Hash, 11, nextAddrs, nextKeys)
+	proof := &FinalityProof{
+		Headers:       []*types.Header{header},
+		ValidatorSets: []*ValidatorSetChange{{Block: 11, Mode: params.BlockHeaderMode, Validators: nextAddrs}},
+	}
+
+	if _, err := NewVerifier(config).Verify(checkpoint, proof); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,111 @@
+package finality
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// vote is a validator vote found in a header of the proof.
+type vote struct {
+	validator common.Address
+	candidate common.Address
+	authorize bool
+}
+
+// tally is the running count of the votes on a candidate.
+type tally struct {
+	authorize bool
+	votes     int
+}
+
+// voteTally replays header votes the same way as the Istanbul snapshot, starting
+// from an empty tally at the checkpoint.
+type voteTally struct {
+	votes []*vote
+	tally map[common.Address]tally
+}
+
+func newVoteTally() *voteTally {
+	return &voteTally{tally: make(map[common.Address]tally)}
+}
+
+func (t *voteTally) reset() {
+	t.votes = nil
+	t.tally = make(map[common.Address]tally)
+}
+
+// apply records the vote of validator on candidate and returns the resulting
+// validator set.
+func (t *voteTally) apply(validator, candidate common.Address, authorize bool, validators []common.Address) []common.Address {
+	// Discard any previous vote of the validator on the candidate
+	for i, v := range t.votes {
+		if v.validator == validator && v.candidate == candidate {
+			t.uncast(v.candidate, v.authorize)
+			t.votes = append(t.votes[:i], t.votes[i+1:]...)
+			break
+		}
+	}
+	if t.cast(candidate, authorize, validators) {
+		t.votes = append(t.votes, &vote{validator: validator, candidate: candidate, authorize: authorize})
+	}
+
+	current := t.tally[candidate]
+	if current.votes <= len(validators)/2 {
+		return validators
+	}
+	next := make([]common.Address, 0, len(validators)+1)
+	if current.authorize {
+		next = append(next, validators...)
+		next = append(next, candidate)
+	} else {
+		for _, addr := range validators {
+			if addr != candidate {
+				next = append(next, addr)
+			}
+		}
+		// Discard any previous votes the deauthorized validator cast
+		for i := 0; i < len(t.votes); i++ {
+			if t.votes[i].validator == candidate {
+				t.uncast(t.votes[i].candidate, t.votes[i].authorize)
+				t.votes = append(t.votes[:i], t.votes[i+1:]...)
+				i--
+			}
+		}
+	}
+	// Discard any previous votes around the just changed account
+	for i := 0; i < len(t.votes); i++ {
+		if t.votes[i].candidate == candidate {
+			t.votes = append(t.votes[:i], t.votes[i+1:]...)
+			i--
+		}
+	}
+	delete(t.tally, candidate)
+	return next
+}
+
+// cast adds a new vote into the tally if it makes sense for the current validators.
+func (t *voteTally) cast(candidate common.Address, authorize bool, validators []common.Address) bool {
+	if contains(validators, candidate) == authorize {
+		return false
+	}
+	if old, ok := t.tally[candidate]; ok {
+		old.votes++
+		t.tally[candidate] = old
+	} else {
+		t.tally[candidate] = tally{authorize: authorize, votes: 1}
+	}
+	return true
+}
+
+// uncast removes a previously cast vote from the tally.
+func (t *voteTally) uncast(candidate common.Address, authorize bool) {
+	old, ok := t.tally[candidate]
+	if !ok || old.authorize != authorize {
+		return
+	}
+	if old.votes > 1 {
+		old.votes--
+		t.tally[candidate] = old
+	} else {
+		delete(t.tally, candidate)
+	}
+}
@@ -87,5 +87,11 @@ var (
 
 	// ErrPrivateContractInteractionVerificationFailed is returned if the verification of contract interaction differs from the one returned by Tessera (check pmh.verify(...))
 	ErrPrivateContractInteractionVerificationFailed = errors.New("verification of contract interaction differs from the one returned by Tessera")
+
+	// ErrInvalidPrivatePayloadHash is returned if the data of a typed private transaction is not an encrypted payload hash
+	ErrInvalidPrivatePayloadHash = errors.New("private transaction data must be the hash of the encrypted payload")
+
+	// ErrPrivacyFlagMismatch is returned if the privacy flag of a typed private transaction differs from the one returned by Tessera
+	ErrPrivacyFlagMismatch = errors.New("privacy flag of the transaction differs from the one returned by Tessera")
 	// End Quorum
 )
@@ -0,0 +1,43 @@
+package rawdb
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// eventSinkCursorKey tracks the last block delivered to the event sink plugin
+var eventSinkCursorKey = []byte("quorumEventSinkCursor")
+
+// EventSinkCursor identifies the last block delivered to the event sink plugin
+type EventSinkCursor struct {
+	Number uint64
+	Hash   common.Hash
+}
+
+// ReadEventSinkCursor retrieves the last block delivered to the event sink plugin,
+// nil if no block has been delivered yet
+func ReadEventSinkCursor(db ethdb.KeyValueReader) *EventSinkCursor {
+	data, _ := db.Get(eventSinkCursorKey)
+	if len(data) == 0 {
+		return nil
+	}
+	cursor := new(EventSinkCursor)
+	if err := rlp.DecodeBytes(data, cursor); err != nil {
+		log.Error("Invalid event sink cursor RLP", "err", err)
+		return nil
+	}
+	return cursor
+}
+
+// WriteEventSinkCursor stores the last block delivered to the event sink plugin
+func WriteEventSinkCursor(db ethdb.KeyValueWriter, cursor *EventSinkCursor) {
+	data, err := rlp.EncodeToBytes(cursor)
+	if err != nil {
+		log.Crit("Failed to RLP encode event sink cursor", "err", err)
+	}
+	if err := db.Put(eventSinkCursorKey, data); err != nil {
+		log.Crit("Failed to store event sink cursor", "err", err)
+	}
+}
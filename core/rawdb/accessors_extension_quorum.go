@@ -0,0 +1,73 @@
+package rawdb
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	// extensionContractPrefix + management contract address + psi -> extension contract
+	extensionContractPrefix = []byte("QEXT")
+	// extensionContractsStoredKey is set once the extension contracts are
+	// stored in the database, migrated or rebuilt
+	extensionContractsStoredKey = []byte("quorumExtensionsStored")
+)
+
+func extensionContractKey(psi types.PrivateStateIdentifier, address common.Address) []byte {
+	key := append(append([]byte{}, extensionContractPrefix...), address.Bytes()...)
+	return append(key, []byte(psi)...)
+}
+
+// ReadExtensionContracts returns the encoded extension contracts, keyed by
+// private state and management contract address
+func ReadExtensionContracts(db ethdb.Iteratee) map[types.PrivateStateIdentifier]map[common.Address][]byte {
+	contracts := make(map[types.PrivateStateIdentifier]map[common.Address][]byte)
+	it := db.NewIterator(extensionContractPrefix, nil)
+	defer it.Release()
+	for it.Next() {
+		key := it.Key()
+		if len(key) < len(extensionContractPrefix)+common.AddressLength {
+			continue
+		}
+		address := common.BytesToAddress(key[len(extensionContractPrefix) : len(extensionContractPrefix)+common.AddressLength])
+		psi := types.PrivateStateIdentifier(key[len(extensionContractPrefix)+common.AddressLength:])
+		if contracts[psi] == nil {
+			contracts[psi] = make(map[common.Address][]byte)
+		}
+		contracts[psi][address] = common.CopyBytes(it.Value())
+	}
+	return contracts
+}
+
+// WriteExtensionContract stores the encoded extension contract of a management
+// contract in a private state
+func WriteExtensionContract(db ethdb.KeyValueWriter, psi types.PrivateStateIdentifier, address common.Address, data []byte) {
+	if err := db.Put(extensionContractKey(psi, address), data); err != nil {
+		log.Crit("Failed to store extension contract", "err", err)
+	}
+}
+
+// DeleteExtensionContract removes the extension contract of a management
+// contract in a private state
+func DeleteExtensionContract(db ethdb.KeyValueWriter, psi types.PrivateStateIdentifier, address common.Address) {
+	if err := db.Delete(extensionContractKey(psi, address)); err != nil {
+		log.Crit("Failed to delete extension contract", "err", err)
+	}
+}
+
+// ReadExtensionContractsStored returns whether the extension contracts are
+// stored in the database
+func ReadExtensionContractsStored(db ethdb.KeyValueReader) bool {
+	stored, _ := db.Has(extensionContractsStoredKey)
+	return stored
+}
+
+// WriteExtensionContractsStored marks the extension contracts as stored in the
+// database
+func WriteExtensionContractsStored(db ethdb.KeyValueWriter) {
+	if err := db.Put(extensionContractsStoredKey, []byte{1}); err != nil {
+		log.Crit("Failed to store extension contracts marker", "err", err)
+	}
+}
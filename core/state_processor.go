@@ -109,11 +109,17 @@ func (p *StateProcessor) Process(block *types.Block, statedb *state.StateDB, pri
 			if err := core.CheckAccountPermission(tx.From(), tx.To(), tx.Value(), tx.Data(), tx.Gas(), tx.GasPrice()); err != nil {
 				return nil, nil, nil, 0, fmt.Errorf("could not apply tx %d [%v]: %w", i, tx.Hash().Hex(), err)
 			}
+			if err := checkAccessRules(p.config, header, tx); err != nil {
+				return nil, nil, nil, 0, fmt.Errorf("could not apply tx %d [%v]: %w", i, tx.Hash().Hex(), err)
+			}
 		}
 
 		if p.config.IsQuorum && !p.config.IsGasPriceEnabled(header.Number) && tx.GasPrice() != nil && tx.GasPrice().Cmp(common.Big0) > 0 {
 			return nil, nil, nil, 0, fmt.Errorf("could not apply tx %d [%v]: %w", i, tx.Hash().Hex(), ErrInvalidGasPrice)
 		}
+		if tx.Type() == types.PrivateTxType && !p.config.IsPrivateTxTypeEnabled(header.Number) {
+			return nil, nil, nil, 0, fmt.Errorf("could not apply tx %d [%v]: %w", i, tx.Hash().Hex(), ErrTxTypeNotSupported)
+		}
 
 		msg, err := tx.AsMessage(types.MakeSigner(p.config, header.Number))
 		if err != nil {
@@ -408,11 +414,17 @@ func ApplyTransaction(config *params.ChainConfig, bc ChainContext, author *commo
 		if err := core.CheckAccountPermission(tx.From(), tx.To(), tx.Value(), tx.Data(), tx.Gas(), tx.GasPrice()); err != nil {
 			return nil, nil, err
 		}
+		if err := checkAccessRules(config, header, tx); err != nil {
+			return nil, nil, err
+		}
 	}
 
 	if config.IsQuorum && !config.IsGasPriceEnabled(header.Number) && tx.GasPrice() != nil && tx.GasPrice().Cmp(common.Big0) > 0 {
 		return nil, nil, ErrInvalidGasPrice
 	}
+	if tx.Type() == types.PrivateTxType && !config.IsPrivateTxTypeEnabled(header.Number) {
+		return nil, nil, ErrTxTypeNotSupported
+	}
 
 	msg, err := tx.AsMessage(types.MakeSigner(config, header.Number))
 	if err != nil {
@@ -438,6 +450,18 @@ func ApplyTransaction(config *params.ChainConfig, bc ChainContext, author *commo
 
 // Quorum
 
+// Quorum
+//
+// checkAccessRules applies the access rules of the sender once the chain config
+// names the contract holding them, so that all the nodes enforce the same rules.
+// A privacy marker is not subject to them, its private transaction is
+func checkAccessRules(config *params.ChainConfig, header *types.Header, tx *types.Transaction) error {
+	if tx.IsPrivacyMarker() || config.GetAccessRuleManagerAddress(header.Number) == (common.Address{}) {
+		return nil
+	}
+	return core.CheckTransactionAccessRules(tx.From(), tx.To(), tx.Value(), tx.Data(), tx.IsPrivate())
+}
+
 // ApplyInnerTransaction is called from within the Quorum precompile for privacy marker transactions.
 // It's a call back which essentially duplicates the logic in Process(),
 // in this case to process the actual private transaction.
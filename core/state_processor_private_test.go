@@ -0,0 +1,132 @@
+package core
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/private"
+	"github.com/ethereum/go-ethereum/private/engine"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInsertChain_whenTypedPrivateTxPrivacyFlagMismatch(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	var (
+		matchingPayload   = common.BytesToEncryptedPayloadHash([]byte("matchingPayload"))
+		mismatchedPayload = common.BytesToEncryptedPayloadHash([]byte("mismatchedPayload"))
+		matchingContract  = crypto.CreateAddress(testAddress, 0)
+		mismatchContract  = crypto.CreateAddress(testAddress, 1)
+	)
+	mockptm := private.NewMockPrivateTransactionManager(mockCtrl)
+	mockptm.EXPECT().Receive(matchingPayload).Return("", []string{"AAA"}, common.FromHex(testCode), &engine.ExtraMetadata{PrivacyFlag: engine.PrivacyFlagPartyProtection}, nil).AnyTimes()
+	// the payload was sent as standard private while the transaction is signed with party protection
+	mockptm.EXPECT().Receive(mismatchedPayload).Return("", []string{"AAA"}, common.FromHex(testCode), &engine.ExtraMetadata{PrivacyFlag: engine.PrivacyFlagStandardPrivate}, nil).AnyTimes()
+	saved := private.P
+	defer func() {
+		private.P = saved
+	}()
+	private.P = mockptm
+
+	config := *params.QuorumTestChainConfig
+	config.BerlinBlock = common.Big0
+	config.PrivacyEnhancementsBlock = common.Big0
+	config.PrivateTxTypeBlock = common.Big0
+	signer := types.LatestSignerForChainID(config.ChainID)
+	testdb := rawdb.NewMemoryDatabase()
+	genesis := GenesisBlockForTesting(testdb, testAddress, big.NewInt(1000000000))
+	blocks, _ := GenerateChain(&config, genesis, ethash.NewFaker(), testdb, 1, func(i int, block *BlockGen) {
+		block.SetCoinbase(common.Address{0})
+		for _, payload := range []common.EncryptedPayloadHash{matchingPayload, mismatchedPayload} {
+			tx, err := types.SignTx(types.NewTx(&types.PrivateTx{
+				Nonce:       block.TxNonce(testAddress),
+				GasPrice:    common.Big0,
+				Gas:         testGas,
+				Value:       common.Big0,
+				Data:        payload.Bytes(),
+				PrivacyFlag: engine.PrivacyFlagPartyProtection,
+			}), signer, testKey)
+			require.NoError(t, err)
+			block.AddTx(tx)
+		}
+	})
+
+	// import the block in a new chain
+	testdb = rawdb.NewMemoryDatabase()
+	GenesisBlockForTesting(testdb, testAddress, big.NewInt(1000000000))
+	blockchain, err := NewBlockChain(testdb, nil, &config, ethash.NewFaker(), vm.Config{}, nil, nil, nil)
+	require.NoError(t, err)
+	defer blockchain.Stop()
+
+	count, err := blockchain.InsertChain(blocks)
+
+	require.NoError(t, err)
+	assert.Equal(t, len(blocks), count)
+	publicState, privateStateRepo, err := blockchain.StateAt(blocks[0].Root())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), publicState.GetNonce(testAddress), "the public state is the same as on the nodes not party to the transactions")
+	privateState, err := privateStateRepo.DefaultState()
+	require.NoError(t, err)
+	assert.NotZero(t, privateState.GetCodeSize(matchingContract))
+	assert.Zero(t, privateState.GetCodeSize(mismatchContract), "the payload of the transaction with a mismatched privacy flag must not be applied")
+	receipts := blockchain.GetReceiptsByHash(blocks[0].Hash())
+	require.Len(t, receipts, 2)
+	assert.Equal(t, types.ReceiptStatusSuccessful, receipts[0].Status)
+	assert.Equal(t, types.ReceiptStatusFailed, receipts[1].Status)
+}
+
+func TestInsertChain_whenTypedPrivateTxNotEnabled(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	payload := common.BytesToEncryptedPayloadHash([]byte("payload"))
+	mockptm := private.NewMockPrivateTransactionManager(mockCtrl)
+	mockptm.EXPECT().Receive(payload).Return("", []string{"AAA"}, common.FromHex(testCode), &engine.ExtraMetadata{PrivacyFlag: engine.PrivacyFlagStandardPrivate}, nil).AnyTimes()
+	saved := private.P
+	defer func() {
+		private.P = saved
+	}()
+	private.P = mockptm
+
+	config := *params.QuorumTestChainConfig
+	config.BerlinBlock = common.Big0
+	config.PrivateTxTypeBlock = big.NewInt(2)
+	signer := types.LatestSignerForChainID(config.ChainID)
+	testdb := rawdb.NewMemoryDatabase()
+	genesis := GenesisBlockForTesting(testdb, testAddress, big.NewInt(1000000000))
+	// the block is generated as if the private transaction type was enabled
+	enabled := config
+	enabled.PrivateTxTypeBlock = common.Big0
+	blocks, _ := GenerateChain(&enabled, genesis, ethash.NewFaker(), testdb, 1, func(i int, block *BlockGen) {
+		block.SetCoinbase(common.Address{0})
+		tx, err := types.SignTx(types.NewTx(&types.PrivateTx{
+			Nonce:    block.TxNonce(testAddress),
+			GasPrice: common.Big0,
+			Gas:      testGas,
+			Value:    common.Big0,
+			Data:     payload.Bytes(),
+		}), signer, testKey)
+		require.NoError(t, err)
+		block.AddTx(tx)
+	})
+
+	testdb = rawdb.NewMemoryDatabase()
+	GenesisBlockForTesting(testdb, testAddress, big.NewInt(1000000000))
+	blockchain, err := NewBlockChain(testdb, nil, &config, ethash.NewFaker(), vm.Config{}, nil, nil, nil)
+	require.NoError(t, err)
+	defer blockchain.Stop()
+
+	_, err = blockchain.InsertChain(blocks)
+
+	assert.ErrorIs(t, err, ErrTxTypeNotSupported)
+}
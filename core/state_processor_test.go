@@ -28,6 +28,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
+	pcore "github.com/ethereum/go-ethereum/permission/core"
 	"github.com/ethereum/go-ethereum/trie"
 	"golang.org/x/crypto/sha3"
 )
@@ -156,3 +157,41 @@ func GenerateBadBlock(parent *types.Block, engine consensus.Engine, txs types.Tr
 	// Assemble and return the final block for sealing
 	return types.NewBlock(header, txs, nil, receipts, trie.NewStackTrie(nil))
 }
+
+// Quorum
+func TestCheckAccessRules_whenChainConfigNamesAccessRuleManager(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	target := common.BytesToAddress([]byte("target"))
+
+	defer func(model pcore.PermissionModelType) { pcore.PermissionModel = model }(pcore.PermissionModel)
+	pcore.SetDefaults("NWADMIN", "ORGADMIN", true)
+	pcore.SetQIP714BlockReached()
+	pcore.OrgInfoMap = pcore.NewOrgCache(params.DEFAULT_ORGCACHE_SIZE)
+	pcore.RoleInfoMap = pcore.NewRoleCache(params.DEFAULT_ROLECACHE_SIZE)
+	pcore.AcctInfoMap = pcore.NewAcctCache(params.DEFAULT_ACCOUNTCACHE_SIZE)
+	pcore.AccessRuleMap = pcore.NewAccessRuleCache()
+	defer func() { pcore.AccessRuleMap = nil }()
+	pcore.OrgInfoMap.UpsertOrg("ORG1", "", "ORG1", big.NewInt(1), pcore.OrgApproved)
+	pcore.RoleInfoMap.UpsertRole("ORG1", "MEMBER", false, false, pcore.Transact, true)
+	pcore.AcctInfoMap.UpsertAccount("ORG1", "MEMBER", from, false, pcore.AcctActive)
+	pcore.AccessRuleMap.UpsertAccessRule("ORG1", "MEMBER", target, pcore.FunctionSelector{}, false, nil)
+
+	manager := common.BytesToAddress([]byte("access rule manager"))
+	config := *params.QuorumTestChainConfig
+	config.Transitions = []params.Transition{{Block: big.NewInt(10), AccessRuleManagerAddress: &manager}}
+
+	signer := types.LatestSigner(&config)
+	tx, _ := types.SignTx(types.NewTransaction(0, target, big.NewInt(1), params.TxGas, big.NewInt(0), nil), signer, key)
+	pmt, _ := types.SignTx(types.NewTransaction(0, common.QuorumPrivacyPrecompileContractAddress(), big.NewInt(0), params.TxGas, big.NewInt(0), nil), signer, key)
+
+	if err := checkAccessRules(&config, &types.Header{Number: big.NewInt(9)}, tx); err != nil {
+		t.Fatalf("expected no access rules before the transition, got %v", err)
+	}
+	if err := checkAccessRules(&config, &types.Header{Number: big.NewInt(10)}, tx); err != pcore.ErrAccessRuleDenied {
+		t.Fatalf("expected %v, got %v", pcore.ErrAccessRuleDenied, err)
+	}
+	if err := checkAccessRules(&config, &types.Header{Number: big.NewInt(10)}, pmt); err != nil {
+		t.Fatalf("expected no access rules for a privacy marker, got %v", err)
+	}
+}
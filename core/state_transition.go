@@ -30,6 +30,7 @@ import (
 	"github.com/ethereum/go-ethereum/multitenancy"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/private"
+	"github.com/ethereum/go-ethereum/private/engine"
 )
 
 /*
@@ -118,6 +119,7 @@ type PrivateMessage interface {
 	Message
 	IsPrivate() bool
 	IsInnerPrivate() bool
+	SignedPrivacyFlag() (engine.PrivacyFlagType, bool)
 }
 
 // IntrinsicGas computes the 'intrinsic gas' for a message with the given data.
@@ -271,7 +273,10 @@ func (st *StateTransition) TransitionDb() (*ExecutionResult, error) {
 	isQuorum := st.evm.ChainConfig().IsQuorum
 	snapshot := st.evm.StateDB.Snapshot()
 
-	var data []byte
+	var (
+		data           []byte
+		privacyFlagErr error // the privacy flag of a typed private tx differs from the payload one
+	)
 	isPrivate := false
 	publicState := st.state
 	pmh := newPMH(st)
@@ -280,6 +285,17 @@ func (st *StateTransition) TransitionDb() (*ExecutionResult, error) {
 		pmh.snapshot = snapshot
 		pmh.eph = common.BytesToEncryptedPayloadHash(st.data)
 		_, _, data, pmh.receivedPrivacyMetadata, err = private.P.Receive(pmh.eph)
+		if err == nil {
+			pmh.hasPrivatePayload = data != nil
+			if privacyFlag, ok := msg.SignedPrivacyFlag(); ok {
+				pmh.signedPrivacyFlag = &privacyFlag
+			}
+			if privacyFlagErr = pmh.checkSignedPrivacyFlag(); privacyFlagErr != nil {
+				// the payload is ignored as done by the nodes which are not party to it,
+				// so that the public state is the same on all nodes
+				data, pmh.receivedPrivacyMetadata, pmh.hasPrivatePayload = nil, nil, false
+			}
+		}
 		// Increment the public account nonce if:
 		// 1. Tx is private and *not* a participant of the group and either call or create
 		// 2. Tx is private we are part of the group and is a call
@@ -294,8 +310,6 @@ func (st *StateTransition) TransitionDb() (*ExecutionResult, error) {
 			}, nil
 		}
 
-		pmh.hasPrivatePayload = data != nil
-
 		vmErr, consensusErr := pmh.prepare()
 		if consensusErr != nil || vmErr != nil {
 			return &ExecutionResult{
@@ -361,7 +375,7 @@ func (st *StateTransition) TransitionDb() (*ExecutionResult, error) {
 			st.state.AddBalance(st.evm.Context.Coinbase, new(big.Int).Mul(new(big.Int).SetUint64(st.gasUsed()), st.gasPrice))
 			return &ExecutionResult{
 				UsedGas:    0,
-				Err:        nil,
+				Err:        privacyFlagErr,
 				ReturnData: nil,
 			}, nil
 		}
@@ -415,6 +429,9 @@ func (st *StateTransition) TransitionDb() (*ExecutionResult, error) {
 	st.state.AddBalance(rewardAccount, new(big.Int).Mul(new(big.Int).SetUint64(st.gasUsed()), st.gasPrice))
 
 	if isPrivate {
+		if vmerr == nil {
+			vmerr = privacyFlagErr
+		}
 		return &ExecutionResult{
 			UsedGas:    0,
 			Err:        vmerr,
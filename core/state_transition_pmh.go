@@ -30,6 +30,7 @@ type privateMessageHandler struct {
 
 	snapshot                int
 	receivedPrivacyMetadata *engine.ExtraMetadata
+	signedPrivacyFlag       *engine.PrivacyFlagType // nil unless the transaction is a typed private transaction
 	eph                     common.EncryptedPayloadHash
 }
 
@@ -37,6 +38,24 @@ func (pmh *privateMessageHandler) mustVerify() bool {
 	return pmh.hasPrivatePayload && pmh.receivedPrivacyMetadata != nil && pmh.stAPI.IsPrivacyEnhancementsEnabled()
 }
 
+// checks the privacy flag signed in a typed private transaction is the one the payload was sent with,
+// otherwise the privacy enhancements checks could be bypassed by signing a different flag
+func (pmh *privateMessageHandler) checkSignedPrivacyFlag() error {
+	// the empty payload hash is used to apply the transaction to the private states which are not party to it
+	if pmh.signedPrivacyFlag == nil || !pmh.hasPrivatePayload || common.EmptyEncryptedPayloadHash(pmh.eph) {
+		return nil
+	}
+	receivedPrivacyFlag := engine.PrivacyFlagStandardPrivate
+	if pmh.receivedPrivacyMetadata != nil {
+		receivedPrivacyFlag = pmh.receivedPrivacyMetadata.PrivacyFlag
+	}
+	if *pmh.signedPrivacyFlag != receivedPrivacyFlag {
+		log.Debug(ErrPrivacyFlagMismatch.Error(), "signed", *pmh.signedPrivacyFlag, "received", receivedPrivacyFlag, "EPH", pmh.eph.ToBase64())
+		return ErrPrivacyFlagMismatch
+	}
+	return nil
+}
+
 // checks the privacy metadata in the state transition context
 // returns vmError if there is an error in the EVM execution
 // returns consensusErr if there is an error in the consensus execution
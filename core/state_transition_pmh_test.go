@@ -13,6 +13,8 @@ import (
 
 type stubPmhStateTransition struct {
 	snapshot int
+	affected []common.Address
+	metadata *state.PrivacyMetadata
 }
 
 func (s *stubPmhStateTransition) SetTxPrivacyMetadata(pm *types.PrivacyMetadata) {
@@ -27,6 +29,9 @@ func (s *stubPmhStateTransition) RevertToSnapshot(val int) {
 }
 
 func (s *stubPmhStateTransition) GetStatePrivacyMetadata(addr common.Address) (*state.PrivacyMetadata, error) {
+	if s.metadata != nil {
+		return s.metadata, nil
+	}
 	return &state.PrivacyMetadata{PrivacyFlag: engine.PrivacyFlagStateValidation, CreationTxHash: common.EncryptedPayloadHash{1}}, nil
 }
 
@@ -35,6 +40,9 @@ func (s *stubPmhStateTransition) CalculateMerkleRoot() (common.Hash, error) {
 }
 
 func (s *stubPmhStateTransition) AffectedContracts() []common.Address {
+	if s.affected != nil {
+		return s.affected
+	}
 	return make([]common.Address, 0)
 }
 
@@ -51,3 +59,59 @@ func TestPrivateMessageContextVerify_WithMerkleRootCreationError(t *testing.T) {
 	assert.Equal(pmc.snapshot, stateTransitionAPI.snapshot, "Revert should have been called")
 	assert.True(exitEarly, "Exit early should be true")
 }
+
+func TestPrivateMessageContextVerify_AfterParticipantsRemoved(t *testing.T) {
+	assert := testifyassert.New(t)
+	creationHash, removalHash := common.EncryptedPayloadHash{1}, common.EncryptedPayloadHash{2}
+	// the removal replaces the creation hash of the contract with the hash of
+	// the payload sent to the remaining parties
+	stateTransitionAPI := &stubPmhStateTransition{
+		affected: []common.Address{common.HexToAddress("0x2222")},
+		metadata: &state.PrivacyMetadata{PrivacyFlag: engine.PrivacyFlagPartyProtection, CreationTxHash: removalHash},
+	}
+
+	pmc := newPMH(stateTransitionAPI)
+	pmc.snapshot = 10
+	pmc.receivedPrivacyMetadata = &engine.ExtraMetadata{
+		ACHashes:    common.EncryptedPayloadHashes{creationHash: struct{}{}},
+		PrivacyFlag: engine.PrivacyFlagPartyProtection,
+	}
+	exitEarly, err := pmc.verify(nil)
+
+	assert.NoError(err)
+	assert.True(exitEarly, "a removed party only knows the previous creation hash and must fail the participation check")
+	assert.Equal(pmc.snapshot, stateTransitionAPI.snapshot, "Revert should have been called")
+
+	stateTransitionAPI.snapshot = 0
+	pmc.receivedPrivacyMetadata.ACHashes = common.EncryptedPayloadHashes{removalHash: struct{}{}}
+	exitEarly, err = pmc.verify(nil)
+
+	assert.NoError(err)
+	assert.False(exitEarly, "a remaining party must pass the participation check")
+	assert.Equal(0, stateTransitionAPI.snapshot)
+}
+
+func TestPrivateMessageContextCheckSignedPrivacyFlag(t *testing.T) {
+	partyProtection, standardPrivate := engine.PrivacyFlagPartyProtection, engine.PrivacyFlagStandardPrivate
+	for name, tc := range map[string]struct {
+		signed   *engine.PrivacyFlagType
+		received *engine.ExtraMetadata
+		eph      common.EncryptedPayloadHash
+		err      error
+	}{
+		"not a typed private tx":       {nil, &engine.ExtraMetadata{PrivacyFlag: engine.PrivacyFlagPartyProtection}, common.EncryptedPayloadHash{1}, nil},
+		"matching flag":                {&partyProtection, &engine.ExtraMetadata{PrivacyFlag: engine.PrivacyFlagPartyProtection}, common.EncryptedPayloadHash{1}, nil},
+		"standard private no metadata": {&standardPrivate, nil, common.EncryptedPayloadHash{1}, nil},
+		"mismatched flag":              {&partyProtection, &engine.ExtraMetadata{PrivacyFlag: engine.PrivacyFlagStandardPrivate}, common.EncryptedPayloadHash{1}, ErrPrivacyFlagMismatch},
+		"mismatched no metadata":       {&partyProtection, nil, common.EncryptedPayloadHash{1}, ErrPrivacyFlagMismatch},
+		"empty payload hash":           {&partyProtection, nil, common.EncryptedPayloadHash{}, nil},
+	} {
+		pmc := newPMH(&stubPmhStateTransition{})
+		pmc.hasPrivatePayload = true
+		pmc.signedPrivacyFlag = tc.signed
+		pmc.receivedPrivacyMetadata = tc.received
+		pmc.eph = tc.eph
+
+		testifyassert.Equal(t, tc.err, pmc.checkSignedPrivacyFlag(), name)
+	}
+}
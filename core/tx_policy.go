@@ -0,0 +1,138 @@
+// Quorum
+
+package core
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/plugin/txpolicy"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+const (
+	// remotePolicyCacheSize is the number of transaction policy decisions kept
+	remotePolicyCacheSize = 4096
+
+	// maxPendingPolicyEvaluations is the maximum number of remote transactions
+	// waiting for the transaction policy
+	maxPendingPolicyEvaluations = 1024
+
+	// maxConcurrentPolicyEvaluations is the maximum number of remote
+	// transactions evaluated at the same time
+	maxConcurrentPolicyEvaluations = 16
+)
+
+var (
+	// ErrPolicyEvaluationPending is returned if a remote transaction is added
+	// before the transaction policy allowed it. It is added once allowed.
+	ErrPolicyEvaluationPending = errors.New("transaction policy evaluation pending")
+
+	// ErrPolicyEvaluationOverflow is returned if too many remote transactions
+	// are waiting for the transaction policy
+	ErrPolicyEvaluationOverflow = errors.New("too many transactions waiting for the transaction policy")
+)
+
+// remoteTxPolicy evaluates the transactions received from peers against the
+// transaction policy. The evaluation runs in the background, outside the pool
+// lock, and its outcome is cached by transaction hash. An allowed transaction
+// is then added to the pool again.
+type remoteTxPolicy struct {
+	policy txpolicy.TransactionPolicy
+	signer types.Signer
+	add    func(*types.Transaction)
+
+	mu        sync.Mutex
+	decisions *lru.Cache // transaction hash -> error, nil if allowed
+	pending   map[common.Hash]struct{}
+
+	slots  chan struct{}
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newRemoteTxPolicy(policy txpolicy.TransactionPolicy, signer types.Signer, add func(*types.Transaction)) *remoteTxPolicy {
+	decisions, _ := lru.New(remotePolicyCacheSize)
+	ctx, cancel := context.WithCancel(context.Background())
+	return &remoteTxPolicy{
+		policy:    policy,
+		signer:    signer,
+		add:       add,
+		decisions: decisions,
+		pending:   make(map[common.Hash]struct{}),
+		slots:     make(chan struct{}, maxConcurrentPolicyEvaluations),
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+}
+
+// check returns nil if the policy allowed the transaction, or the reason it
+// can not be added yet. The evaluation of an unknown transaction is started.
+func (p *remoteTxPolicy) check(tx *types.Transaction) error {
+	hash := tx.Hash()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if decision, ok := p.decisions.Get(hash); ok {
+		if decision == nil {
+			return nil
+		}
+		return decision.(error)
+	}
+	if _, ok := p.pending[hash]; ok {
+		return ErrPolicyEvaluationPending
+	}
+	if len(p.pending) >= maxPendingPolicyEvaluations {
+		return ErrPolicyEvaluationOverflow
+	}
+	p.pending[hash] = struct{}{}
+	p.wg.Add(1)
+	go p.evaluate(tx)
+	return ErrPolicyEvaluationPending
+}
+
+func (p *remoteTxPolicy) evaluate(tx *types.Transaction) {
+	defer p.wg.Done()
+
+	select {
+	case p.slots <- struct{}{}:
+		defer func() { <-p.slots }()
+	case <-p.ctx.Done():
+		return
+	}
+	from, _ := types.Sender(p.signer, tx) // already validated
+	err := txpolicy.Check(p.ctx, p.policy, &txpolicy.EvaluationRequest{
+		Source:      txpolicy.SourceP2P,
+		Transaction: tx,
+		From:        from,
+	})
+	if p.ctx.Err() != nil {
+		return
+	}
+
+	p.mu.Lock()
+	delete(p.pending, tx.Hash())
+	if _, denied := err.(*txpolicy.DeniedError); err == nil || denied {
+		// an evaluation failure is retried the next time the transaction is received
+		p.decisions.Add(tx.Hash(), err)
+	}
+	p.mu.Unlock()
+
+	if err != nil {
+		log.Debug("Remote transaction not allowed by the transaction policy", "hash", tx.Hash(), "err", err)
+		return
+	}
+	p.add(tx)
+}
+
+// stop cancels the pending evaluations and waits for them to return
+func (p *remoteTxPolicy) stop() {
+	p.cancel()
+	p.wg.Wait()
+}
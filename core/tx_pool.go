@@ -34,6 +34,7 @@ import (
 	"github.com/ethereum/go-ethereum/metrics"
 	"github.com/ethereum/go-ethereum/params"
 	pcore "github.com/ethereum/go-ethereum/permission/core"
+	"github.com/ethereum/go-ethereum/plugin/txpolicy"
 	"github.com/ethereum/go-ethereum/private"
 )
 
@@ -265,6 +266,8 @@ type TxPool struct {
 	reorgDoneCh     chan chan struct{}
 	reorgShutdownCh chan struct{}  // requests shutdown of scheduleReorgLoop
 	wg              sync.WaitGroup // tracks loop, scheduleReorgLoop
+
+	remotePolicy *remoteTxPolicy // Quorum - optional policy evaluating the transactions received from peers
 }
 
 type txpoolResetRequest struct {
@@ -413,6 +416,11 @@ func (pool *TxPool) Stop() {
 	pool.chainHeadSub.Unsubscribe()
 	pool.wg.Wait()
 
+	// Quorum
+	if pool.remotePolicy != nil {
+		pool.remotePolicy.stop()
+	}
+
 	if pool.journal != nil {
 		pool.journal.close()
 	}
@@ -433,6 +441,18 @@ func (pool *TxPool) GasPrice() *big.Int {
 	return new(big.Int).Set(pool.gasPrice)
 }
 
+// Quorum
+//
+// SetRemoteTransactionPolicy sets the policy which transactions received from peers must satisfy. The
+// policy is evaluated in the background, a remote transaction is added to the pool once allowed. Local
+// transactions are evaluated by the RPC API before they are submitted. It must be called before any
+// remote transaction is added.
+func (pool *TxPool) SetRemoteTransactionPolicy(policy txpolicy.TransactionPolicy) {
+	pool.remotePolicy = newRemoteTxPolicy(policy, pool.signer, func(tx *types.Transaction) {
+		pool.AddRemotes([]*types.Transaction{tx})
+	})
+}
+
 // SetGasPrice updates the minimum price required by the transaction pool for a
 // new transaction, and drops all transactions below this threshold.
 func (pool *TxPool) SetGasPrice(price *big.Int) {
@@ -591,10 +611,33 @@ func (pool *TxPool) validateTx(tx *types.Transaction, local bool) error {
 		if tx.IsPrivate() && (len(tx.Data()) == 0 || tx.Value().Sign() != 0) {
 			return ErrEtherValueUnsupported
 		}
+		// Typed private transactions sign the encrypted payload hash along with the privacy flag
+		if tx.Type() == types.PrivateTxType {
+			if !pool.chainconfig.IsPrivateTxTypeEnabled(pool.chain.CurrentBlock().Number()) {
+				return ErrTxTypeNotSupported
+			}
+			if len(tx.Data()) != common.EncryptedPayloadHashLength {
+				return ErrInvalidPrivatePayloadHash
+			}
+			if err := tx.PrivacyFlag().Validate(); err != nil {
+				return err
+			}
+			if tx.PrivacyFlag().IsNotStandardPrivate() && !pool.chainconfig.IsPrivacyEnhancementsEnabled(pool.chain.CurrentBlock().Number()) {
+				return ErrPrivacyEnhancedReceivedWhenDisabled
+			}
+		}
 		// Quorum - check if the sender account is authorized to perform the transaction
 		if err := pcore.CheckAccountPermission(tx.From(), tx.To(), tx.Value(), tx.Data(), tx.Gas(), tx.GasPrice()); err != nil {
 			return err
 		}
+		// Quorum - check the contract and function level access rules of the sender,
+		// part of block validation once the chain config names their contract. The
+		// rules apply to the private transaction of a privacy marker, checked above
+		if !tx.IsPrivacyMarker() {
+			if err := pcore.CheckTransactionAccessRules(tx.From(), tx.To(), tx.Value(), tx.Data(), tx.IsPrivate()); err != nil {
+				return err
+			}
+		}
 	}
 	if !pool.chainconfig.IsQuorum || pool.chainconfig.IsGasPriceEnabled(pool.chain.CurrentBlock().Header().Number) {
 		// Drop non-local transactions under our own minimal accepted gas price
@@ -875,6 +918,13 @@ func (pool *TxPool) addTxs(txs []*types.Transaction, local, sync bool) []error {
 			invalidTxMeter.Mark(1)
 			continue
 		}
+		// Quorum - remote transactions are added once allowed by the transaction policy
+		if !local && pool.remotePolicy != nil {
+			if err := pool.remotePolicy.check(tx); err != nil {
+				errs[i] = err
+				continue
+			}
+		}
 		// Accumulate all unknown transactions for deeper processing
 		news = append(news, tx)
 	}
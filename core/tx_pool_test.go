@@ -17,6 +17,7 @@
 package core
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"errors"
 	"fmt"
@@ -25,6 +26,7 @@ import (
 	"math/rand"
 	"os"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 
@@ -36,6 +38,8 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/plugin/txpolicy"
+	"github.com/ethereum/go-ethereum/private/engine"
 	"github.com/ethereum/go-ethereum/trie"
 )
 
@@ -405,6 +409,122 @@ func TestValidateTx_whenValueNonZeroWithSmartContractForPrivateTransaction(t *te
 	}
 }
 
+func newTypedPrivateTransaction(data []byte, privacyFlag engine.PrivacyFlagType, key *ecdsa.PrivateKey) *types.Transaction {
+	tx, _ := types.SignTx(types.NewTx(&types.PrivateTx{
+		Nonce:       0,
+		GasPrice:    common.Big0,
+		Gas:         1000000,
+		To:          &common.Address{},
+		Value:       common.Big0,
+		Data:        data,
+		PrivacyFlag: privacyFlag,
+	}), types.LatestSignerForChainID(params.QuorumTestChainConfig.ChainID), key)
+	return tx
+}
+
+func setupBerlinQuorumTxPool(privacyEnhancementsBlock *big.Int) (*TxPool, *ecdsa.PrivateKey) {
+	config := *params.QuorumTestChainConfig
+	config.BerlinBlock = common.Big0
+	config.PrivacyEnhancementsBlock = privacyEnhancementsBlock
+	config.PrivateTxTypeBlock = common.Big0
+	return setupTxPoolWithConfig(&config)
+}
+
+func TestValidateTx_whenTypedPrivateTransactionNotEnabled(t *testing.T) {
+	config := *params.QuorumTestChainConfig
+	config.BerlinBlock = common.Big0
+	pool, key := setupTxPoolWithConfig(&config)
+	defer pool.Stop()
+
+	if err := pool.AddRemote(newTypedPrivateTransaction(common.EncryptedPayloadHash{1}.Bytes(), engine.PrivacyFlagStandardPrivate, key)); err != ErrTxTypeNotSupported {
+		t.Error("expected:", ErrTxTypeNotSupported, "; got:", err)
+	}
+}
+
+func TestValidateTx_whenTypedPrivateTransaction(t *testing.T) {
+	pool, key := setupBerlinQuorumTxPool(nil)
+	defer pool.Stop()
+
+	if err := pool.AddRemote(newTypedPrivateTransaction(common.EncryptedPayloadHash{1}.Bytes(), engine.PrivacyFlagStandardPrivate, key)); err != nil {
+		t.Error("expected no error; got", err)
+	}
+}
+
+func TestValidateTx_whenTypedPrivateTransactionWithoutPayloadHash(t *testing.T) {
+	pool, key := setupBerlinQuorumTxPool(nil)
+	defer pool.Stop()
+
+	if err := pool.AddRemote(newTypedPrivateTransaction([]byte("arbitrary bytecode"), engine.PrivacyFlagStandardPrivate, key)); err != ErrInvalidPrivatePayloadHash {
+		t.Error("expected:", ErrInvalidPrivatePayloadHash, "; got:", err)
+	}
+}
+
+func TestValidateTx_whenTypedPrivateTransactionWithPrivacyEnhancementsDisabled(t *testing.T) {
+	pool, key := setupBerlinQuorumTxPool(nil)
+	defer pool.Stop()
+
+	if err := pool.AddRemote(newTypedPrivateTransaction(common.EncryptedPayloadHash{1}.Bytes(), engine.PrivacyFlagStateValidation, key)); err != ErrPrivacyEnhancedReceivedWhenDisabled {
+		t.Error("expected:", ErrPrivacyEnhancedReceivedWhenDisabled, "; got:", err)
+	}
+}
+
+type stubTransactionPolicy struct {
+	mu        sync.Mutex
+	denied    map[common.Hash]string
+	evaluated []common.Hash
+}
+
+func (p *stubTransactionPolicy) Evaluate(_ context.Context, req *txpolicy.EvaluationRequest) (*txpolicy.Decision, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if req.Source != txpolicy.SourceP2P {
+		return nil, fmt.Errorf("unexpected source %s", req.Source)
+	}
+	p.evaluated = append(p.evaluated, req.Transaction.Hash())
+	reason, denied := p.denied[req.Transaction.Hash()]
+	return &txpolicy.Decision{Allowed: !denied, Reason: reason}, nil
+}
+
+func TestAddRemote_whenRemoteTransactionPolicySet(t *testing.T) {
+	pool, key := setupTxPool()
+	defer pool.Stop()
+	pool.currentState.AddBalance(crypto.PubkeyToAddress(key.PublicKey), big.NewInt(1000000))
+	allowedTx, deniedTx, localTx := transaction(0, 100000, key), transaction(1, 100000, key), transaction(2, 100000, key)
+	policy := &stubTransactionPolicy{denied: map[common.Hash]string{deniedTx.Hash(): "sanctioned"}}
+	pool.SetRemoteTransactionPolicy(policy)
+
+	// remote transactions are added once evaluated in the background
+	for _, tx := range []*types.Transaction{allowedTx, deniedTx} {
+		if err := pool.AddRemote(tx); err != ErrPolicyEvaluationPending {
+			t.Fatal("expected the evaluation to be pending, got:", err)
+		}
+	}
+	pool.remotePolicy.wg.Wait()
+	if !pool.Has(allowedTx.Hash()) {
+		t.Error("expected the allowed transaction to be added")
+	}
+	if pool.Has(deniedTx.Hash()) {
+		t.Error("expected the denied transaction not to be added")
+	}
+
+	// the decision is cached
+	err := pool.AddRemote(deniedTx)
+	if _, ok := err.(*txpolicy.DeniedError); !ok {
+		t.Fatal("expected denied transaction error, got:", err)
+	}
+	if err.Error() != "transaction denied by policy: sanctioned" {
+		t.Error("unexpected error:", err)
+	}
+
+	// local transactions are evaluated by the RPC API
+	if err := pool.AddLocal(localTx); err != nil {
+		t.Error("expected local transaction to be added, got:", err)
+	}
+	if len(policy.evaluated) != 2 {
+		t.Error("expected each remote transaction to be evaluated once, evaluated:", policy.evaluated)
+	}
+}
+
 func TestTransactionQueue(t *testing.T) {
 	t.Parallel()
 
@@ -130,6 +130,12 @@ type QBFTExtra struct {
 	Vote          *ValidatorVote
 	Round         uint32
 	CommittedSeal [][]byte
+
+	// AggregatedSeal and SealBitmap replace CommittedSeal once BLS seals are enabled.
+	// The bitmap marks the signers by their index in the sorted validator set of the parent block.
+	// Both are omitted from the encoding when empty so that legacy headers keep their hash.
+	AggregatedSeal []byte
+	SealBitmap     []byte
 }
 
 type ValidatorVote struct {
@@ -139,13 +145,17 @@ type ValidatorVote struct {
 
 // EncodeRLP serializes qist into the Ethereum RLP format.
 func (qst *QBFTExtra) EncodeRLP(w io.Writer) error {
-	return rlp.Encode(w, []interface{}{
+	fields := []interface{}{
 		qst.VanityData,
 		qst.Validators,
 		qst.Vote,
 		qst.Round,
 		qst.CommittedSeal,
-	})
+	}
+	if len(qst.AggregatedSeal) > 0 || len(qst.SealBitmap) > 0 {
+		fields = append(fields, qst.AggregatedSeal, qst.SealBitmap)
+	}
+	return rlp.Encode(w, fields)
 }
 
 // DecodeRLP implements rlp.Decoder, and load the QBFTExtra fields from a RLP stream.
@@ -156,11 +166,27 @@ func (qst *QBFTExtra) DecodeRLP(s *rlp.Stream) error {
 		Vote          *ValidatorVote `rlp:"nil"`
 		Round         uint32
 		CommittedSeal [][]byte
+		Rest          []rlp.RawValue `rlp:"tail"`
 	}
 	if err := s.Decode(&qbftExtra); err != nil {
 		return err
 	}
 	qst.VanityData, qst.Validators, qst.Vote, qst.Round, qst.CommittedSeal = qbftExtra.VanityData, qbftExtra.Validators, qbftExtra.Vote, qbftExtra.Round, qbftExtra.CommittedSeal
+	qst.AggregatedSeal, qst.SealBitmap = nil, nil
+
+	// Legacy headers stop after the committed seals, BLS enabled ones carry the aggregate and the bitmap
+	switch len(qbftExtra.Rest) {
+	case 0:
+	case 2:
+		if err := rlp.DecodeBytes(qbftExtra.Rest[0], &qst.AggregatedSeal); err != nil {
+			return err
+		}
+		if err := rlp.DecodeBytes(qbftExtra.Rest[1], &qst.SealBitmap); err != nil {
+			return err
+		}
+	default:
+		return ErrInvalidIstanbulHeaderExtra
+	}
 
 	return nil
 }
@@ -215,6 +241,8 @@ func QBFTFilteredHeaderWithRound(h *Header, round uint32) *Header {
 	}
 
 	qbftExtra.CommittedSeal = [][]byte{}
+	qbftExtra.AggregatedSeal = nil
+	qbftExtra.SealBitmap = nil
 	qbftExtra.Round = round
 
 	payload, err := rlp.EncodeToBytes(&qbftExtra)
@@ -23,6 +23,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rlp"
 )
 
 func TestHeaderHash(t *testing.T) {
@@ -80,6 +81,40 @@ func TestExtractToQBFTExtra(t *testing.T) {
 	}
 }
 
+func TestQBFTExtraAggregatedSeal(t *testing.T) {
+	legacy := &QBFTExtra{
+		VanityData:    []byte{},
+		Validators:    []common.Address{common.HexToAddress("0x44add0ec310f115a0e603b2d7db9f067778eaf8a")},
+		CommittedSeal: [][]byte{},
+	}
+	legacyPayload, err := rlp.EncodeToBytes(legacy)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	aggregated := *legacy
+	aggregated.AggregatedSeal = bytes.Repeat([]byte{0x01}, 192)
+	aggregated.SealBitmap = []byte{0x01}
+	payload, err := rlp.EncodeToBytes(&aggregated)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoded, err := ExtractQBFTExtra(&Header{Extra: payload})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Equal(decoded.AggregatedSeal, aggregated.AggregatedSeal) || !bytes.Equal(decoded.SealBitmap, aggregated.SealBitmap) {
+		t.Errorf("aggregated seal mismatch: have %x/%x", decoded.AggregatedSeal, decoded.SealBitmap)
+	}
+
+	// the filtered header of an aggregated header must match the legacy encoding
+	filtered := QBFTFilteredHeader(&Header{Extra: payload})
+	if !bytes.Equal(filtered.Extra, legacyPayload) {
+		t.Errorf("filtered extra mismatch: have %x, want %x", filtered.Extra, legacyPayload)
+	}
+}
+
 func TestExtractToIstanbul(t *testing.T) {
 	testCases := []struct {
 		vanity         []byte
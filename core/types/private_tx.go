@@ -0,0 +1,99 @@
+// Quorum
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/private/engine"
+)
+
+// PrivateTx is the data of EIP-2718 typed private transactions. Data holds the hash
+// of the encrypted payload stored in the private transaction manager, the privacy flag
+// is part of the signed data so it cannot be altered once the transaction is signed.
+type PrivateTx struct {
+	ChainID     *big.Int               // destination chain ID
+	Nonce       uint64                 // nonce of sender account
+	GasPrice    *big.Int               // wei per gas
+	Gas         uint64                 // gas limit
+	To          *common.Address        `rlp:"nil"` // nil means contract creation
+	Value       *big.Int               // wei amount
+	Data        []byte                 // hash of the encrypted payload
+	PrivacyFlag engine.PrivacyFlagType // privacy enhancements applied to the transaction
+	AccessList  AccessList             // EIP-2930 access list
+	V, R, S     *big.Int               // signature values
+}
+
+// copy creates a deep copy of the transaction data and initializes all fields.
+func (tx *PrivateTx) copy() TxData {
+	cpy := &PrivateTx{
+		Nonce:       tx.Nonce,
+		To:          tx.To,
+		Data:        common.CopyBytes(tx.Data),
+		Gas:         tx.Gas,
+		PrivacyFlag: tx.PrivacyFlag,
+		// These are copied below.
+		AccessList: make(AccessList, len(tx.AccessList)),
+		Value:      new(big.Int),
+		ChainID:    new(big.Int),
+		GasPrice:   new(big.Int),
+		V:          new(big.Int),
+		R:          new(big.Int),
+		S:          new(big.Int),
+	}
+	copy(cpy.AccessList, tx.AccessList)
+	if tx.Value != nil {
+		cpy.Value.Set(tx.Value)
+	}
+	if tx.ChainID != nil {
+		cpy.ChainID.Set(tx.ChainID)
+	}
+	if tx.GasPrice != nil {
+		cpy.GasPrice.Set(tx.GasPrice)
+	}
+	if tx.V != nil {
+		cpy.V.Set(tx.V)
+	}
+	if tx.R != nil {
+		cpy.R.Set(tx.R)
+	}
+	if tx.S != nil {
+		cpy.S.Set(tx.S)
+	}
+	return cpy
+}
+
+// accessors for innerTx.
+
+func (tx *PrivateTx) txType() byte           { return PrivateTxType }
+func (tx *PrivateTx) chainID() *big.Int      { return tx.ChainID }
+func (tx *PrivateTx) accessList() AccessList { return tx.AccessList }
+func (tx *PrivateTx) data() []byte           { return tx.Data }
+func (tx *PrivateTx) gas() uint64            { return tx.Gas }
+func (tx *PrivateTx) gasPrice() *big.Int     { return tx.GasPrice }
+func (tx *PrivateTx) value() *big.Int        { return tx.Value }
+func (tx *PrivateTx) nonce() uint64          { return tx.Nonce }
+func (tx *PrivateTx) to() *common.Address    { return tx.To }
+
+func (tx *PrivateTx) rawSignatureValues() (v, r, s *big.Int) {
+	return tx.V, tx.R, tx.S
+}
+
+// setSignatureValues keeps the chain ID of the transaction when the signer does not
+// return one, e.g.: QuorumPrivateTxSigner which checks it is its configured chain ID
+func (tx *PrivateTx) setSignatureValues(chainID, v, r, s *big.Int) {
+	if chainID != nil {
+		tx.ChainID = chainID
+	}
+	tx.V, tx.R, tx.S = v, r, s
+}
+
+// PrivacyFlag returns the privacy flag signed as part of a typed private transaction.
+// It returns engine.PrivacyFlagStandardPrivate for other transactions as their
+// privacy flag is only known to the private transaction manager
+func (tx *Transaction) PrivacyFlag() engine.PrivacyFlagType {
+	if inner, ok := tx.inner.(*PrivateTx); ok {
+		return inner.PrivacyFlag
+	}
+	return engine.PrivacyFlagStandardPrivate
+}
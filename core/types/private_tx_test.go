@@ -0,0 +1,139 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/private/engine"
+	"github.com/ethereum/go-ethereum/rlp"
+	testifyassert "github.com/stretchr/testify/assert"
+	testifyrequire "github.com/stretchr/testify/require"
+)
+
+func newTestPrivateTx(chainID *big.Int) *Transaction {
+	to := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	return NewTx(&PrivateTx{
+		ChainID:     chainID,
+		Nonce:       3,
+		GasPrice:    big.NewInt(0),
+		Gas:         90000,
+		To:          &to,
+		Value:       big.NewInt(0),
+		Data:        bytes.Repeat([]byte{0xab}, common.EncryptedPayloadHashLength),
+		PrivacyFlag: engine.PrivacyFlagStateValidation,
+		AccessList: AccessList{{
+			Address:     to,
+			StorageKeys: []common.Hash{common.HexToHash("0x01")},
+		}},
+	})
+}
+
+func TestPrivateTx_whenSignedWithEIP2930Signer(t *testing.T) {
+	assert := testifyassert.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	signer := NewEIP2930Signer(big.NewInt(10))
+
+	// chain ID is left for the signer to set
+	signedTx, err := SignTx(newTestPrivateTx(nil), signer, key)
+	testifyrequire.NoError(t, err)
+
+	assert.True(signedTx.IsPrivate())
+	assert.Equal(uint8(PrivateTxType), signedTx.Type())
+	assert.Equal(big.NewInt(10), signedTx.ChainId())
+	assert.Equal(engine.PrivacyFlagStateValidation, signedTx.PrivacyFlag())
+	from, err := Sender(signer, signedTx)
+	assert.NoError(err)
+	assert.Equal(addr, from)
+	from, err = Sender(NewQuorumPrivateTxSigner(big.NewInt(10)), signedTx)
+	assert.NoError(err)
+	assert.Equal(addr, from)
+
+	_, err = Sender(NewEIP2930Signer(big.NewInt(11)), signedTx)
+	assert.Equal(ErrInvalidChainId, err)
+	_, err = Sender(NewQuorumPrivateTxSigner(big.NewInt(11)), signedTx)
+	assert.Equal(ErrInvalidChainId, err)
+	_, err = Sender(QuorumPrivateTxSigner{}, signedTx)
+	assert.Equal(ErrTxTypeNotSupported, err)
+	_, err = Sender(NewEIP155Signer(big.NewInt(10)), signedTx)
+	assert.Equal(ErrTxTypeNotSupported, err)
+}
+
+func TestPrivateTx_whenPrivacyFlagAltered(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	signer := NewEIP2930Signer(big.NewInt(10))
+	signedTx, err := SignTx(newTestPrivateTx(nil), signer, key)
+	testifyrequire.NoError(t, err)
+
+	inner := signedTx.inner.copy().(*PrivateTx)
+	inner.PrivacyFlag = engine.PrivacyFlagStandardPrivate
+	from, err := Sender(signer, NewTx(inner))
+
+	testifyassert.NoError(t, err)
+	testifyassert.NotEqual(t, crypto.PubkeyToAddress(key.PublicKey), from)
+}
+
+func TestPrivateTx_whenSignedWithQuorumPrivateTxSigner(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+
+	signedTx, err := SignTx(newTestPrivateTx(big.NewInt(10)), NewQuorumPrivateTxSigner(big.NewInt(10)), key)
+	testifyrequire.NoError(t, err)
+
+	testifyassert.Equal(t, big.NewInt(10), signedTx.ChainId(), "chain ID of the transaction must be kept")
+	from, err := Sender(NewEIP2930Signer(big.NewInt(10)), signedTx)
+	testifyassert.NoError(t, err)
+	testifyassert.Equal(t, crypto.PubkeyToAddress(key.PublicKey), from)
+}
+
+func TestPrivateTx_whenQuorumPrivateTxSignerOfOtherChain(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+
+	_, err := SignTx(newTestPrivateTx(big.NewInt(11)), NewQuorumPrivateTxSigner(big.NewInt(10)), key)
+	testifyassert.Equal(t, ErrInvalidChainId, err)
+
+	// the chain ID of the transaction is not trusted by a signer which is not bound to a chain
+	_, err = SignTx(newTestPrivateTx(big.NewInt(10)), QuorumPrivateTxSigner{}, key)
+	testifyassert.Equal(t, ErrTxTypeNotSupported, err)
+	testifyassert.False(t, QuorumPrivateTxSigner{}.Equal(NewQuorumPrivateTxSigner(big.NewInt(10))))
+	testifyassert.True(t, NewQuorumPrivateTxSigner(big.NewInt(10)).Equal(NewQuorumPrivateTxSigner(big.NewInt(10))))
+}
+
+func TestPrivateTx_MarshalBinaryAndJSON(t *testing.T) {
+	assert := testifyassert.New(t)
+	key, _ := crypto.GenerateKey()
+	signedTx, err := SignTx(newTestPrivateTx(nil), NewEIP2930Signer(big.NewInt(10)), key)
+	testifyrequire.NoError(t, err)
+
+	data, err := signedTx.MarshalBinary()
+	testifyrequire.NoError(t, err)
+	assert.Equal(byte(PrivateTxType), data[0])
+	fromBinary := new(Transaction)
+	testifyrequire.NoError(t, fromBinary.UnmarshalBinary(data))
+	assert.Equal(signedTx.Hash(), fromBinary.Hash())
+	assert.Equal(engine.PrivacyFlagStateValidation, fromBinary.PrivacyFlag())
+	assert.Equal(signedTx.AccessList(), fromBinary.AccessList())
+
+	jsonData, err := json.Marshal(signedTx)
+	testifyrequire.NoError(t, err)
+	assert.Contains(string(jsonData), `"privacyFlag":"0x3"`)
+	fromJSON := new(Transaction)
+	testifyrequire.NoError(t, json.Unmarshal(jsonData, fromJSON))
+	assert.Equal(signedTx.Hash(), fromJSON.Hash())
+	assert.True(fromJSON.IsPrivate())
+}
+
+func TestReceipt_whenPrivateTxType(t *testing.T) {
+	receipt := &Receipt{Type: PrivateTxType, Status: ReceiptStatusSuccessful, CumulativeGasUsed: 1, Logs: []*Log{}}
+
+	data, err := rlp.EncodeToBytes(receipt)
+	testifyrequire.NoError(t, err)
+	decoded := new(Receipt)
+	testifyrequire.NoError(t, rlp.DecodeBytes(data, decoded))
+
+	testifyassert.Equal(t, uint8(PrivateTxType), decoded.Type)
+	testifyassert.Equal(t, receipt.CumulativeGasUsed, decoded.CumulativeGasUsed)
+}
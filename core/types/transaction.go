@@ -45,6 +45,10 @@ var (
 const (
 	LegacyTxType = iota
 	AccessListTxType
+
+	// Quorum
+	// PrivateTxType is kept apart from the upstream types so that they do not clash
+	PrivateTxType = 0x50
 )
 
 // Transaction is an Ethereum transaction.
@@ -182,6 +186,10 @@ func (tx *Transaction) decodeTyped(b []byte) (TxData, error) {
 		var inner AccessListTx
 		err := rlp.DecodeBytes(b[1:], &inner)
 		return &inner, err
+	case PrivateTxType:
+		var inner PrivateTx
+		err := rlp.DecodeBytes(b[1:], &inner)
+		return &inner, err
 	default:
 		return nil, ErrTxTypeNotSupported
 	}
@@ -499,8 +507,9 @@ type Message struct {
 	accessList AccessList
 	checkNonce bool
 	// Quorum
-	isPrivate      bool
-	isInnerPrivate bool
+	isPrivate         bool
+	isInnerPrivate    bool
+	signedPrivacyFlag *engine.PrivacyFlagType // privacy flag signed in a typed private transaction
 }
 
 func NewMessage(from common.Address, to *common.Address, nonce uint64, amount *big.Int, gasLimit uint64, gasPrice *big.Int, data []byte, accessList AccessList, checkNonce bool) Message {
@@ -531,6 +540,10 @@ func (tx *Transaction) AsMessage(s Signer) (Message, error) {
 		// Quorum
 		isPrivate: tx.IsPrivate(),
 	}
+	if tx.Type() == PrivateTxType {
+		privacyFlag := tx.PrivacyFlag()
+		msg.signedPrivacyFlag = &privacyFlag
+	}
 
 	var err error
 	msg.from, err = Sender(s, tx)
@@ -632,6 +645,16 @@ func (m Message) IsInnerPrivate() bool {
 	return m.isInnerPrivate
 }
 
+// Quorum
+// SignedPrivacyFlag returns the privacy flag signed in a typed private transaction,
+// it returns false for other transactions
+func (m Message) SignedPrivacyFlag() (engine.PrivacyFlagType, bool) {
+	if m.signedPrivacyFlag == nil {
+		return engine.PrivacyFlagStandardPrivate, false
+	}
+	return *m.signedPrivacyFlag, true
+}
+
 // Quorum
 func (m Message) WithInnerPrivateFlag(isInnerPrivateTxn bool) Message {
 	m.isInnerPrivate = isInnerPrivateTxn
@@ -650,6 +673,9 @@ func (tx *Transaction) IsPrivate() bool {
 	if tx == nil {
 		return false
 	}
+	if tx.Type() == PrivateTxType {
+		return true
+	}
 	v, _, _ := tx.RawSignatureValues()
 	if v == nil {
 		return false
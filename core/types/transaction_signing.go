@@ -184,7 +184,7 @@ func (s eip2930Signer) Equal(s2 Signer) bool {
 
 func (s eip2930Signer) Sender(tx *Transaction) (common.Address, error) {
 	// Quorum
-	if tx.IsPrivate() {
+	if tx.IsPrivate() && tx.Type() == LegacyTxType {
 		return QuorumPrivateTxSigner{}.Sender(tx)
 	}
 	// End Quorum
@@ -196,7 +196,7 @@ func (s eip2930Signer) Sender(tx *Transaction) (common.Address, error) {
 		}
 		V = new(big.Int).Sub(V, s.chainIdMul)
 		V.Sub(V, big8)
-	case AccessListTxType:
+	case AccessListTxType, PrivateTxType:
 		// ACL txs are defined to use 0 and 1 as their recovery id, add
 		// 27 to become equivalent to unprotected Homestead signatures.
 		V = new(big.Int).Add(V, big.NewInt(27))
@@ -221,6 +221,14 @@ func (s eip2930Signer) SignatureValues(tx *Transaction, sig []byte) (R, S, V *bi
 		}
 		R, S, _ = decodeSignature(sig)
 		V = big.NewInt(int64(sig[64]))
+	// Quorum
+	case *PrivateTx:
+		if txdata.ChainID.Sign() != 0 && txdata.ChainID.Cmp(s.chainId) != 0 {
+			return nil, nil, nil, ErrInvalidChainId
+		}
+		R, S, _ = decodeSignature(sig)
+		V = big.NewInt(int64(sig[64]))
+	// End Quorum
 	default:
 		return nil, nil, nil, ErrTxTypeNotSupported
 	}
@@ -254,6 +262,22 @@ func (s eip2930Signer) Hash(tx *Transaction) common.Hash {
 				tx.Data(),
 				tx.AccessList(),
 			})
+	// Quorum
+	case PrivateTxType:
+		return prefixedRlpHash(
+			tx.Type(),
+			[]interface{}{
+				s.chainId,
+				tx.Nonce(),
+				tx.GasPrice(),
+				tx.Gas(),
+				tx.To(),
+				tx.Value(),
+				tx.Data(),
+				tx.PrivacyFlag(),
+				tx.AccessList(),
+			})
+	// End Quorum
 	default:
 		// This _should_ not happen, but in case someone sends in a bad
 		// json struct via RPC, it's probably more prudent to return an
@@ -291,7 +315,7 @@ func (s EIP155Signer) Equal(s2 Signer) bool {
 var big8 = big.NewInt(8)
 
 func (s EIP155Signer) Sender(tx *Transaction) (common.Address, error) {
-	if tx.IsPrivate() {
+	if tx.IsPrivate() && tx.Type() == LegacyTxType {
 		return QuorumPrivateTxSigner{}.Sender(tx)
 	}
 	if tx.Type() != LegacyTxType {
@@ -24,15 +24,42 @@ import (
 
 // Signs with Homestead
 // obtains sender from EIP55Signer
-type QuorumPrivateTxSigner struct{ HomesteadSigner }
+type QuorumPrivateTxSigner struct {
+	HomesteadSigner
+	chainId *big.Int // chain of typed private transactions, nil if they are not supported
+}
+
+// NewQuorumPrivateTxSigner returns a signer of legacy private transactions which also
+// signs and verifies typed private transactions of the given chain
+func NewQuorumPrivateTxSigner(chainId *big.Int) QuorumPrivateTxSigner {
+	return QuorumPrivateTxSigner{chainId: chainId}
+}
 
+// Typed private transactions are signed and verified by the EIP-2930 signer of the
+// configured chain, their chain ID must be the configured one.
 func (s QuorumPrivateTxSigner) Sender(tx *Transaction) (common.Address, error) {
+	if tx.Type() == PrivateTxType {
+		if s.chainId == nil {
+			return common.Address{}, ErrTxTypeNotSupported
+		}
+		return NewEIP2930Signer(s.chainId).Sender(tx)
+	}
 	return HomesteadSigner{}.Sender(tx)
 }
 
 // SignatureValues returns signature values. This signature
 // needs to be in the [R || S || V] format where V is 0 or 1.
 func (qs QuorumPrivateTxSigner) SignatureValues(tx *Transaction, sig []byte) (R, S, V *big.Int, err error) {
+	if tx.Type() == PrivateTxType {
+		if qs.chainId == nil {
+			return nil, nil, nil, ErrTxTypeNotSupported
+		}
+		// the chain ID is not set by this signer, see PrivateTx.setSignatureValues
+		if tx.ChainId().Cmp(qs.chainId) != 0 {
+			return nil, nil, nil, ErrInvalidChainId
+		}
+		return NewEIP2930Signer(qs.chainId).SignatureValues(tx, sig)
+	}
 	r, s, _, _ := HomesteadSigner{}.SignatureValues(tx, sig)
 	// update v for private transaction marker: needs to be 37 (0+37) or 38 (1+37) for a private transaction.
 	v := new(big.Int).SetBytes([]byte{sig[64] + 37})
@@ -42,12 +69,25 @@ func (qs QuorumPrivateTxSigner) SignatureValues(tx *Transaction, sig []byte) (R,
 // Hash returns the hash to be signed by the sender.
 // It does not uniquely identify the transaction.
 func (s QuorumPrivateTxSigner) Hash(tx *Transaction) common.Hash {
+	if tx.Type() == PrivateTxType {
+		if s.chainId == nil {
+			// as done by the other signers for unsupported types
+			return common.Hash{}
+		}
+		return NewEIP2930Signer(s.chainId).Hash(tx)
+	}
 	return s.HomesteadSigner.Hash(tx)
 }
 
 func (s QuorumPrivateTxSigner) Equal(s2 Signer) bool {
-	_, ok := s2.(QuorumPrivateTxSigner)
-	return ok
+	x, ok := s2.(QuorumPrivateTxSigner)
+	if !ok {
+		return false
+	}
+	if s.chainId == nil || x.chainId == nil {
+		return s.chainId == nil && x.chainId == nil
+	}
+	return s.chainId.Cmp(x.chainId) == 0
 }
 
 /*
@@ -47,7 +47,7 @@ func TestQuorumSignPrivateQuorum(t *testing.T) {
 
 	for i := 0; i < len(keys); i++ {
 		key, _ := createKey(crypto.S256(), keys[i])
-		qpPrivateSigner := QuorumPrivateTxSigner{HomesteadSigner{}}
+		qpPrivateSigner := QuorumPrivateTxSigner{HomesteadSigner: HomesteadSigner{}}
 
 		signedTx, addr, err := signTxWithSigner(qpPrivateSigner, key)
 		v, _, _ := signedTx.RawSignatureValues()
@@ -43,6 +43,7 @@ import (
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/params"
 	pcore "github.com/ethereum/go-ethereum/permission/core"
+	"github.com/ethereum/go-ethereum/plugin/txpolicy"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/jpmorganchase/quorum-security-plugin-sdk-go/proto"
 )
@@ -491,6 +492,10 @@ func (b *EthAPIBackend) IsPrivacyMarkerTransactionCreationEnabled() bool {
 	return b.eth.config.QuorumChainConfig.PrivacyMarkerEnabled() && b.ChainConfig().IsPrivacyPrecompileEnabled(b.eth.blockchain.CurrentBlock().Number())
 }
 
+func (b *EthAPIBackend) TransactionPolicy() txpolicy.TransactionPolicy {
+	return b.eth.txPolicy
+}
+
 // used by Quorum
 type EthAPIState struct {
 	state, privateState *state.StateDB
@@ -20,15 +20,20 @@ import (
 	"bytes"
 	"fmt"
 	"math/big"
+	"path/filepath"
 	"reflect"
 	"sort"
 	"testing"
+	"unicode"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/rpc"
 )
 
 var dumper = spew.ConfigState{Indent: "    "}
@@ -207,3 +212,55 @@ func TestStorageRangeAt(t *testing.T) {
 		}
 	}
 }
+
+// Every state-changing admin, personal and debug method registered by the eth
+// service and the tracers must be audited by default.
+func TestDefaultAuditedMethods_whenEthAPIs(t *testing.T) {
+	stack, err := node.New(&node.Config{})
+	if err != nil {
+		t.Fatalf("failed to create node, err = %v", err)
+	}
+	defer stack.Close()
+	eth, err := New(stack, &Config{})
+	if err != nil {
+		t.Fatalf("failed to create eth service, err = %v", err)
+	}
+	audit, err := rpc.NewAuditLog(rpc.AuditLogConfig{File: filepath.Join(t.TempDir(), "audit.log")})
+	if err != nil {
+		t.Fatalf("failed to create audit log, err = %v", err)
+	}
+	defer audit.Close()
+	readOnly := map[string]bool{
+		"admin_exportChain": true,
+	}
+	for _, method := range []string{"listAccounts", "listWallets", "sign", "signTransaction", "ecRecover"} {
+		readOnly["personal_"+method] = true
+	}
+	for _, method := range []string{
+		"dumpBlock", "dumpAddress", "accountRange", "privateStateRoot", "defaultStateRoot", "preimage",
+		"getBadBlocks", "storageRangeAt", "getModifiedAccountsByNumber", "getModifiedAccountsByHash",
+		"getBlockRlp", "testSignCliqueBlock", "printBlock", "seedHash", "chaindbProperty", "chaindbCompact",
+		"traceChain", "traceBlockByNumber", "traceBlockByHash", "traceBlock", "traceBlockFromFile",
+		"traceBadBlock", "standardTraceBlockToFile", "standardTraceBadBlockToFile", "traceTransaction", "traceCall",
+	} {
+		readOnly["debug_"+method] = true
+	}
+
+	var unaudited []string
+	for _, api := range append(eth.APIs(), tracers.APIs(eth.APIBackend)...) {
+		if api.Namespace != "admin" && api.Namespace != "personal" && api.Namespace != "debug" {
+			continue
+		}
+		typ := reflect.TypeOf(api.Service)
+		for i := 0; i < typ.NumMethod(); i++ {
+			name := []rune(typ.Method(i).Name)
+			method := api.Namespace + "_" + string(unicode.ToLower(name[0])) + string(name[1:])
+			if !readOnly[method] && !audit.IsAudited(method) {
+				unaudited = append(unaudited, method)
+			}
+		}
+	}
+	if len(unaudited) > 0 {
+		t.Errorf("state-changing methods not audited: %v", unaudited)
+	}
+}
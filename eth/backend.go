@@ -59,6 +59,7 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/plugin"
 	"github.com/ethereum/go-ethereum/plugin/security"
+	"github.com/ethereum/go-ethereum/plugin/txpolicy"
 	"github.com/ethereum/go-ethereum/private"
 	"github.com/ethereum/go-ethereum/qlight"
 	"github.com/ethereum/go-ethereum/rlp"
@@ -109,6 +110,8 @@ type Ethereum struct {
 	qlightServerHandler             *handler
 	qlightP2pServer                 *p2p.Server
 	qlightTokenHolder               *qlight.TokenHolder
+	eventSinkStreamer               *eventSinkStreamer
+	txPolicy                        txpolicy.TransactionPolicy // evaluated by the RPC API and the transaction pool, nil if not configured
 }
 
 // New creates a new Ethereum object (including the
@@ -283,6 +286,22 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 		config.TxPool.Journal = stack.ResolvePath(config.TxPool.Journal)
 	}
 	eth.txPool = core.NewTxPool(config.TxPool, chainConfig, eth.blockchain)
+	// Quorum
+	if stack.PluginManager().IsEnabled(plugin.TxPolicyPluginInterfaceName) {
+		policy, err := stack.PluginManager().TransactionPolicy()
+		if err != nil {
+			return nil, fmt.Errorf("transaction policy plugin: %w", err)
+		}
+		eth.txPolicy = policy
+		eth.txPool.SetRemoteTransactionPolicy(policy)
+	}
+	if stack.PluginManager().IsEnabled(plugin.EventSinkPluginInterfaceName) {
+		sink, err := stack.PluginManager().EventSink()
+		if err != nil {
+			return nil, fmt.Errorf("event sink plugin: %w", err)
+		}
+		eth.eventSinkStreamer = newEventSinkStreamer(eth.blockchain, chainDb, sink)
+	}
 
 	// Permit the downloader to use the trie cache allowance during fast sync
 	cacheLimit := cacheConfig.TrieCleanLimit + cacheConfig.TrieDirtyLimit + cacheConfig.SnapshotLimit
@@ -819,6 +838,9 @@ func (s *Ethereum) Start() error {
 			s.qlightServerHandler.StartQLightServer(s.qlightP2pServer.MaxPeers)
 		}
 	}
+	if s.eventSinkStreamer != nil {
+		s.eventSinkStreamer.Start()
+	}
 
 	return nil
 }
@@ -839,6 +861,9 @@ func (s *Ethereum) Stop() error {
 	}
 
 	// Then stop everything else.
+	if s.eventSinkStreamer != nil {
+		s.eventSinkStreamer.Stop()
+	}
 	s.bloomIndexer.Close()
 	close(s.closeBloomHandler)
 	s.txPool.Stop()
@@ -0,0 +1,205 @@
+package eth
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/plugin/eventsink"
+)
+
+var (
+	// how long to wait before retrying a failed delivery to the event sink
+	eventSinkRetryInterval = 5 * time.Second
+	// maximum time for the event sink to acknowledge a single event
+	eventSinkTimeout = 30 * time.Second
+)
+
+// eventSinkStreamer pushes canonical blocks and reorg notifications to an event sink.
+//
+// Delivery is at-least-once: the last delivered block is persisted after the
+// event sink acknowledges it, so events are delivered again if the node stops
+// in between. Failed deliveries are retried until they succeed. When the event
+// sink is first enabled, delivery starts after the current chain head.
+type eventSinkStreamer struct {
+	chain *core.BlockChain
+	db    ethdb.KeyValueStore
+	sink  eventsink.EventSink
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+func newEventSinkStreamer(chain *core.BlockChain, db ethdb.KeyValueStore, sink eventsink.EventSink) *eventSinkStreamer {
+	return &eventSinkStreamer{
+		chain: chain,
+		db:    db,
+		sink:  sink,
+		quit:  make(chan struct{}),
+	}
+}
+
+func (s *eventSinkStreamer) Start() {
+	s.wg.Add(1)
+	go s.loop()
+}
+
+func (s *eventSinkStreamer) Stop() {
+	close(s.quit)
+	s.wg.Wait()
+}
+
+func (s *eventSinkStreamer) loop() {
+	defer s.wg.Done()
+	headCh := make(chan core.ChainHeadEvent, 10)
+	sub := s.chain.SubscribeChainHeadEvent(headCh)
+	defer sub.Unsubscribe()
+
+	// catch up with the chain head straight away
+	retry := time.After(0)
+	for {
+		select {
+		case <-headCh:
+		case <-retry:
+		case <-sub.Err():
+			return
+		case <-s.quit:
+			return
+		}
+		if err := s.deliverPending(); err != nil {
+			log.Warn("Unable to deliver chain events to the event sink", "err", err, "retryIn", eventSinkRetryInterval)
+			retry = time.After(eventSinkRetryInterval)
+		} else {
+			retry = nil
+		}
+	}
+}
+
+// deliverPending delivers all canonical blocks after the last delivered one,
+// preceded by a reorg notification if the last delivered block is no longer canonical
+func (s *eventSinkStreamer) deliverPending() error {
+	cursor := rawdb.ReadEventSinkCursor(s.db)
+	if cursor == nil {
+		head := s.chain.CurrentBlock()
+		cursor = &rawdb.EventSinkCursor{Number: head.NumberU64(), Hash: head.Hash()}
+		rawdb.WriteEventSinkCursor(s.db, cursor)
+	}
+	for {
+		select {
+		case <-s.quit:
+			return nil
+		default:
+		}
+		if s.chain.GetCanonicalHash(cursor.Number) != cursor.Hash {
+			ancestor, err := s.deliverReorg(cursor)
+			if err != nil {
+				return err
+			}
+			cursor = ancestor
+			rawdb.WriteEventSinkCursor(s.db, cursor)
+			continue
+		}
+		next := s.chain.GetBlockByNumber(cursor.Number + 1)
+		if next == nil {
+			return nil
+		}
+		if next.ParentHash() != cursor.Hash {
+			// the canonical chain has changed since the cursor was checked
+			continue
+		}
+		if err := s.deliverBlock(next); err != nil {
+			return err
+		}
+		cursor = &rawdb.EventSinkCursor{Number: next.NumberU64(), Hash: next.Hash()}
+		rawdb.WriteEventSinkCursor(s.db, cursor)
+	}
+}
+
+func (s *eventSinkStreamer) deliverBlock(block *types.Block) error {
+	receipts, privateReceipts, err := s.blockReceipts(block)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), eventSinkTimeout)
+	defer cancel()
+	return s.sink.DeliverBlock(ctx, &eventsink.BlockEvent{
+		Block:           block,
+		Receipts:        receipts,
+		PrivateReceipts: privateReceipts,
+	})
+}
+
+// blockReceipts returns the public receipts of a block and the private receipts
+// of each private state. Without multiple private states, the receipt stored for
+// a private transaction is its private receipt, which is replaced by a public
+// receipt without logs.
+func (s *eventSinkStreamer) blockReceipts(block *types.Block) (types.Receipts, map[types.PrivateStateIdentifier]types.Receipts, error) {
+	privateStateRepo, err := s.chain.PrivateStateManager().StateRepository(block.Root())
+	if err != nil {
+		return nil, nil, err
+	}
+	stored := s.chain.GetReceiptsByHash(block.Hash())
+	txs := block.Transactions()
+	receipts := make(types.Receipts, len(stored))
+	privateReceipts := make(map[types.PrivateStateIdentifier]types.Receipts)
+	for i, receipt := range stored {
+		receipts[i] = receipt
+		if !privateStateRepo.IsMPS() && receipt.PSReceipts == nil && i < len(txs) && txs[i].IsPrivate() {
+			psi := privateStateRepo.DefaultStateMetadata().ID
+			privateReceipts[psi] = append(privateReceipts[psi], receipt)
+			receipts[i] = publicReceipt(receipt)
+			continue
+		}
+		for psi, psReceipt := range receipt.PSReceipts {
+			privateReceipts[psi] = append(privateReceipts[psi], psReceipt)
+		}
+	}
+	return receipts, privateReceipts, nil
+}
+
+// publicReceipt returns the receipt of a private transaction in the public state,
+// where it has no effect
+func publicReceipt(privateReceipt *types.Receipt) *types.Receipt {
+	return &types.Receipt{
+		Type:              privateReceipt.Type,
+		Status:            types.ReceiptStatusSuccessful,
+		CumulativeGasUsed: privateReceipt.CumulativeGasUsed,
+		Logs:              []*types.Log{},
+		TxHash:            privateReceipt.TxHash,
+		GasUsed:           privateReceipt.GasUsed,
+		BlockHash:         privateReceipt.BlockHash,
+		BlockNumber:       privateReceipt.BlockNumber,
+		TransactionIndex:  privateReceipt.TransactionIndex,
+	}
+}
+
+// deliverReorg notifies the event sink of the delivered blocks which are no longer
+// canonical and returns the common ancestor with the canonical chain
+func (s *eventSinkStreamer) deliverReorg(cursor *rawdb.EventSinkCursor) (*rawdb.EventSinkCursor, error) {
+	var removed []common.Hash
+	block := s.chain.GetBlockByHash(cursor.Hash)
+	for block != nil && s.chain.GetCanonicalHash(block.NumberU64()) != block.Hash() {
+		removed = append(removed, block.Hash())
+		block = s.chain.GetBlockByHash(block.ParentHash())
+	}
+	if block == nil {
+		return nil, fmt.Errorf("unable to find the common ancestor of delivered block %d [%s]", cursor.Number, cursor.Hash.TerminalString())
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), eventSinkTimeout)
+	defer cancel()
+	if err := s.sink.DeliverReorg(ctx, &eventsink.ReorgEvent{
+		CommonAncestorNumber: block.NumberU64(),
+		CommonAncestorHash:   block.Hash(),
+		RemovedBlockHashes:   removed,
+	}); err != nil {
+		return nil, err
+	}
+	return &rawdb.EventSinkCursor{Number: block.NumberU64(), Hash: block.Hash()}, nil
+}
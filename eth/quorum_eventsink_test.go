@@ -0,0 +1,201 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/plugin/eventsink"
+	"github.com/ethereum/go-ethereum/private"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubEventSink struct {
+	blocks []common.Hash
+	events []*eventsink.BlockEvent
+	reorgs []*eventsink.ReorgEvent
+	err    error
+}
+
+func (s *stubEventSink) DeliverBlock(_ context.Context, event *eventsink.BlockEvent) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.blocks = append(s.blocks, event.Block.Hash())
+	s.events = append(s.events, event)
+	return nil
+}
+
+func (s *stubEventSink) DeliverReorg(_ context.Context, event *eventsink.ReorgEvent) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.reorgs = append(s.reorgs, event)
+	return nil
+}
+
+func newEventSinkTestChain(t *testing.T, blocks int) (*core.BlockChain, ethdb.Database) {
+	db := rawdb.NewMemoryDatabase()
+	(&core.Genesis{Config: params.TestChainConfig}).MustCommit(db)
+	chain, err := core.NewBlockChain(db, nil, params.TestChainConfig, ethash.NewFaker(), vm.Config{}, nil, nil, nil)
+	require.NoError(t, err)
+	bs, _ := core.GenerateChain(params.TestChainConfig, chain.Genesis(), ethash.NewFaker(), db, blocks, nil)
+	_, err = chain.InsertChain(bs)
+	require.NoError(t, err)
+	return chain, db
+}
+
+// enableAtGenesis stores the checkpoint of an event sink enabled at genesis
+func enableAtGenesis(chain *core.BlockChain, db ethdb.Database) {
+	rawdb.WriteEventSinkCursor(db, &rawdb.EventSinkCursor{Number: 0, Hash: chain.Genesis().Hash()})
+}
+
+func TestEventSinkStreamer_deliverPending(t *testing.T) {
+	chain, db := newEventSinkTestChain(t, 3)
+	defer chain.Stop()
+	enableAtGenesis(chain, db)
+	sink := &stubEventSink{}
+	testObject := newEventSinkStreamer(chain, db, sink)
+
+	require.NoError(t, testObject.deliverPending())
+
+	assert.Equal(t, []common.Hash{
+		chain.GetCanonicalHash(1), chain.GetCanonicalHash(2), chain.GetCanonicalHash(3),
+	}, sink.blocks)
+	assert.Equal(t, &rawdb.EventSinkCursor{Number: 3, Hash: chain.GetCanonicalHash(3)}, rawdb.ReadEventSinkCursor(db))
+
+	// nothing new is delivered again
+	require.NoError(t, testObject.deliverPending())
+	assert.Len(t, sink.blocks, 3)
+}
+
+func TestEventSinkStreamer_deliverPending_whenFirstEnabled(t *testing.T) {
+	chain, db := newEventSinkTestChain(t, 3)
+	defer chain.Stop()
+	sink := &stubEventSink{}
+	testObject := newEventSinkStreamer(chain, db, sink)
+
+	require.NoError(t, testObject.deliverPending())
+
+	assert.Empty(t, sink.blocks, "the blocks before the event sink was enabled are not delivered")
+	assert.Equal(t, &rawdb.EventSinkCursor{Number: 3, Hash: chain.GetCanonicalHash(3)}, rawdb.ReadEventSinkCursor(db))
+
+	next, _ := core.GenerateChain(params.TestChainConfig, chain.CurrentBlock(), ethash.NewFaker(), db, 1, nil)
+	_, err := chain.InsertChain(next)
+	require.NoError(t, err)
+	require.NoError(t, testObject.deliverPending())
+	assert.Equal(t, []common.Hash{next[0].Hash()}, sink.blocks)
+}
+
+func TestEventSinkStreamer_deliverPending_whenPrivateTransaction(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	saved := private.P
+	defer func() { private.P = saved }()
+	// the private payload creates a contract emitting a log
+	payloadHash := common.BytesToEncryptedPayloadHash([]byte("arbitrary payload"))
+	mockPTM := private.NewMockPrivateTransactionManager(mockCtrl)
+	mockPTM.EXPECT().Receive(payloadHash).Return("", []string{"AAA"}, []byte{byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.LOG0)}, nil, nil).AnyTimes()
+	private.P = mockPTM
+
+	key, _ := crypto.GenerateKey()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	config := params.QuorumTestChainConfig
+	db := rawdb.NewMemoryDatabase()
+	genesis := (&core.Genesis{Config: config, Alloc: core.GenesisAlloc{from: {Balance: big.NewInt(1000000000)}}}).MustCommit(db)
+	chain, err := core.NewBlockChain(db, nil, config, ethash.NewFaker(), vm.Config{}, nil, nil, nil)
+	require.NoError(t, err)
+	defer chain.Stop()
+	enableAtGenesis(chain, db)
+	bs, _ := core.GenerateChain(config, genesis, ethash.NewFaker(), db, 1, func(i int, b *core.BlockGen) {
+		tx, err := types.SignTx(types.NewContractCreation(b.TxNonce(from), big.NewInt(0), 100000, big.NewInt(0), payloadHash.Bytes()), types.QuorumPrivateTxSigner{}, key)
+		require.NoError(t, err)
+		b.AddTx(tx)
+	})
+	_, err = chain.InsertChain(bs)
+	require.NoError(t, err)
+	sink := &stubEventSink{}
+	testObject := newEventSinkStreamer(chain, db, sink)
+
+	require.NoError(t, testObject.deliverPending())
+
+	require.Len(t, sink.events, 1)
+	event := sink.events[0]
+	require.Len(t, event.Receipts, 1)
+	assert.Empty(t, event.Receipts[0].Logs, "the private logs are not public")
+	assert.Equal(t, types.ReceiptStatusSuccessful, event.Receipts[0].Status)
+	privateReceipts := event.PrivateReceipts[types.DefaultPrivateStateIdentifier]
+	require.Len(t, privateReceipts, 1)
+	assert.Equal(t, bs[0].Transactions()[0].Hash(), privateReceipts[0].TxHash)
+	assert.Len(t, privateReceipts[0].Logs, 1)
+}
+
+func TestEventSinkStreamer_deliverPending_whenReorg(t *testing.T) {
+	chain, db := newEventSinkTestChain(t, 3)
+	defer chain.Stop()
+	enableAtGenesis(chain, db)
+	sink := &stubEventSink{}
+	testObject := newEventSinkStreamer(chain, db, sink)
+	require.NoError(t, testObject.deliverPending())
+	removed := []common.Hash{chain.GetCanonicalHash(3), chain.GetCanonicalHash(2)}
+
+	fork, _ := core.GenerateChain(params.TestChainConfig, chain.GetBlockByNumber(1), ethash.NewFaker(), db, 4, func(i int, b *core.BlockGen) {
+		b.SetCoinbase(common.Address{0x1})
+	})
+	_, err := chain.InsertChain(fork)
+	require.NoError(t, err)
+	sink.blocks = nil
+
+	require.NoError(t, testObject.deliverPending())
+
+	assert.Equal(t, []*eventsink.ReorgEvent{{
+		CommonAncestorNumber: 1,
+		CommonAncestorHash:   chain.GetCanonicalHash(1),
+		RemovedBlockHashes:   removed,
+	}}, sink.reorgs)
+	assert.Equal(t, []common.Hash{fork[0].Hash(), fork[1].Hash(), fork[2].Hash(), fork[3].Hash()}, sink.blocks)
+	assert.Equal(t, &rawdb.EventSinkCursor{Number: 5, Hash: fork[3].Hash()}, rawdb.ReadEventSinkCursor(db))
+}
+
+func TestEventSinkStreamer_deliverPending_whenDeliveryFails(t *testing.T) {
+	chain, db := newEventSinkTestChain(t, 2)
+	defer chain.Stop()
+	enableAtGenesis(chain, db)
+	sink := &stubEventSink{err: errors.New("arbitrary error")}
+	testObject := newEventSinkStreamer(chain, db, sink)
+
+	assert.EqualError(t, testObject.deliverPending(), "arbitrary error")
+	assert.Equal(t, &rawdb.EventSinkCursor{Number: 0, Hash: chain.Genesis().Hash()}, rawdb.ReadEventSinkCursor(db))
+
+	// resumes from the last delivered block
+	sink.err = nil
+	require.NoError(t, testObject.deliverPending())
+	assert.Equal(t, []common.Hash{chain.GetCanonicalHash(1), chain.GetCanonicalHash(2)}, sink.blocks)
+}
+
+func TestEventSinkStreamer_StartStop(t *testing.T) {
+	chain, db := newEventSinkTestChain(t, 2)
+	defer chain.Stop()
+	enableAtGenesis(chain, db)
+	testObject := newEventSinkStreamer(chain, db, &stubEventSink{})
+
+	testObject.Start()
+	assert.Eventually(t, func() bool {
+		cursor := rawdb.ReadEventSinkCursor(db)
+		return cursor != nil && cursor.Number == 2
+	}, time.Second, 10*time.Millisecond)
+	testObject.Stop()
+}
@@ -546,7 +546,7 @@ func (ec *Client) SendTransaction(ctx context.Context, tx *types.Transaction, ar
 		return err
 	}
 	if args.PrivateFor != nil {
-		return ec.c.CallContext(ctx, nil, "eth_sendRawPrivateTransaction", hexutil.Encode(data), bind.PrivateTxArgs{PrivateFor: args.PrivateFor})
+		return ec.c.CallContext(ctx, nil, "eth_sendRawPrivateTransaction", hexutil.Encode(data), args)
 	} else {
 		return ec.c.CallContext(ctx, nil, "eth_sendRawTransaction", hexutil.Encode(data))
 	}
@@ -569,7 +569,7 @@ func (ec *Client) DistributeTransaction(ctx context.Context, tx *types.Transacti
 		return "", err
 	}
 	retVal := ""
-	err = ec.c.CallContext(ctx, &retVal, "eth_distributePrivateTransaction", hexutil.Encode(data), bind.PrivateTxArgs{PrivateFor: args.PrivateFor})
+	err = ec.c.CallContext(ctx, &retVal, "eth_distributePrivateTransaction", hexutil.Encode(data), args)
 	return retVal, err
 }
 
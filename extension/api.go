@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/internal/ethapi"
 	"github.com/ethereum/go-ethereum/multitenancy"
 	"github.com/ethereum/go-ethereum/permission/core"
+	"github.com/ethereum/go-ethereum/private/engine"
 )
 
 var (
@@ -260,6 +261,15 @@ func (api *PrivateExtensionAPI) ApproveExtension(ctx context.Context, addressToV
 // - the new PTM public key
 // - the Ethereum addresses of who can vote to extend the contract
 func (api *PrivateExtensionAPI) ExtendContract(ctx context.Context, toExtend common.Address, newRecipientPtmPublicKey string, recipientAddr common.Address, txa ethapi.SendTxArgs) (string, error) {
+	return api.ExtendContractToRecipients(ctx, toExtend, []string{newRecipientPtmPublicKey}, []common.Address{recipientAddr}, nil, txa)
+}
+
+// ExtendContractToRecipients deploys a single extension management contract extending a contract to several new
+// participants. Each recipient account accepts or declines the extension for its PTM key, the state is shared with
+// the recipients which accepted once every voter voted. The extension is declined if the creator or every
+// recipient declines. The given dependencies, private contracts listed by GetExtensionDependencies as shareable, are
+// approved by the voters along with the extension and shared with the contract.
+func (api *PrivateExtensionAPI) ExtendContractToRecipients(ctx context.Context, toExtend common.Address, recipientPtmPublicKeys []string, recipientAddrs []common.Address, dependencies []common.Address, txa ethapi.SendTxArgs) (string, error) {
 	// check if the contract to be extended is already under extension
 	// if yes throw an error
 	if api.checkIfContractUnderExtension(ctx, toExtend) {
@@ -280,9 +290,11 @@ func (api *PrivateExtensionAPI) ExtendContract(ctx context.Context, toExtend com
 		return "", err
 	}
 
-	// check if recipient address is 0x0
-	if recipientAddr == (common.Address{0}) {
-		return "", errors.New("invalid recipient address")
+	if len(recipientAddrs) == 0 {
+		return "", errors.New("no recipient given")
+	}
+	if len(recipientAddrs) != len(recipientPtmPublicKeys) {
+		return "", errors.New("number of recipient addresses and transaction manager keys differ")
 	}
 
 	psm, err := api.privacyService.apiBackendHelper.PSMR().ResolveForUserContext(ctx)
@@ -304,34 +316,57 @@ func (api *PrivateExtensionAPI) ExtendContract(ctx context.Context, toExtend com
 		return "", errors.New("operation not allowed")
 	}
 
+	if err := api.privacyService.checkApprovedDependencies(api.privacyService.stateFetcher.getCurrentBlockHash(), toExtend, psm.ID, dependencies); err != nil {
+		return "", err
+	}
+
 	// if running in permissioned mode with new permissions model
 	// ensure that the account extending the contract is an admin
-	// account and recipient account is an admin account as well
-	if txa.From == recipientAddr {
-		return "", errors.New("account accepting the extension cannot be the account initiating extension")
-	}
+	// account and recipient accounts are admin accounts as well
 	if !core.CheckIfAdminAccount(txa.From) {
 		return "", errors.New("account not an org admin account, cannot initiate extension")
 	}
-	if !core.CheckIfAdminAccount(recipientAddr) {
-		return "", errors.New("recipient account address is not an org admin account. cannot accept extension")
-	}
+	recipientKeys := make(map[string]bool)
+	for i, recipientAddr := range recipientAddrs {
+		// check if recipient address is 0x0
+		if recipientAddr == (common.Address{0}) {
+			return "", errors.New("invalid recipient address")
+		}
+		if txa.From == recipientAddr {
+			return "", errors.New("account accepting the extension cannot be the account initiating extension")
+		}
+		if checkAddressInList(recipientAddr, recipientAddrs[:i]) {
+			return "", fmt.Errorf("recipient account address %s given more than once", recipientAddr.Hex())
+		}
+		if !core.CheckIfAdminAccount(recipientAddr) {
+			return "", errors.New("recipient account address is not an org admin account. cannot accept extension")
+		}
 
-	// check the new key is valid
-	if _, err := base64.StdEncoding.DecodeString(newRecipientPtmPublicKey); err != nil {
-		return "", errors.New("invalid new recipient transaction manager key provided")
+		// check the new key is valid
+		if _, err := base64.StdEncoding.DecodeString(recipientPtmPublicKeys[i]); err != nil {
+			return "", errors.New("invalid new recipient transaction manager key provided")
+		}
+		recipientKeys[recipientPtmPublicKeys[i]] = true
 	}
 
-	// check the the intended new recipient will actually receive the extension request
-	switch len(txa.PrivateFor) {
-	case 0:
-		txa.PrivateFor = append(txa.PrivateFor, newRecipientPtmPublicKey)
-	case 1:
-		if txa.PrivateFor[0] != newRecipientPtmPublicKey {
-			return "", errors.New("mismatch between recipient transaction manager key and privateFor argument")
+	// check the the intended new recipients will actually receive the extension request
+	if len(txa.PrivateFor) == 0 {
+		for i, key := range recipientPtmPublicKeys {
+			if !checkKeyInList(key, recipientPtmPublicKeys[:i]) {
+				txa.PrivateFor = append(txa.PrivateFor, key)
+			}
+		}
+	} else {
+		for _, key := range txa.PrivateFor {
+			if !recipientKeys[key] {
+				return "", errors.New("invalid transaction manager keys given in privateFor argument")
+			}
+		}
+		for key := range recipientKeys {
+			if !checkKeyInList(key, txa.PrivateFor) {
+				return "", errors.New("mismatch between recipient transaction manager key and privateFor argument")
+			}
 		}
-	default:
-		return "", errors.New("invalid transaction manager keys given in privateFor argument")
 	}
 
 	// get all participants for the contract being extended
@@ -349,7 +384,7 @@ func (api *PrivateExtensionAPI) ExtendContract(ctx context.Context, toExtend com
 	psiManagementContractClient := api.privacyService.managementContract(psm.ID)
 	defer psiManagementContractClient.Close()
 	//Deploy the contract
-	tx, err := psiManagementContractClient.Deploy(txArgs, toExtend, recipientAddr, newRecipientPtmPublicKey)
+	tx, err := psiManagementContractClient.Deploy(txArgs, toExtend, recipientAddrs, recipientPtmPublicKeys, dependencies)
 	if err != nil {
 		return "", err
 	}
@@ -417,6 +452,131 @@ func (api *PrivateExtensionAPI) CancelExtension(ctx context.Context, extensionCo
 	return msg, nil
 }
 
+// RemoveParticipants removes parties from a private contract. The contract address is sent to the remaining parties
+// as a new payload, and a removal management contract recording the removed keys and the payload hash is deployed for
+// them. Once mined, the payload hash replaces the creation hash of the contract on the nodes of the remaining parties,
+// so transactions of the removed parties fail the participation check. Only party protection and private state
+// validation contracts are supported, the call is rejected for standard private contracts before any transaction is
+// sent. Only the creator of the contract can remove parties, and it can not remove itself. The removal contract is
+// deployed as a party protection contract, so the nodes of the remaining parties can check it was created by the
+// creator of the contract.
+func (api *PrivateExtensionAPI) RemoveParticipants(ctx context.Context, toRemoveFrom common.Address, removedPtmPublicKeys []string, txa ethapi.SendTxArgs) (string, error) {
+	if api.checkIfContractUnderExtension(ctx, toRemoveFrom) {
+		return "", errors.New("contract extension in progress for the given contract address")
+	}
+
+	isPublic, err := api.checkIfPublicContract(toRemoveFrom)
+	if err != nil {
+		return "", err
+	}
+	if isPublic {
+		return "", errors.New("removing parties from a public contract!!! not allowed")
+	}
+
+	if len(removedPtmPublicKeys) == 0 {
+		return "", errors.New("no party to remove given")
+	}
+
+	psm, err := api.privacyService.apiBackendHelper.PSMR().ResolveForUserContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	privateContractExists, err := api.checkIfPrivateStateExists(psm.ID, toRemoveFrom)
+	if err != nil {
+		return "", err
+	}
+	if !privateContractExists {
+		return "", errors.New("removing parties from a non-existent private contract!!! not allowed")
+	}
+
+	// standard private contracts record neither their creation payload nor
+	// their parties
+	blockHash := api.privacyService.stateFetcher.getCurrentBlockHash()
+	privacyMetaData, err := api.privacyService.stateFetcher.GetPrivacyMetaData(blockHash, toRemoveFrom, psm.ID)
+	if err != nil || privacyMetaData.PrivacyFlag.IsStandardPrivate() {
+		return "", errors.New("parties can only be removed from party protection or private state validation contracts")
+	}
+
+	// check if contract creator
+	if !api.privacyService.CheckIfContractCreator(blockHash, toRemoveFrom, psm.ID) {
+		return "", errors.New("operation not allowed")
+	}
+	if !core.CheckIfAdminAccount(txa.From) {
+		return "", errors.New("account not an org admin account, cannot remove parties")
+	}
+
+	// the nodes of the remaining parties only accept a removal sent by the
+	// sender of the creation payload
+	privateFrom, _, _, _, err := api.privacyService.ptm.Receive(privacyMetaData.CreationTxHash)
+	if err != nil {
+		return "", err
+	}
+	if txa.PrivateFrom != "" && txa.PrivateFrom != privateFrom {
+		return "", errors.New("privateFrom must be the transaction manager key which created the contract")
+	}
+	txa.PrivateFrom = privateFrom
+
+	err = api.doMultiTenantChecks(ctx, txa.From, txa)
+	if err != nil {
+		return "", err
+	}
+
+	participants, err := api.privacyService.GetAllParticipants(blockHash, toRemoveFrom, psm.ID)
+	if err != nil {
+		return "", err
+	}
+	for i, key := range removedPtmPublicKeys {
+		if _, err := base64.StdEncoding.DecodeString(key); err != nil {
+			return "", errors.New("invalid transaction manager key provided")
+		}
+		if key == privateFrom {
+			return "", errors.New("the contract creator cannot be removed")
+		}
+		if checkKeyInList(key, removedPtmPublicKeys[:i]) {
+			return "", fmt.Errorf("transaction manager key %s given more than once", key)
+		}
+		if !checkKeyInList(key, participants) {
+			return "", fmt.Errorf("transaction manager key %s is not a party of the contract", key)
+		}
+	}
+	var remaining []string
+	for _, key := range participants {
+		if !checkKeyInList(key, removedPtmPublicKeys) && !checkKeyInList(key, remaining) {
+			remaining = append(remaining, key)
+		}
+	}
+	if len(txa.PrivateFor) != 0 {
+		return "", errors.New("privateFor argument not allowed, the removal is sent to the remaining parties")
+	}
+	txa.PrivateFor = remaining
+
+	extraMetaData, err := api.privacyService.privacyExtraMetadata(blockHash, toRemoveFrom, psm.ID)
+	if err != nil {
+		return "", err
+	}
+	_, _, hash, err := api.privacyService.ptm.Send(toRemoveFrom.Bytes(), privateFrom, remaining, extraMetaData)
+	if err != nil {
+		return "", err
+	}
+
+	txArgs, err := api.privacyService.GenerateTransactOptions(txa)
+	if err != nil {
+		return "", err
+	}
+	txArgs.PrivacyFlag = engine.PrivacyFlagPartyProtection
+
+	psiManagementContractClient := api.privacyService.managementContract(psm.ID)
+	defer psiManagementContractClient.Close()
+	tx, err := psiManagementContractClient.DeployParticipantRemover(txArgs, toRemoveFrom, removedPtmPublicKeys, hash.ToBase64())
+	if err != nil {
+		return "", err
+	}
+
+	msg := fmt.Sprintf("0x%x", tx.Hash())
+	return msg, nil
+}
+
 // Returns the extension status from management contract
 func (api *PrivateExtensionAPI) GetExtensionStatus(ctx context.Context, extensionContract common.Address) (string, error) {
 	psm, err := api.privacyService.apiBackendHelper.PSMR().ResolveForUserContext(ctx)
@@ -434,3 +594,70 @@ func (api *PrivateExtensionAPI) GetExtensionStatus(ctx context.Context, extensio
 
 	return extensionInProgress, nil
 }
+
+// RecipientStatus is the vote of a recipient of an extension
+type RecipientStatus struct {
+	Address  common.Address `json:"address"`
+	PtmKey   string         `json:"ptmKey"`
+	Voted    bool           `json:"voted"`
+	Accepted bool           `json:"accepted"`
+}
+
+// GetExtensionRecipientsStatus returns the votes of the recipients of an active extension
+func (api *PrivateExtensionAPI) GetExtensionRecipientsStatus(ctx context.Context, extensionContract common.Address) ([]RecipientStatus, error) {
+	psm, err := api.privacyService.apiBackendHelper.PSMR().ResolveForUserContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	api.privacyService.mu.Lock()
+	extension, ok := api.privacyService.psiContracts[psm.ID][extensionContract]
+	api.privacyService.mu.Unlock()
+	if !ok {
+		return nil, errors.New("no active extension for the given management contract address")
+	}
+
+	psiManagementContractClient := api.privacyService.managementContract(psm.ID)
+	defer psiManagementContractClient.Close()
+	caller, err := psiManagementContractClient.Caller(extensionContract)
+	if err != nil {
+		return nil, err
+	}
+	recipients := extension.AllRecipients()
+	statuses := make([]RecipientStatus, len(recipients))
+	for i, recipient := range recipients {
+		statuses[i] = RecipientStatus{Address: recipient.Address, PtmKey: recipient.PtmKey}
+		if statuses[i].Voted, err = caller.CheckIfVoted(&bind.CallOpts{Pending: true, From: recipient.Address}); err != nil {
+			return nil, err
+		}
+		if statuses[i].Accepted, err = caller.Votes(&bind.CallOpts{Pending: true}, recipient.Address); err != nil {
+			return nil, err
+		}
+	}
+	return statuses, nil
+}
+
+// GetExtensionDependencies lists the private contracts which could be shared along with the given contract if it was
+// extended now, and the dependencies which cannot be shared with the reason. Only the dependencies given to
+// ExtendContractToRecipients are shared, they are set together with the extended contract in the private state of
+// the recipients.
+func (api *PrivateExtensionAPI) GetExtensionDependencies(ctx context.Context, toExtend common.Address) ([]ExtensionDependency, error) {
+	psm, err := api.privacyService.apiBackendHelper.PSMR().ResolveForUserContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	privateContractExists, err := api.checkIfPrivateStateExists(psm.ID, toExtend)
+	if err != nil {
+		return nil, err
+	}
+	if !privateContractExists {
+		return nil, errors.New("non-existent private contract")
+	}
+	dependencies, err := api.privacyService.extensionDependencies(api.privacyService.stateFetcher.getCurrentBlockHash(), toExtend, psm.ID)
+	if err != nil {
+		return nil, err
+	}
+	if dependencies == nil {
+		dependencies = make([]ExtensionDependency, 0)
+	}
+	return dependencies, nil
+}
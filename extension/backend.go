@@ -34,6 +34,7 @@ type PrivacyService struct {
 
 	mu           sync.Mutex
 	psiContracts map[types.PrivateStateIdentifier]map[common.Address]*ExtensionContract
+	quit         chan struct{} // closed on stop, ends the rebuild of the extensions
 
 	node   *node.Node
 	config *params.ChainConfig
@@ -89,6 +90,7 @@ func New(stack *node.Node, ptm private.PrivateTransactionManager, manager *accou
 		node:             stack,
 		config:           config,
 		isQlightClient:   false,
+		quit:             make(chan struct{}),
 	}
 
 	apiSupport, ok := service.apiBackendHelper.(ethapi.ProxyAPISupport)
@@ -116,29 +118,29 @@ func (service *PrivacyService) watchForNewContracts(psi types.PrivateStateIdenti
 
 	cb := func(foundLog types.Log) {
 		service.mu.Lock()
+		if foundLog.Removed {
+			// the management contract creation was reverted by a chain reorg
+			if ext, ok := service.psiContracts[psi][foundLog.Address]; ok && (ext.BlockHash == common.Hash{} || ext.BlockHash == foundLog.BlockHash) {
+				delete(service.psiContracts[psi], foundLog.Address)
+				if err := service.dataHandler.Save(service.psiContracts); err != nil {
+					log.Error("Failed to store list of contracts being extended", "error", err)
+				}
+			}
+			service.mu.Unlock()
+			return
+		}
+
 		psiClient := service.client(psi)
 		defer psiClient.Close()
-		tx, _ := service.client(psi).TransactionInBlock(foundLog.BlockHash, foundLog.TxIndex)
-		from, _ := types.QuorumPrivateTxSigner{}.Sender(tx)
-
-		newExtensionEvent, err := extensionContracts.UnpackNewExtensionCreatedLog(foundLog.Data)
+		newContractExtension, err := extensionFromLog(psiClient, foundLog)
 		if err != nil {
-			log.Error("Error unpacking extension creation log", "error", err)
+			log.Error("Error reading extension creation log", "error", err)
 			log.Debug("Errored log", foundLog)
 			service.mu.Unlock()
 			return
 		}
 
-		newContractExtension := ExtensionContract{
-			ContractExtended:          newExtensionEvent.ToExtend,
-			Initiator:                 from,
-			Recipient:                 newExtensionEvent.RecipientAddress,
-			RecipientPtmKey:           newExtensionEvent.RecipientPTMKey,
-			ManagementContractAddress: foundLog.Address,
-			CreationData:              tx.Data(),
-		}
-
-		enclaveKey := common.BytesToEncryptedPayloadHash(tx.Data())
+		enclaveKey := common.BytesToEncryptedPayloadHash(newContractExtension.CreationData)
 		privateFrom, _, _, _, err := service.ptm.Receive(enclaveKey)
 		if err != nil {
 			log.Error("Error receiving private payload", "error", err)
@@ -149,7 +151,7 @@ func (service *PrivacyService) watchForNewContracts(psi types.PrivateStateIdenti
 		if service.psiContracts[psi] == nil {
 			service.psiContracts[psi] = make(map[common.Address]*ExtensionContract)
 		}
-		service.psiContracts[psi][foundLog.Address] = &newContractExtension
+		service.psiContracts[psi][foundLog.Address] = newContractExtension
 
 		if err := service.dataHandler.Save(service.psiContracts); err != nil {
 			log.Error("Error writing extension data to file", "error", err)
@@ -200,12 +202,169 @@ func (service *PrivacyService) watchForNewContracts(psi types.PrivateStateIdenti
 	return handler.createSub(newExtensionQuery, cb)
 }
 
+// extensionFromLog returns the extension of a management contract from its
+// creation log
+func extensionFromLog(client Client, foundLog types.Log) (*ExtensionContract, error) {
+	tx, err := client.TransactionInBlock(foundLog.BlockHash, foundLog.TxIndex)
+	if err != nil {
+		return nil, err
+	}
+	// the transaction is in the chain, its chain ID has already been verified
+	from, _ := types.NewQuorumPrivateTxSigner(tx.ChainId()).Sender(tx)
+
+	unpack := extensionContracts.UnpackNewExtensionCreatedLog
+	if foundLog.Topics[0] == common.HexToHash(extensionContracts.LegacyNewContractExtensionContractCreatedTopicHash) {
+		unpack = extensionContracts.UnpackLegacyNewExtensionCreatedLog
+	}
+	newExtensionEvent, err := unpack(foundLog.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(newExtensionEvent.RecipientAddresses) == 0 || len(newExtensionEvent.RecipientAddresses) != len(newExtensionEvent.RecipientPTMKeys) {
+		return nil, fmt.Errorf("invalid recipients in extension creation log of %s", foundLog.Address.Hex())
+	}
+	recipients := make([]ExtensionRecipient, len(newExtensionEvent.RecipientAddresses))
+	for i, address := range newExtensionEvent.RecipientAddresses {
+		recipients[i] = ExtensionRecipient{Address: address, PtmKey: newExtensionEvent.RecipientPTMKeys[i]}
+	}
+
+	return &ExtensionContract{
+		ContractExtended:          newExtensionEvent.ToExtend,
+		Initiator:                 from,
+		Recipient:                 recipients[0].Address,
+		RecipientPtmKey:           recipients[0].PtmKey,
+		Recipients:                recipients,
+		ManagementContractAddress: foundLog.Address,
+		CreationData:              tx.Data(),
+		BlockNumber:               foundLog.BlockNumber,
+		BlockHash:                 foundLog.BlockHash,
+	}, nil
+}
+
+// activeExtensions returns the extensions of the management contracts created
+// and not finished in the current chain up to the given block, or the latest
+// block if nil, only considering the given management contracts if any
+func activeExtensions(client Client, toBlock *big.Int, managementContracts ...common.Address) (map[common.Address]*ExtensionContract, error) {
+	createdQuery, finishedQuery := newExtensionQuery, finishedExtensionQuery
+	createdQuery.FromBlock, finishedQuery.FromBlock = big.NewInt(0), big.NewInt(0)
+	createdQuery.ToBlock, finishedQuery.ToBlock = toBlock, toBlock
+	createdQuery.Addresses, finishedQuery.Addresses = managementContracts, managementContracts
+
+	created, err := client.FilterLogs(createdQuery)
+	if err != nil {
+		return nil, err
+	}
+	finished, err := client.FilterLogs(finishedQuery)
+	if err != nil {
+		return nil, err
+	}
+	isFinished := make(map[common.Address]bool)
+	for _, l := range finished {
+		isFinished[l.Address] = true
+	}
+	active := make(map[common.Address]*ExtensionContract)
+	for _, l := range created {
+		if isFinished[l.Address] {
+			continue
+		}
+		ext, err := extensionFromLog(client, l)
+		if err != nil {
+			log.Error("Error reading extension creation log", "address", l.Address.Hex(), "error", err)
+			continue
+		}
+		active[l.Address] = ext
+	}
+	return active, nil
+}
+
+// restoreExtension adds the extension of a management contract if it is active
+// in the current chain
+func (service *PrivacyService) restoreExtension(psi types.PrivateStateIdentifier, managementContract common.Address) error {
+	psiClient := service.client(psi)
+	defer psiClient.Close()
+	active, err := activeExtensions(psiClient, nil, managementContract)
+	if err != nil {
+		return err
+	}
+	ext, ok := active[managementContract]
+	if !ok {
+		return nil
+	}
+	if service.psiContracts[psi] == nil {
+		service.psiContracts[psi] = make(map[common.Address]*ExtensionContract)
+	}
+	service.psiContracts[psi][managementContract] = ext
+	return service.dataHandler.Save(service.psiContracts)
+}
+
+// rebuildExtensions rebuilds the extensions of the private states from the
+// chain events up to the current block. It reads the logs from the genesis
+// block so it runs in the background, the watches handle the extensions
+// created or finished meanwhile and the rebuilt ones are merged with them.
+func (service *PrivacyService) rebuildExtensions() {
+	head := new(big.Int).SetUint64(service.stateFetcher.chainAccessor.CurrentBlock().NumberU64())
+	rebuilt := make(map[types.PrivateStateIdentifier]map[common.Address]*ExtensionContract)
+	for _, psi := range service.apiBackendHelper.PSMR().PSIs() {
+		psiClient := service.client(psi)
+		active, err := activeExtensions(psiClient, head)
+		psiClient.Close()
+		if err != nil {
+			log.Error("Failed to rebuild extension contracts from chain events", "psi", psi, "error", err)
+			return
+		}
+		rebuilt[psi] = active
+		select {
+		case <-service.quit:
+			return
+		default:
+		}
+	}
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	finishedQuery := finishedExtensionQuery
+	finishedQuery.FromBlock = new(big.Int).Add(head, big.NewInt(1))
+	for psi, active := range rebuilt {
+		// the extensions finished after the rebuilt block, which the watch
+		// removed before they were rebuilt
+		psiClient := service.client(psi)
+		finished, err := psiClient.FilterLogs(finishedQuery)
+		psiClient.Close()
+		if err != nil {
+			log.Error("Failed to rebuild extension contracts from chain events", "psi", psi, "error", err)
+			return
+		}
+		for _, l := range finished {
+			delete(active, l.Address)
+		}
+		if service.psiContracts[psi] == nil {
+			service.psiContracts[psi] = make(map[common.Address]*ExtensionContract)
+		}
+		for address, ext := range active {
+			if _, ok := service.psiContracts[psi][address]; !ok {
+				service.psiContracts[psi][address] = ext
+			}
+		}
+		log.Info("Rebuilt extension contracts from chain events", "psi", psi, "active", len(service.psiContracts[psi]))
+	}
+	if err := service.dataHandler.(rebuildableDataHandler).Rebuilt(service.psiContracts); err != nil {
+		log.Error("Failed to store the rebuilt extension contracts", "error", err)
+	}
+}
+
 func (service *PrivacyService) watchForCancelledContracts(psi types.PrivateStateIdentifier) error {
 	handler := NewSubscriptionHandler(service.node, psi, service.ptm, service)
 
 	cb := func(l types.Log) {
 		service.mu.Lock()
-		if _, ok := service.psiContracts[psi][l.Address]; ok {
+		if l.Removed {
+			// the extension is active again if the finish was reverted by a
+			// chain reorg
+			if err := service.restoreExtension(psi, l.Address); err != nil {
+				log.Error("Failed to restore extension contract", "address", l.Address.Hex(), "error", err)
+			}
+		} else if _, ok := service.psiContracts[psi][l.Address]; ok {
 			delete(service.psiContracts[psi], l.Address)
 			if err := service.dataHandler.Save(service.psiContracts); err != nil {
 				log.Error("Failed to store list of contracts being extended", "error", err)
@@ -294,46 +453,44 @@ func (service *PrivacyService) watchForCompletionEvents(psi types.PrivateStateId
 			log.Error("[contract] caller.ContractToExtend", "error", err)
 			return
 		}
-		log.Debug("Extension: dump current state", "block", l.BlockHash, "contract", contractToExtend.Hex(), "psi", txPsi.ID)
-		entireStateData, err := service.stateFetcher.GetAddressStateFromBlock(l.BlockHash, contractToExtend, txPsi.ID)
+		// only the dependencies listed in the extension, which the voters
+		// approved, are shared along with the contract
+		sharedDependencies, err := service.stateFetcher.GetApprovedDependencies(l.BlockHash, l.Address, txPsi.ID)
+		if err != nil {
+			log.Error("[state] service.stateFetcher.GetApprovedDependencies", "block", l.BlockHash.Hex(), "error", err)
+			return
+		}
+		if err := service.checkApprovedDependencies(l.BlockHash, contractToExtend, txPsi.ID, sharedDependencies); err != nil {
+			log.Error("Extension: unable to share the dependencies of the contract", "contract", contractToExtend.Hex(), "error", err)
+			return
+		}
+		log.Debug("Extension: dump current state", "block", l.BlockHash, "contract", contractToExtend.Hex(), "dependencies", sharedDependencies, "psi", txPsi.ID)
+		entireStateData, err := service.stateFetcher.GetAddressStateFromBlock(l.BlockHash, contractToExtend, txPsi.ID, sharedDependencies...)
 		if err != nil {
 			log.Error("[state] service.stateFetcher.GetAddressStateFromBlock", "block", l.BlockHash.Hex(), "contract", contractToExtend.Hex(), "error", err)
 			return
 		}
 
-		log.Debug("Extension: send the state dump to the new recipient", "recipients", fetchedParties)
+		// a recipient which declined the extension does not receive the state
+		stateRecipients, err := service.acceptedParties(caller, extensionEntry, fetchedParties)
+		if err != nil {
+			log.Error("Extension: unable to fetch the votes of the recipients", "address", l.Address.Hex(), "error", err)
+			return
+		}
+		log.Debug("Extension: send the state dump to the new recipients", "recipients", stateRecipients)
 
 		// PSV & PP changes
 		// send the new transaction with state dump to all participants
-		extraMetaData := engine.ExtraMetadata{PrivacyFlag: engine.PrivacyFlagStandardPrivate}
-		privacyMetaData, err := service.stateFetcher.GetPrivacyMetaData(l.BlockHash, contractToExtend, txPsi.ID)
+		extraMetaData, err := service.privacyExtraMetadata(l.BlockHash, contractToExtend, txPsi.ID)
 		if err != nil {
-			log.Error("[privacyMetaData] fetch err", "err", err)
-		} else {
-			extraMetaData.PrivacyFlag = privacyMetaData.PrivacyFlag
-			if privacyMetaData.PrivacyFlag == engine.PrivacyFlagStateValidation {
-				storageRoot, err := service.stateFetcher.GetStorageRoot(l.BlockHash, contractToExtend, txPsi.ID)
-				if err != nil {
-					log.Error("[storageRoot] fetch err", "err", err)
-				}
-				extraMetaData.ACMerkleRoot = storageRoot
-			}
-			// Fetch mandatory recipients data from Tessera - only when privacy flag is 2
-			if privacyMetaData.PrivacyFlag == engine.PrivacyFlagMandatoryRecipients {
-				fetchedMandatoryRecipients, err := service.ptm.GetMandatory(privacyMetaData.CreationTxHash)
-				if err != nil || len(fetchedMandatoryRecipients) == 0 {
-					log.Error("Extension: Unable to fetch mandatory parties for extension management contract", "error", err)
-					return
-				}
-				log.Debug("Extension: able to fetch mandatory recipients", "mandatory", fetchedMandatoryRecipients)
-				extraMetaData.MandatoryRecipients = fetchedMandatoryRecipients
-			}
+			log.Error("Extension: Unable to fetch mandatory parties for extension management contract", "error", err)
+			return
 		}
 
-		_, _, hashOfStateData, err := service.ptm.Send(entireStateData, privateFrom, fetchedParties, &extraMetaData)
+		_, _, hashOfStateData, err := service.ptm.Send(entireStateData, privateFrom, stateRecipients, extraMetaData)
 
 		if err != nil {
-			log.Error("[ptm] service.ptm.Send", "stateDataInHex", hex.EncodeToString(entireStateData[:]), "recipients", fetchedParties, "error", err)
+			log.Error("[ptm] service.ptm.Send", "stateDataInHex", hex.EncodeToString(entireStateData[:]), "recipients", stateRecipients, "error", err)
 			return
 		}
 		hashofStateDataBase64 := hashOfStateData.ToBase64()
@@ -354,6 +511,144 @@ func (service *PrivacyService) watchForCompletionEvents(psi types.PrivateStateId
 	return handler.createSub(canPerformStateShareQuery, cb)
 }
 
+// extensionDependencies returns the private contracts a contract to extend
+// depends on. A dependency can be shared if it has the privacy flag of the
+// contract and if its parties are parties of the contract, so that extending
+// it does not change who can transact with it. The parties of a contract
+// without privacy metadata, a standard private contract which was never
+// extended, are not known, so its dependencies cannot be shared.
+func (service *PrivacyService) extensionDependencies(blockHash common.Hash, toExtend common.Address, psi types.PrivateStateIdentifier) ([]ExtensionDependency, error) {
+	privacyFlag := engine.PrivacyFlagStandardPrivate
+	var participants []string
+	partiesKnown := false
+	if privacyMetaData, err := service.stateFetcher.GetPrivacyMetaData(blockHash, toExtend, psi); err == nil {
+		privacyFlag = privacyMetaData.PrivacyFlag
+		if participants, err = service.ptm.GetParticipants(privacyMetaData.CreationTxHash); err != nil {
+			return nil, err
+		}
+		partiesKnown = true
+	}
+	check := func(dependency common.Address) error {
+		dependencyFlag := engine.PrivacyFlagStandardPrivate
+		privacyMetaData, err := service.stateFetcher.GetPrivacyMetaData(blockHash, dependency, psi)
+		if err == nil {
+			dependencyFlag = privacyMetaData.PrivacyFlag
+		}
+		if dependencyFlag != privacyFlag {
+			return fmt.Errorf("privacy flag %d differs from the privacy flag %d of the contract", dependencyFlag, privacyFlag)
+		}
+		if !partiesKnown {
+			return errors.New("the parties of the contract are not known")
+		}
+		if err != nil {
+			return errors.New("the parties of the dependency are not known")
+		}
+		dependencyParticipants, err := service.ptm.GetParticipants(privacyMetaData.CreationTxHash)
+		if err != nil {
+			return err
+		}
+		for _, participant := range dependencyParticipants {
+			if !checkKeyInList(participant, participants) {
+				return fmt.Errorf("party %s is not a party of the contract", participant)
+			}
+		}
+		return nil
+	}
+	return service.stateFetcher.GetDependencies(blockHash, toExtend, psi, check)
+}
+
+// checkApprovedDependencies checks that the dependencies listed in an
+// extension can be shared along with the contract at the given block
+func (service *PrivacyService) checkApprovedDependencies(blockHash common.Hash, toExtend common.Address, psi types.PrivateStateIdentifier, approved []common.Address) error {
+	if len(approved) == 0 {
+		return nil
+	}
+	dependencies, err := service.extensionDependencies(blockHash, toExtend, psi)
+	if err != nil {
+		return err
+	}
+	for i, address := range approved {
+		if checkAddressInList(address, approved[:i]) {
+			return fmt.Errorf("dependency %s given more than once", address.Hex())
+		}
+		found := false
+		for _, dependency := range dependencies {
+			if dependency.Address != address {
+				continue
+			}
+			if !dependency.Shared {
+				return fmt.Errorf("dependency %s cannot be shared: %s", address.Hex(), dependency.Reason)
+			}
+			found = true
+		}
+		if !found {
+			return fmt.Errorf("contract %s is not a dependency of the contract", address.Hex())
+		}
+	}
+	return nil
+}
+
+// privacyExtraMetadata returns the extra metadata of a payload replacing the
+// creation payload of a contract, carrying the privacy flag of the contract
+func (service *PrivacyService) privacyExtraMetadata(blockHash common.Hash, contract common.Address, psi types.PrivateStateIdentifier) (*engine.ExtraMetadata, error) {
+	extraMetaData := &engine.ExtraMetadata{PrivacyFlag: engine.PrivacyFlagStandardPrivate}
+	privacyMetaData, err := service.stateFetcher.GetPrivacyMetaData(blockHash, contract, psi)
+	if err != nil {
+		log.Error("[privacyMetaData] fetch err", "err", err)
+		return extraMetaData, nil
+	}
+	extraMetaData.PrivacyFlag = privacyMetaData.PrivacyFlag
+	if privacyMetaData.PrivacyFlag == engine.PrivacyFlagStateValidation {
+		storageRoot, err := service.stateFetcher.GetStorageRoot(blockHash, contract, psi)
+		if err != nil {
+			log.Error("[storageRoot] fetch err", "err", err)
+		}
+		extraMetaData.ACMerkleRoot = storageRoot
+	}
+	// Fetch mandatory recipients data from Tessera - only when privacy flag is 2
+	if privacyMetaData.PrivacyFlag == engine.PrivacyFlagMandatoryRecipients {
+		fetchedMandatoryRecipients, err := service.ptm.GetMandatory(privacyMetaData.CreationTxHash)
+		if err != nil {
+			return nil, err
+		}
+		if len(fetchedMandatoryRecipients) == 0 {
+			return nil, errors.New("no mandatory recipients found")
+		}
+		log.Debug("Extension: able to fetch mandatory recipients", "mandatory", fetchedMandatoryRecipients)
+		extraMetaData.MandatoryRecipients = fetchedMandatoryRecipients
+	}
+	return extraMetaData, nil
+}
+
+// acceptedParties removes from the parties the keys of the recipients which
+// declined the extension, unless the key is also the key of a recipient which
+// accepted
+func (service *PrivacyService) acceptedParties(caller *extensionContracts.ContractExtenderCaller, extension *ExtensionContract, parties []string) ([]string, error) {
+	declined := make(map[string]bool)
+	var accepted []string
+	for _, recipient := range extension.AllRecipients() {
+		vote, err := caller.Votes(nil, recipient.Address)
+		if err != nil {
+			return nil, err
+		}
+		if vote {
+			accepted = append(accepted, recipient.PtmKey)
+		} else {
+			declined[recipient.PtmKey] = true
+		}
+	}
+	for _, key := range accepted {
+		delete(declined, key)
+	}
+	filtered := make([]string, 0, len(parties))
+	for _, party := range parties {
+		if !declined[party] {
+			filtered = append(filtered, party)
+		}
+	}
+	return filtered, nil
+}
+
 // utility methods
 func (service *PrivacyService) apis() []rpc.API {
 	return []rpc.API{
@@ -385,11 +680,18 @@ func (service *PrivacyService) Start() error {
 		}
 	}
 
+	// the data handler has no record of the extensions, for example on first
+	// start or after the database was lost
+	if handler, ok := service.dataHandler.(rebuildableDataHandler); ok && handler.Missing() {
+		go service.rebuildExtensions()
+	}
+
 	return nil
 }
 
 func (service *PrivacyService) Stop() error {
 	log.Info("extension service: stopping")
+	close(service.quit)
 	service.stopFeed.Send(stopEvent{})
 	log.Info("extension service: stopped")
 	return nil
@@ -8,11 +8,20 @@ import (
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/eth"
 	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/extension/extensionContracts"
 	"github.com/ethereum/go-ethereum/internal/ethapi"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/private"
+	"github.com/ethereum/go-ethereum/private/engine"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type MockBackend struct {
@@ -232,3 +241,154 @@ func TestGenerateTransactionOptionsGivesNonDefaultsWhenSpecified(t *testing.T) {
 		return
 	}
 }
+
+type MockClient struct {
+	Client
+	logs []types.Log
+	txs  map[common.Hash]*types.Transaction
+}
+
+func (client *MockClient) FilterLogs(query ethereum.FilterQuery) ([]types.Log, error) {
+	var found []types.Log
+	for _, l := range client.logs {
+		matches := len(query.Addresses) == 0
+		if (query.FromBlock != nil && l.BlockNumber < query.FromBlock.Uint64()) || (query.ToBlock != nil && l.BlockNumber > query.ToBlock.Uint64()) {
+			continue
+		}
+		for _, address := range query.Addresses {
+			matches = matches || address == l.Address
+		}
+		for _, topic := range query.Topics[0] {
+			if matches && topic == l.Topics[0] {
+				found = append(found, l)
+			}
+		}
+	}
+	return found, nil
+}
+
+func (client *MockClient) TransactionInBlock(blockHash common.Hash, txIndex uint) (*types.Transaction, error) {
+	return client.txs[blockHash], nil
+}
+
+func TestActiveExtensions(t *testing.T) {
+	toExtend := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	recipient := common.HexToAddress("0x4444444444444444444444444444444444444444")
+	active, finished := common.HexToAddress("0x2222222222222222222222222222222222222222"), common.HexToAddress("0x3333333333333333333333333333333333333333")
+
+	data, err := extensionContracts.ContractExtenderParsedABI.Events["NewContractExtensionContractCreated"].Inputs.Pack(toExtend, []common.Address{recipient}, []string{"key1"})
+	require.NoError(t, err)
+	created := func(address common.Address, block uint64) types.Log {
+		return types.Log{
+			Address:     address,
+			Topics:      []common.Hash{common.HexToHash(extensionContracts.NewContractExtensionContractCreatedTopicHash)},
+			Data:        data,
+			BlockNumber: block,
+			BlockHash:   common.BigToHash(new(big.Int).SetUint64(block)),
+		}
+	}
+	client := &MockClient{
+		logs: []types.Log{
+			created(active, 5),
+			created(finished, 6),
+			{Address: finished, Topics: []common.Hash{common.HexToHash(extensionContracts.ExtensionFinishedTopicHash)}, BlockNumber: 7},
+		},
+		txs: map[common.Hash]*types.Transaction{
+			common.BigToHash(big.NewInt(5)): types.NewTransaction(0, common.Address{}, nil, 0, nil, []byte("creation5")),
+			common.BigToHash(big.NewInt(6)): types.NewTransaction(0, common.Address{}, nil, 0, nil, []byte("creation6")),
+		},
+	}
+
+	extensions, err := activeExtensions(client, nil)
+	require.NoError(t, err)
+	require.Len(t, extensions, 1)
+	assert.Equal(t, &ExtensionContract{
+		ContractExtended:          toExtend,
+		Recipient:                 recipient,
+		RecipientPtmKey:           "key1",
+		Recipients:                []ExtensionRecipient{{Address: recipient, PtmKey: "key1"}},
+		ManagementContractAddress: active,
+		CreationData:              []byte("creation5"),
+		BlockNumber:               5,
+		BlockHash:                 common.BigToHash(big.NewInt(5)),
+	}, extensions[active])
+
+	extensions, err = activeExtensions(client, nil, finished)
+	require.NoError(t, err)
+	assert.Empty(t, extensions)
+
+	// the extension was finished after the given block
+	extensions, err = activeExtensions(client, big.NewInt(6), finished)
+	require.NoError(t, err)
+	assert.Len(t, extensions, 1)
+}
+
+type dependencyChainAccessor struct {
+	ChainAccessor
+	privateState *state.StateDB
+}
+
+func (accessor *dependencyChainAccessor) GetBlockByHash(common.Hash) *types.Block {
+	return types.NewBlockWithHeader(&types.Header{})
+}
+
+func (accessor *dependencyChainAccessor) StateAtPSI(common.Hash, types.PrivateStateIdentifier) (*state.StateDB, *state.StateDB, error) {
+	return nil, accessor.privateState, nil
+}
+
+func TestCheckApprovedDependencies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	contract := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	library := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	otherParties := common.HexToAddress("0x4444444444444444444444444444444444444444")
+	standardPrivate := common.HexToAddress("0x5555555555555555555555555555555555555555")
+	unreferenced := common.HexToAddress("0x6666666666666666666666666666666666666666")
+	standardPrivateContract := common.HexToAddress("0x7777777777777777777777777777777777777777")
+
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	var code []byte
+	for _, address := range []common.Address{library, otherParties, standardPrivate} {
+		code = append(append(code, byte(vm.PUSH20)), address.Bytes()...)
+	}
+	statedb.SetCode(contract, code)
+	statedb.SetCode(standardPrivateContract, code)
+	for i, address := range []common.Address{contract, library, otherParties} {
+		statedb.SetPrivacyMetadata(address, &state.PrivacyMetadata{CreationTxHash: common.EncryptedPayloadHash{byte(i + 1)}, PrivacyFlag: engine.PrivacyFlagPartyProtection})
+	}
+	for _, address := range []common.Address{library, otherParties, standardPrivate, unreferenced} {
+		statedb.SetCode(address, []byte{1})
+	}
+
+	ptm := private.NewMockPrivateTransactionManager(ctrl)
+	ptm.EXPECT().GetParticipants(common.EncryptedPayloadHash{1}).Return([]string{"A", "B"}, nil).AnyTimes()
+	ptm.EXPECT().GetParticipants(common.EncryptedPayloadHash{2}).Return([]string{"A"}, nil).AnyTimes()
+	ptm.EXPECT().GetParticipants(common.EncryptedPayloadHash{3}).Return([]string{"A", "C"}, nil).AnyTimes()
+	service := &PrivacyService{ptm: ptm, stateFetcher: NewStateFetcher(&dependencyChainAccessor{privateState: statedb})}
+
+	tests := []struct {
+		name     string
+		toExtend common.Address
+		approved []common.Address
+		err      string
+	}{
+		{"none approved", standardPrivateContract, nil, ""},
+		{"dependency with parties of the contract", contract, []common.Address{library}, ""},
+		{"dependency with other parties", contract, []common.Address{otherParties}, "dependency " + otherParties.Hex() + " cannot be shared: party C is not a party of the contract"},
+		{"standard private dependency", contract, []common.Address{standardPrivate}, "dependency " + standardPrivate.Hex() + " cannot be shared: privacy flag 0 differs from the privacy flag 1 of the contract"},
+		{"standard private contract", standardPrivateContract, []common.Address{standardPrivate}, "dependency " + standardPrivate.Hex() + " cannot be shared: the parties of the contract are not known"},
+		{"not a dependency", contract, []common.Address{unreferenced}, "contract " + unreferenced.Hex() + " is not a dependency of the contract"},
+		{"duplicate", contract, []common.Address{library, library}, "dependency " + library.Hex() + " given more than once"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := service.checkApprovedDependencies(common.Hash{}, tt.toExtend, types.DefaultPrivateStateIdentifier, tt.approved)
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
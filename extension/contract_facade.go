@@ -13,7 +13,8 @@ import (
 type ManagementContractFacade interface {
 	Transactor(managementAddress common.Address) (*extensionContracts.ContractExtenderTransactor, error)
 	Caller(managementAddress common.Address) (*extensionContracts.ContractExtenderCaller, error)
-	Deploy(args *bind.TransactOpts, toExtend common.Address, recipientAddress common.Address, recipientHash string) (*types.Transaction, error)
+	Deploy(args *bind.TransactOpts, toExtend common.Address, recipientAddresses []common.Address, recipientHashes []string, dependencies []common.Address) (*types.Transaction, error)
+	DeployParticipantRemover(args *bind.TransactOpts, toRemoveFrom common.Address, removedHashes []string, hash string) (*types.Transaction, error)
 
 	GetAllVoters(addressToVoteOn common.Address) ([]common.Address, error)
 	Close()
@@ -35,8 +36,13 @@ func (facade EthclientManagementContractFacade) Caller(managementAddress common.
 	return extensionContracts.NewContractExtenderCaller(managementAddress, facade.client)
 }
 
-func (facade EthclientManagementContractFacade) Deploy(args *bind.TransactOpts, toExtend common.Address, recipientAddress common.Address, recipientHash string) (*types.Transaction, error) {
-	_, tx, _, err := extensionContracts.DeployContractExtender(args, facade.client, toExtend, recipientAddress, recipientHash)
+func (facade EthclientManagementContractFacade) Deploy(args *bind.TransactOpts, toExtend common.Address, recipientAddresses []common.Address, recipientHashes []string, dependencies []common.Address) (*types.Transaction, error) {
+	_, tx, _, err := extensionContracts.DeployContractExtender(args, facade.client, toExtend, recipientAddresses, recipientHashes, dependencies)
+	return tx, err
+}
+
+func (facade EthclientManagementContractFacade) DeployParticipantRemover(args *bind.TransactOpts, toRemoveFrom common.Address, removedHashes []string, hash string) (*types.Transaction, error) {
+	_, tx, _, err := extensionContracts.DeployContractParticipantRemover(args, facade.client, toRemoveFrom, removedHashes, hash)
 	return tx, err
 }
 
@@ -1,13 +1,16 @@
 package extension
 
 import (
+	"bytes"
 	"encoding/json"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/log"
 )
 
@@ -41,6 +44,14 @@ type DataHandler interface {
 	Save(extensionContracts map[types.PrivateStateIdentifier]map[common.Address]*ExtensionContract) error
 }
 
+// rebuildableDataHandler is a data handler which may have no record of the
+// extensions, which are then rebuilt from the chain events
+type rebuildableDataHandler interface {
+	DataHandler
+	Missing() bool
+	Rebuilt(extensionContracts map[types.PrivateStateIdentifier]map[common.Address]*ExtensionContract) error
+}
+
 type JsonFileDataHandler struct {
 	saveFile string
 }
@@ -105,3 +116,104 @@ func (handler *JsonFileDataHandler) Save(extensionContracts map[types.PrivateSta
 	}
 	return nil
 }
+
+// DBDataHandler stores the active extension contracts in the chain database,
+// an entry per private state and management contract, so that a save is
+// atomic. On first load, the entries are migrated from the JSON file of
+// earlier versions. If there is none, the entries are missing and must be
+// rebuilt from the chain events, they are not marked as stored until then so
+// that an interrupted rebuild is done again.
+type DBDataHandler struct {
+	db       ethdb.KeyValueStore
+	jsonFile *JsonFileDataHandler
+	missing  bool
+}
+
+func NewDBDataHandler(db ethdb.KeyValueStore, dataDirectory string) *DBDataHandler {
+	return &DBDataHandler{
+		db:       db,
+		jsonFile: NewJsonFileDataHandler(dataDirectory),
+	}
+}
+
+func (handler *DBDataHandler) Load() (map[types.PrivateStateIdentifier]map[common.Address]*ExtensionContract, error) {
+	if !rawdb.ReadExtensionContractsStored(handler.db) {
+		return handler.migrate()
+	}
+
+	extensionContracts := map[types.PrivateStateIdentifier]map[common.Address]*ExtensionContract{types.DefaultPrivateStateIdentifier: {}}
+	for psi, stored := range rawdb.ReadExtensionContracts(handler.db) {
+		extensionContracts[psi] = make(map[common.Address]*ExtensionContract)
+		for address, data := range stored {
+			var ext ExtensionContract
+			if err := json.Unmarshal(data, &ext); err != nil {
+				return nil, err
+			}
+			extensionContracts[psi][address] = &ext
+		}
+	}
+	return extensionContracts, nil
+}
+
+// migrate moves the entries of the JSON file to the database
+func (handler *DBDataHandler) migrate() (map[types.PrivateStateIdentifier]map[common.Address]*ExtensionContract, error) {
+	if _, err := os.Stat(handler.jsonFile.saveFile); os.IsNotExist(err) {
+		handler.missing = true
+		return map[types.PrivateStateIdentifier]map[common.Address]*ExtensionContract{types.DefaultPrivateStateIdentifier: {}}, nil
+	}
+	extensionContracts, err := handler.jsonFile.Load()
+	if err != nil {
+		return nil, err
+	}
+	if err := handler.Save(extensionContracts); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(handler.jsonFile.saveFile, handler.jsonFile.saveFile+".migrated"); err != nil {
+		log.Warn("Failed to rename migrated extension contracts file", "file", handler.jsonFile.saveFile, "err", err)
+	}
+	log.Info("Migrated extension contracts to the database", "file", handler.jsonFile.saveFile)
+	return extensionContracts, nil
+}
+
+// Missing returns whether the entries were neither saved nor migrated, so
+// they must be rebuilt from the chain events
+func (handler *DBDataHandler) Missing() bool {
+	return handler.missing
+}
+
+// Save writes the changed entries and deletes the removed ones in a batch
+func (handler *DBDataHandler) Save(extensionContracts map[types.PrivateStateIdentifier]map[common.Address]*ExtensionContract) error {
+	stored := rawdb.ReadExtensionContracts(handler.db)
+	batch := handler.db.NewBatch()
+	for psi, contracts := range extensionContracts {
+		for address, ext := range contracts {
+			//no unmarshallable types, so can't error
+			data, _ := json.Marshal(ext)
+			if !bytes.Equal(stored[psi][address], data) {
+				rawdb.WriteExtensionContract(batch, psi, address, data)
+			}
+		}
+	}
+	for psi, contracts := range stored {
+		for address := range contracts {
+			if _, ok := extensionContracts[psi][address]; !ok {
+				rawdb.DeleteExtensionContract(batch, psi, address)
+			}
+		}
+	}
+	if !handler.missing {
+		rawdb.WriteExtensionContractsStored(batch)
+	}
+	if err := batch.Write(); err != nil {
+		log.Error("Couldn't save outstanding extension contract details")
+		return err
+	}
+	return nil
+}
+
+// Rebuilt saves the entries rebuilt from the chain events, which are no
+// longer missing
+func (handler *DBDataHandler) Rebuilt(extensionContracts map[types.PrivateStateIdentifier]map[common.Address]*ExtensionContract) error {
+	handler.missing = false
+	return handler.Save(extensionContracts)
+}
@@ -4,9 +4,11 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -14,10 +16,14 @@ import (
 func TestWriteContentsToFileWritesOkay(t *testing.T) {
 	extensionContracts := make(map[common.Address]*ExtensionContract)
 	extensionContracts[common.HexToAddress("0x2222222222222222222222222222222222222222")] = &ExtensionContract{
-		ContractExtended:          common.HexToAddress("0x1111111111111111111111111111111111111111"),
-		Initiator:                 common.HexToAddress("0x3333333333333333333333333333333333333333"),
-		Recipient:                 common.HexToAddress("0x4444444444444444444444444444444444444444"),
-		RecipientPtmKey:           "1234567891234567891234567891234567891234567=",
+		ContractExtended: common.HexToAddress("0x1111111111111111111111111111111111111111"),
+		Initiator:        common.HexToAddress("0x3333333333333333333333333333333333333333"),
+		Recipient:        common.HexToAddress("0x4444444444444444444444444444444444444444"),
+		RecipientPtmKey:  "1234567891234567891234567891234567891234567=",
+		Recipients: []ExtensionRecipient{
+			{Address: common.HexToAddress("0x4444444444444444444444444444444444444444"), PtmKey: "1234567891234567891234567891234567891234567="},
+			{Address: common.HexToAddress("0x5555555555555555555555555555555555555555"), PtmKey: "7654321987654321987654321987654321987654321="},
+		},
 		ManagementContractAddress: common.HexToAddress("0x2222222222222222222222222222222222222222"),
 		CreationData:              []byte("Sample Transaction Data"),
 	}
@@ -77,4 +83,70 @@ func TestLoadOldContents(t *testing.T) {
 		actual, _ := json.Marshal(loadedData)
 		t.Errorf("expected data from file different to data written, expected %v, got %v", string(expected), string(actual))
 	}
+
+	// the entries saved before multiple recipients have a single recipient
+	recipients := loadedData[types.DefaultPrivateStateIdentifier][common.HexToAddress("0x2222222222222222222222222222222222222222")].AllRecipients()
+	assert.Equal(t, []ExtensionRecipient{{Address: common.HexToAddress("0x4444444444444444444444444444444444444444"), PtmKey: "1234567891234567891234567891234567891234567="}}, recipients)
+}
+
+func TestDBDataHandler_SaveAndLoad(t *testing.T) {
+	db := rawdb.NewMemoryDatabase()
+	dataHandler := NewDBDataHandler(db, "")
+
+	loadedData, err := dataHandler.Load()
+	assert.Nil(t, err)
+	assert.True(t, dataHandler.Missing(), "nothing saved nor migrated, the entries must be rebuilt")
+	assert.Equal(t, map[types.PrivateStateIdentifier]map[common.Address]*ExtensionContract{types.DefaultPrivateStateIdentifier: {}}, loadedData)
+
+	first, second := common.HexToAddress("0x2222222222222222222222222222222222222222"), common.HexToAddress("0x3333333333333333333333333333333333333333")
+	psiExtensions := map[types.PrivateStateIdentifier]map[common.Address]*ExtensionContract{
+		types.DefaultPrivateStateIdentifier: {
+			first:  {ManagementContractAddress: first, RecipientPtmKey: "key1", BlockNumber: 5, BlockHash: common.Hash{5}},
+			second: {ManagementContractAddress: second, RecipientPtmKey: "key2", BlockNumber: 6, BlockHash: common.Hash{6}},
+		},
+		"psi1": {first: {ManagementContractAddress: first, RecipientPtmKey: "key3"}},
+	}
+	assert.Nil(t, dataHandler.Save(psiExtensions))
+	assert.True(t, dataHandler.Missing(), "the entries are missing until rebuilt")
+	assert.False(t, rawdb.ReadExtensionContractsStored(db))
+	assert.Nil(t, dataHandler.Rebuilt(psiExtensions))
+	assert.False(t, dataHandler.Missing())
+
+	// a finished extension is removed
+	delete(psiExtensions[types.DefaultPrivateStateIdentifier], first)
+	assert.Nil(t, dataHandler.Save(psiExtensions))
+
+	reopened := NewDBDataHandler(db, "")
+	loadedData, err = reopened.Load()
+	assert.Nil(t, err)
+	assert.False(t, reopened.Missing())
+	assert.Equal(t, psiExtensions, loadedData)
+}
+
+func TestDBDataHandler_MigratesJsonFile(t *testing.T) {
+	datadir, err := ioutil.TempDir("", t.Name())
+	defer os.RemoveAll(datadir)
+	assert.Nil(t, err, "could not create temp directory for test")
+
+	address := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	psiExtensions := map[types.PrivateStateIdentifier]map[common.Address]*ExtensionContract{
+		"psi1": {address: {ManagementContractAddress: address, Recipient: common.HexToAddress("0x4444444444444444444444444444444444444444"), RecipientPtmKey: "key1"}},
+	}
+	assert.Nil(t, NewJsonFileDataHandler(datadir).Save(psiExtensions))
+
+	db := rawdb.NewMemoryDatabase()
+	dataHandler := NewDBDataHandler(db, datadir)
+	loadedData, err := dataHandler.Load()
+	assert.Nil(t, err)
+	assert.False(t, dataHandler.Missing())
+	assert.Equal(t, psiExtensions, loadedData)
+
+	_, err = os.Stat(filepath.Join(datadir, extensionContractData))
+	assert.True(t, os.IsNotExist(err), "the migrated file must be moved away")
+	_, err = os.Stat(filepath.Join(datadir, extensionContractData+".migrated"))
+	assert.Nil(t, err)
+
+	loadedData, err = NewDBDataHandler(db, datadir).Load()
+	assert.Nil(t, err)
+	assert.Equal(t, psiExtensions["psi1"], loadedData["psi1"])
 }
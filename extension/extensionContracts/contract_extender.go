@@ -27,21 +27,21 @@ var (
 )
 
 // ContractExtenderABI is the input ABI used to generate the binding from.
-const ContractExtenderABI = "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"contractAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipientAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"recipientPTMKey\",\"type\":\"string\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"outcome\",\"type\":\"bool\"}],\"name\":\"AllNodesHaveAccepted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[],\"name\":\"CanPerformStateShare\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[],\"name\":\"ExtensionFinished\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"toExtend\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"recipientPTMKey\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"recipientAddress\",\"type\":\"address\"}],\"name\":\"NewContractExtensionContractCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"vote\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"voter\",\"type\":\"address\"}],\"name\":\"NewVote\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"toExtend\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"tesserahash\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"uuid\",\"type\":\"string\"}],\"name\":\"StateShared\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"toExtend\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"uuid\",\"type\":\"string\"}],\"name\":\"UpdateMembers\",\"type\":\"event\"},{\"constant\":true,\"inputs\":[],\"name\":\"checkIfExtensionFinished\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"checkIfVoted\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"contractToExtend\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"creator\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"bool\",\"name\":\"vote\",\"type\":\"bool\"},{\"internalType\":\"string\",\"name\":\"nextuuid\",\"type\":\"string\"}],\"name\":\"doVote\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"finish\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"haveAllNodesVoted\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"isFinished\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"string\",\"name\":\"hash\",\"type\":\"string\"}],\"name\":\"setSharedStateHash\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"string\",\"name\":\"nextuuid\",\"type\":\"string\"}],\"name\":\"setUuid\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"sharedDataHash\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"targetRecipientPTMKey\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"totalNumberOfVoters\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"updatePartyMembers\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"voteOutcome\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"votes\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"walletAddressesToVote\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"}]"
+const ContractExtenderABI = "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"contractAddress\",\"type\":\"address\"},{\"internalType\":\"address[]\",\"name\":\"recipientAddresses\",\"type\":\"address[]\"},{\"internalType\":\"string[]\",\"name\":\"recipientPTMKeys\",\"type\":\"string[]\"},{\"internalType\":\"address[]\",\"name\":\"dependencyAddresses\",\"type\":\"address[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"outcome\",\"type\":\"bool\"}],\"name\":\"AllNodesHaveAccepted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[],\"name\":\"CanPerformStateShare\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[],\"name\":\"ExtensionFinished\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"toExtend\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address[]\",\"name\":\"recipientAddresses\",\"type\":\"address[]\"},{\"indexed\":false,\"internalType\":\"string[]\",\"name\":\"recipientPTMKeys\",\"type\":\"string[]\"}],\"name\":\"NewContractExtensionContractCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"vote\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"voter\",\"type\":\"address\"}],\"name\":\"NewVote\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"toExtend\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"tesserahash\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"uuid\",\"type\":\"string\"}],\"name\":\"StateShared\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"toExtend\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"uuid\",\"type\":\"string\"}],\"name\":\"UpdateMembers\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"checkIfExtensionFinished\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"checkIfVoted\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"contractToExtend\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"creator\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bool\",\"name\":\"vote\",\"type\":\"bool\"},{\"internalType\":\"string\",\"name\":\"nextuuid\",\"type\":\"string\"}],\"name\":\"doVote\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"finish\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getDependencies\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"haveAllNodesVoted\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"isFinished\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"numberOfAcceptances\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"hash\",\"type\":\"string\"}],\"name\":\"setSharedStateHash\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"nextuuid\",\"type\":\"string\"}],\"name\":\"setUuid\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"sharedDataHash\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"targetRecipientPTMKey\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalNumberOfVoters\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"updatePartyMembers\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"voteOutcome\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"votes\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"walletAddressesToVote\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]"
 
 var ContractExtenderParsedABI, _ = abi.JSON(strings.NewReader(ContractExtenderABI))
 
 // ContractExtenderBin is the compiled bytecode used for deploying new contracts.
-var ContractExtenderBin = "0x60806040523480156200001157600080fd5b5060405162001cbb38038062001cbb833981810160405260608110156200003757600080fd5b810190808051906020019092919080519060200190929190805160405193929190846401000000008211156200006c57600080fd5b838201915060208201858111156200008357600080fd5b8251866001820283011164010000000082111715620000a157600080fd5b8083526020830192505050908051906020019080838360005b83811015620000d7578082015181840152602081019050620000ba565b50505050905090810190601f168015620001055780820380516001836020036101000a031916815260200191505b50604052505050336000806101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff1602179055508060019080519060200190620001649291906200048c565b5082600260006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff16021790555060033390806001815401808255809150509060018203906000526020600020016000909192909190916101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff1602179055505060038290806001815401808255809150509060018203906000526020600020016000909192909190916101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff1602179055505060405180602001604052806000815250600a9080519060200190620002999291906200048c565b506001600960006101000a81548160ff021916908315150217905550600060068190555060008090505b6003805490508110156200036f5760016005600060038481548110620002e557fe5b9060005260206000200160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060006101000a81548160ff0219169083151502179055508080600101915050620002c3565b506003805490506004819055507f04576ede6057794ada68966eebc285c98a2726cbc4929ffd1ad9900336728d93838284604051808473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001806020018373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001828103825284818151815260200191508051906020019080838360005b838110156200044657808201518184015260208101905062000429565b50505050905090810190601f168015620004745780820380516001836020036101000a031916815260200191505b5094505050505060405180910390a15050506200053b565b828054600181600116156101000203166002900490600052602060002090601f016020900481019282601f10620004cf57805160ff191683800117855562000500565b8280016001018555821562000500579182015b82811115620004ff578251825591602001919060010190620004e2565b5b5090506200050f919062000513565b5090565b6200053891905b80821115620005345760008160009055506001016200051a565b5090565b90565b611770806200054b6000396000f3fe608060405234801561001057600080fd5b506004361061010b5760003560e01c8063893971ba116100a2578063d56b288911610071578063d56b2889146104bb578063d8bff5a5146104c5578063de5828cb14610521578063e5af0f30146105e8578063f57077d81461066b5761010b565b8063893971ba146103b2578063ac8b92051461046d578063b5da45bb14610477578063cb2805ec146104995761010b565b806379d41b8f116100de57806379d41b8f146101e45780637b35296214610252578063821e93da1461027457806388f520a01461032f5761010b565b806302d05d3f1461011057806315e56a6a1461015a5780631962cb9b146101a457806338527727146101c6575b600080fd5b61011861068d565b604051808273ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200191505060405180910390f35b6101626106b2565b604051808273ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200191505060405180910390f35b6101ac6106d8565b604051808215151515815260200191505060405180910390f35b6101ce6106ef565b6040518082815260200191505060405180910390f35b610210600480360360208110156101fa57600080fd5b81019080803590602001909291905050506106f5565b604051808273ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200191505060405180910390f35b61025a610731565b604051808215151515815260200191505060405180910390f35b61032d6004803603602081101561028a57600080fd5b81019080803590602001906401000000008111156102a757600080fd5b8201836020820111156102b957600080fd5b803590602001918460018302840111640100000000831117156102db57600080fd5b91908080601f016020809104026020016040519081016040528093929190818152602001838380828437600081840152601f19601f820116905080830192505050505050509192919290505050610744565b005b6103376107ec565b6040518080602001828103825283818151815260200191508051906020019080838360005b8381101561037757808201518184015260208101905061035c565b50505050905090810190601f1680156103a45780820380516001836020036101000a031916815260200191505b509250505060405180910390f35b61046b600480360360208110156103c857600080fd5b81019080803590602001906401000000008111156103e557600080fd5b8201836020820111156103f757600080fd5b8035906020019184600183028401116401000000008311171561041957600080fd5b91908080601f016020809104026020016040519081016040528093929190818152602001838380828437600081840152601f19601f82011690508083019250505050505050919291929050505061088a565b005b610475610d1d565b005b61047f610e65565b604051808215151515815260200191505060405180910390f35b6104a1610e78565b604051808215151515815260200191505060405180910390f35b6104c3610ecc565b005b610507600480360360208110156104db57600080fd5b81019080803573ffffffffffffffffffffffffffffffffffffffff169060200190929190505050610fe1565b604051808215151515815260200191505060405180910390f35b6105e66004803603604081101561053757600080fd5b810190808035151590602001909291908035906020019064010000000081111561056057600080fd5b82018360208201111561057257600080fd5b8035906020019184600183028401116401000000008311171561059457600080fd5b91908080601f016020809104026020016040519081016040528093929190818152602001838380828437600081840152601f19601f820116905080830192505050505050509192919290505050611001565b005b6105f06110fb565b6040518080602001828103825283818151815260200191508051906020019080838360005b83811015610630578082015181840152602081019050610615565b50505050905090810190601f16801561065d5780820380516001836020036101000a031916815260200191505b509250505060405180910390f35b610673611199565b604051808215151515815260200191505060405180910390f35b6000809054906101000a900473ffffffffffffffffffffffffffffffffffffffff1681565b600260009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1681565b6000600c60009054906101000a900460ff16905090565b60045481565b6003818154811061070257fe5b906000526020600020016000915054906101000a900473ffffffffffffffffffffffffffffffffffffffff1681565b600c60009054906101000a900460ff1681565b600c60009054906101000a900460ff16156107aa576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004018080602001828103825260258152602001806117176025913960400191505060405180910390fd5b600b8190806001815401808255809150509060018203906000526020600020016000909192909190915090805190602001906107e7929190611626565b505050565b600a8054600181600116156101000203166002900480601f0160208091040260200160405190810160405280929190818152602001828054600181600116156101000203166002900480156108825780601f1061085757610100808354040283529160200191610882565b820191906000526020600020905b81548152906001019060200180831161086557829003601f168201915b505050505081565b6000809054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff163373ffffffffffffffffffffffffffffffffffffffff161461092f576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004018080602001828103825260238152602001806116f46023913960400191505060405180910390fd5b600c60009054906101000a900460ff1615610995576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004018080602001828103825260258152602001806117176025913960400191505060405180910390fd5b6060600a8054600181600116156101000203166002900480601f016020809104026020016040519081016040528092919081815260200182805460018160011615610100020316600290048015610a2d5780601f10610a0257610100808354040283529160200191610a2d565b820191906000526020600020905b815481529060010190602001808311610a1057829003601f168201915b505050505090506060829050600081511415610ab1576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004018080602001828103825260188152602001807f6e657720686173682063616e6e6f7420626520656d707479000000000000000081525060200191505060405180910390fd5b6000825114610b28576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004018080602001828103825260168152602001807f7374617465206861736820616c7265616479207365740000000000000000000081525060200191505060405180910390fd5b82600a9080519060200190610b3e929190611626565b5060008090505b600b80549050811015610d0f577f67a92539f3cbd7c5a9b36c23c0e2beceb27d2e1b3cd8eda02c623689267ae71e600260009054906101000a900473ffffffffffffffffffffffffffffffffffffffff16600a600b8481548110610ba557fe5b90600052602060002001604051808473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020018060200180602001838103835285818154600181600116156101000203166002900481526020019150805460018160011615610100020316600290048015610c6e5780601f10610c4357610100808354040283529160200191610c6e565b820191906000526020600020905b815481529060010190602001808311610c5157829003601f168201915b5050838103825284818154600181600116156101000203166002900481526020019150805460018160011615610100020316600290048015610cf15780601f10610cc657610100808354040283529160200191610cf1565b820191906000526020600020905b815481529060010190602001808311610cd457829003601f168201915b50509550505050505060405180910390a18080600101915050610b45565b50610d18610ecc565b505050565b60008090505b600b80549050811015610e62577f8adc4573f947f9930560525736f61b116be55049125cb63a36887a40f92f3b44600260009054906101000a900473ffffffffffffffffffffffffffffffffffffffff16600b8381548110610d8157fe5b90600052602060002001604051808373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200180602001828103825283818154600181600116156101000203166002900481526020019150805460018160011615610100020316600290048015610e465780601f10610e1b57610100808354040283529160200191610e46565b820191906000526020600020905b815481529060010190602001808311610e2957829003601f168201915b5050935050505060405180910390a18080600101915050610d23565b50565b600960009054906101000a900460ff1681565b6000600760003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060009054906101000a900460ff16905090565b600c60009054906101000a900460ff1615610f32576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004018080602001828103825260258152602001806117176025913960400191505060405180910390fd5b6000809054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff163373ffffffffffffffffffffffffffffffffffffffff1614610fd7576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004018080602001828103825260238152602001806116f46023913960400191505060405180910390fd5b610fdf6111aa565b565b60086020528060005260406000206000915054906101000a900460ff1681565b600c60009054906101000a900460ff1615611067576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004018080602001828103825260258152602001806117176025913960400191505060405180910390fd5b611070826111f3565b81156110805761107f81610744565b5b611088611550565b7f225708d30006b0cc86d855ab91047edb5fe9c2e416412f36c18c6e90fe4e461f823360405180831515151581526020018273ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019250505060405180910390a15050565b60018054600181600116156101000203166002900480601f0160208091040260200160405190810160405280929190818152602001828054600181600116156101000203166002900480156111915780601f1061116657610100808354040283529160200191611191565b820191906000526020600020905b81548152906001019060200180831161117457829003601f168201915b505050505081565b600060065460038054905014905090565b6001600c60006101000a81548160ff0219169083151502179055507f79c47b570b18a8a814b785800e5fcbf104e067663589cef1bba07756e3c6ede960405160405180910390a1565b600c60009054906101000a900460ff1615611259576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004018080602001828103825260288152602001806116cc6028913960400191505060405180910390fd5b600560003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060009054906101000a900460ff16611318576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004018080602001828103825260138152602001807f6e6f7420616c6c6f77656420746f20766f74650000000000000000000000000081525060200191505060405180910390fd5b600760003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060009054906101000a900460ff16156113d8576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040180806020018281038252600d8152602001807f616c726561647920766f7465640000000000000000000000000000000000000081525060200191505060405180910390fd5b600960009054906101000a900460ff1661145a576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004018080602001828103825260178152602001807f766f74696e6720616c7265616479206465636c696e656400000000000000000081525060200191505060405180910390fd5b6001600760003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060006101000a81548160ff02191690831515021790555080600860003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060006101000a81548160ff021916908315150217905550600660008154809291906001019190505550600960009054906101000a900460ff1680156115345750805b600960006101000a81548160ff02191690831515021790555050565b600960009054906101000a900460ff166115ad577ff20540914db019dd7c8d05ed165316a58d1583642772ac46f3d0c29b8644bd366000604051808215151515815260200191505060405180910390a16115a86111aa565b611624565b6115b5611199565b15611623577ff20540914db019dd7c8d05ed165316a58d1583642772ac46f3d0c29b8644bd366001604051808215151515815260200191505060405180910390a17ffd46cafaa71d87561071b8095703a7f081265fad232945049f5cf2d2c39b3d2860405160405180910390a15b5b565b828054600181600116156101000203166002900490600052602060002090601f016020900481019282601f1061166757805160ff1916838001178555611695565b82800160010185558215611695579182015b82811115611694578251825591602001919060010190611679565b5b5090506116a291906116a6565b5090565b6116c891905b808211156116c45760008160009055506001016116ac565b5090565b9056fe657874656e73696f6e2070726f6365737320636f6d706c657465642e2063616e6e6f7420766f74656f6e6c79206c6561646572206d617920706572666f726d207468697320616374696f6e657874656e73696f6e20686173206265656e206d61726b65642061732066696e6973686564a265627a7a72315820625108b92f7ff30d44757ae1bb19335828b2892b67a277794ea401fa969f7bdf64736f6c63430005110032"
+var ContractExtenderBin = "0x60806040523480156200001157600080fd5b5060405162002fb138038062002fb183398181016040528101906200003791906200091f565b60008351036200007e576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401620000759062000a4f565b60405180910390fd5b8151835114620000c5576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401620000bc9062000ae7565b60405180910390fd5b336000806101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff160217905550816000815181106200011c576200011b62000b09565b5b60200260200101516001908162000134919062000d83565b5083600260006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff16021790555080600390805190602001906200018e929190620004a7565b506004339080600181540180825580915050600190039060005260206000200160009091909190916101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff1602179055506001600660003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060006101000a81548160ff02191690831515021790555060005b83518110156200040f576006600085838151811062000271576200027062000b09565b5b602002602001015173ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060009054906101000a900460ff161562000304576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401620002fb9062000eba565b60405180910390fd5b60048482815181106200031c576200031b62000b09565b5b60200260200101519080600181540180825580915050600190039060005260206000200160009091909190916101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff160217905550600160066000868481518110620003a0576200039f62000b09565b5b602002602001015173ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060006101000a81548160ff0219169083151502179055508080620004069062000f0b565b9150506200024d565b5060405180602001604052806000815250600c908162000430919062000d83565b506001600b60006101000a81548160ff02191690831515021790555060006007819055506004805490506005819055507fd0e3d721030625e7dcc019968ee83d5398daa5f6d855811669581a2ec08f3965848484604051620004959392919062001159565b60405180910390a150505050620011a4565b82805482825590600052602060002090810192821562000523579160200282015b82811115620005225782518260006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff16021790555091602001919060010190620004c8565b5b50905062000532919062000536565b5090565b5b808211156200055157600081600090555060010162000537565b5090565b6000604051905090565b600080fd5b600080fd5b600073ffffffffffffffffffffffffffffffffffffffff82169050919050565b6000620005968262000569565b9050919050565b620005a88162000589565b8114620005b457600080fd5b50565b600081519050620005c8816200059d565b92915050565b600080fd5b6000601f19601f8301169050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b6200061e82620005d3565b810181811067ffffffffffffffff8211171562000640576200063f620005e4565b5b80604052505050565b60006200065562000555565b905062000663828262000613565b919050565b600067ffffffffffffffff821115620006865762000685620005e4565b5b602082029050602081019050919050565b600080fd5b6000620006b3620006ad8462000668565b62000649565b90508083825260208201905060208402830185811115620006d957620006d862000697565b5b835b81811015620007065780620006f18882620005b7565b845260208401935050602081019050620006db565b5050509392505050565b600082601f830112620007285762000727620005ce565b5b81516200073a8482602086016200069c565b91505092915050565b600067ffffffffffffffff821115620007615762000760620005e4565b5b602082029050602081019050919050565b600080fd5b600067ffffffffffffffff821115620007955762000794620005e4565b5b620007a082620005d3565b9050602081019050919050565b60005b83811015620007cd578082015181840152602081019050620007b0565b60008484015250505050565b6000620007f0620007ea8462000777565b62000649565b9050828152602081018484840111156200080f576200080e62000772565b5b6200081c848285620007ad565b509392505050565b600082601f8301126200083c576200083b620005ce565b5b81516200084e848260208601620007d9565b91505092915050565b60006200086e620008688462000743565b62000649565b9050808382526020820190506020840283018581111562000894576200089362000697565b5b835b81811015620008e257805167ffffffffffffffff811115620008bd57620008bc620005ce565b5b808601620008cc898262000824565b8552602085019450505060208101905062000896565b5050509392505050565b600082601f830112620009045762000903620005ce565b5b81516200091684826020860162000857565b91505092915050565b600080600080608085870312156200093c576200093b6200055f565b5b60006200094c87828801620005b7565b945050602085015167ffffffffffffffff81111562000970576200096f62000564565b5b6200097e8782880162000710565b935050604085015167ffffffffffffffff811115620009a257620009a162000564565b5b620009b087828801620008ec565b925050606085015167ffffffffffffffff811115620009d457620009d362000564565b5b620009e28782880162000710565b91505092959194509250565b600082825260208201905092915050565b7f6174206c65617374206f6e6520726563697069656e7420726571756972656400600082015250565b600062000a37601f83620009ee565b915062000a4482620009ff565b602082019050919050565b6000602082019050818103600083015262000a6a8162000a28565b9050919050565b7f6e756d626572206f6620726563697069656e742061646472657373657320616e60008201527f64206b6579732064696666657200000000000000000000000000000000000000602082015250565b600062000acf602d83620009ee565b915062000adc8262000a71565b604082019050919050565b6000602082019050818103600083015262000b028162000ac0565b9050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052603260045260246000fd5b600081519050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b6000600282049050600182168062000b8b57607f821691505b60208210810362000ba15762000ba062000b43565b5b50919050565b60008190508160005260206000209050919050565b60006020601f8301049050919050565b600082821b905092915050565b60006008830262000c0b7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff8262000bcc565b62000c17868362000bcc565b95508019841693508086168417925050509392505050565b6000819050919050565b6000819050919050565b600062000c6462000c5e62000c588462000c2f565b62000c39565b62000c2f565b9050919050565b6000819050919050565b62000c808362000c43565b62000c9862000c8f8262000c6b565b84845462000bd9565b825550505050565b600090565b62000caf62000ca0565b62000cbc81848462000c75565b505050565b5b8181101562000ce45762000cd860008262000ca5565b60018101905062000cc2565b5050565b601f82111562000d335762000cfd8162000ba7565b62000d088462000bbc565b8101602085101562000d18578190505b62000d3062000d278562000bbc565b83018262000cc1565b50505b505050565b600082821c905092915050565b600062000d586000198460080262000d38565b1980831691505092915050565b600062000d73838362000d45565b9150826002028217905092915050565b62000d8e8262000b38565b67ffffffffffffffff81111562000daa5762000da9620005e4565b5b62000db6825462000b72565b62000dc382828562000ce8565b600060209050601f83116001811462000dfb576000841562000de6578287015190505b62000df2858262000d65565b86555062000e62565b601f19841662000e0b8662000ba7565b60005b8281101562000e355784890151825560018201915060208501945060208101905062000e0e565b8683101562000e55578489015162000e51601f89168262000d45565b8355505b6001600288020188555050505b505050505050565b7f6475706c696361746520766f7465720000000000000000000000000000000000600082015250565b600062000ea2600f83620009ee565b915062000eaf8262000e6a565b602082019050919050565b6000602082019050818103600083015262000ed58162000e93565b9050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b600062000f188262000c2f565b91507fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff820362000f4d5762000f4c62000edc565b5b600182019050919050565b62000f638162000589565b82525050565b600081519050919050565b600082825260208201905092915050565b6000819050602082019050919050565b62000fa08162000589565b82525050565b600062000fb4838362000f95565b60208301905092915050565b6000602082019050919050565b600062000fda8262000f69565b62000fe6818562000f74565b935062000ff38362000f85565b8060005b838110156200102a5781516200100e888262000fa6565b97506200101b8362000fc0565b92505060018101905062000ff7565b5085935050505092915050565b600081519050919050565b600082825260208201905092915050565b6000819050602082019050919050565b600082825260208201905092915050565b6000620010818262000b38565b6200108d818562001063565b93506200109f818560208601620007ad565b620010aa81620005d3565b840191505092915050565b6000620010c3838362001074565b905092915050565b6000602082019050919050565b6000620010e58262001037565b620010f1818562001042565b935083602082028501620011058562001053565b8060005b85811015620011475784840389528151620011258582620010b5565b94506200113283620010cb565b925060208a0199505060018101905062001109565b50829750879550505050505092915050565b600060608201905062001170600083018662000f58565b818103602083015262001184818562000fcd565b905081810360408301526200119a8184620010d8565b9050949350505050565b611dfd80620011b46000396000f3fe608060405234801561001057600080fd5b50600436106101215760003560e01c806388f520a0116100ad578063d56b288911610071578063d56b2889146102c4578063d8bff5a5146102ce578063de5828cb146102fe578063e5af0f301461031a578063f57077d81461033857610121565b806388f520a014610244578063893971ba14610262578063ac8b92051461027e578063b5da45bb14610288578063cb2805ec146102a657610121565b80633c81a345116100f45780633c81a3451461019e5780637031d90a146101bc57806379d41b8f146101da5780637b3529621461020a578063821e93da1461022857610121565b806302d05d3f1461012657806315e56a6a146101445780631962cb9b146101625780633852772714610180575b600080fd5b61012e610356565b60405161013b919061106c565b60405180910390f35b61014c61037a565b604051610159919061106c565b60405180910390f35b61016a6103a0565b60405161017791906110a2565b60405180910390f35b6101886103b7565b60405161019591906110d6565b60405180910390f35b6101a66103bd565b6040516101b391906111af565b60405180910390f35b6101c461044b565b6040516101d191906110d6565b60405180910390f35b6101f460048036038101906101ef9190611211565b610451565b604051610201919061106c565b60405180910390f35b610212610490565b60405161021f91906110a2565b60405180910390f35b610242600480360381019061023d9190611384565b6104a3565b005b61024c61052b565b604051610259919061144c565b60405180910390f35b61027c60048036038101906102779190611384565b6105b9565b005b61028661086f565b005b61029061090d565b60405161029d91906110a2565b60405180910390f35b6102ae610920565b6040516102bb91906110a2565b60405180910390f35b6102cc610974565b005b6102e860048036038101906102e3919061149a565b610a5c565b6040516102f591906110a2565b60405180910390f35b610318600480360381019061031391906114f3565b610a7c565b005b610322610b2a565b60405161032f919061144c565b60405180910390f35b610340610bb8565b60405161034d91906110a2565b60405180910390f35b60008054906101000a900473ffffffffffffffffffffffffffffffffffffffff1681565b600260009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1681565b6000600e60009054906101000a900460ff16905090565b60055481565b6060600380548060200260200160405190810160405280929190818152602001828054801561044157602002820191906000526020600020905b8160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190600101908083116103f7575b5050505050905090565b600a5481565b6004818154811061046157600080fd5b906000526020600020016000915054906101000a900473ffffffffffffffffffffffffffffffffffffffff1681565b600e60009054906101000a900460ff1681565b600e60009054906101000a900460ff16156104f3576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016104ea906115c1565b60405180910390fd5b600d8190806001815401808255809150506001900390600052602060002001600090919091909150908161052791906117ed565b5050565b600c805461053890611610565b80601f016020809104026020016040519081016040528092919081815260200182805461056490611610565b80156105b15780601f10610586576101008083540402835291602001916105b1565b820191906000526020600020905b81548152906001019060200180831161059457829003601f168201915b505050505081565b60008054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff163373ffffffffffffffffffffffffffffffffffffffff1614610647576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161063e90611931565b60405180910390fd5b600e60009054906101000a900460ff1615610697576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161068e906115c1565b60405180910390fd5b6000600c80546106a690611610565b80601f01602080910402602001604051908101604052809291908181526020018280546106d290611610565b801561071f5780601f106106f45761010080835404028352916020019161071f565b820191906000526020600020905b81548152906001019060200180831161070257829003601f168201915b505050505090506000829050600081510361076f576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016107669061199d565b60405180910390fd5b60008251146107b3576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016107aa90611a09565b60405180910390fd5b82600c90816107c291906117ed565b5060005b600d80549050811015610861577f67a92539f3cbd7c5a9b36c23c0e2beceb27d2e1b3cd8eda02c623689267ae71e600260009054906101000a900473ffffffffffffffffffffffffffffffffffffffff16600c600d848154811061082d5761082c611a29565b5b9060005260206000200160405161084693929190611adc565b60405180910390a1808061085990611b50565b9150506107c6565b5061086a610974565b505050565b60005b600d8054905081101561090a577f8adc4573f947f9930560525736f61b116be55049125cb63a36887a40f92f3b44600260009054906101000a900473ffffffffffffffffffffffffffffffffffffffff16600d83815481106108d7576108d6611a29565b5b906000526020600020016040516108ef929190611b98565b60405180910390a1808061090290611b50565b915050610872565b50565b600b60009054906101000a900460ff1681565b6000600860003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060009054906101000a900460ff16905090565b600e60009054906101000a900460ff16156109c4576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016109bb906115c1565b60405180910390fd5b60008054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff163373ffffffffffffffffffffffffffffffffffffffff1614610a52576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610a4990611931565b60405180910390fd5b610a5a610bc9565b565b60096020528060005260406000206000915054906101000a900460ff1681565b600e60009054906101000a900460ff1615610acc576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610ac3906115c1565b60405180910390fd5b610ad582610c12565b8115610ae557610ae4816104a3565b5b610aed610f26565b7f225708d30006b0cc86d855ab91047edb5fe9c2e416412f36c18c6e90fe4e461f8233604051610b1e929190611bc8565b60405180910390a15050565b60018054610b3790611610565b80601f0160208091040260200160405190810160405280929190818152602001828054610b6390611610565b8015610bb05780601f10610b8557610100808354040283529160200191610bb0565b820191906000526020600020905b815481529060010190602001808311610b9357829003601f168201915b505050505081565b600060075460048054905014905090565b6001600e60006101000a81548160ff0219169083151502179055507f79c47b570b18a8a814b785800e5fcbf104e067663589cef1bba07756e3c6ede960405160405180910390a1565b600e60009054906101000a900460ff1615610c62576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610c5990611c63565b60405180910390fd5b600660003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060009054906101000a900460ff16610cee576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610ce590611ccf565b60405180910390fd5b600860003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060009054906101000a900460ff1615610d7b576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610d7290611d3b565b60405180910390fd5b600b60009054906101000a900460ff16610dca576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610dc190611da7565b60405180910390fd5b6001600860003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060006101000a81548160ff02191690831515021790555080600960003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060006101000a81548160ff02191690831515021790555060076000815480929190610e8c90611b50565b919050555060008054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff163373ffffffffffffffffffffffffffffffffffffffff1603610f035780600b60006101000a81548160ff021916908315150217905550610f23565b8015610f2257600a6000815480929190610f1c90611b50565b91905055505b5b50565b610f2e610bb8565b8015610f3c57506000600a54145b15610f5d576000600b60006101000a81548160ff0219169083151502179055505b600b60009054906101000a900460ff16610fb6577ff20540914db019dd7c8d05ed165316a58d1583642772ac46f3d0c29b8644bd366000604051610fa191906110a2565b60405180910390a1610fb1610bc9565b611029565b610fbe610bb8565b15611028577ff20540914db019dd7c8d05ed165316a58d1583642772ac46f3d0c29b8644bd366001604051610ff391906110a2565b60405180910390a17ffd46cafaa71d87561071b8095703a7f081265fad232945049f5cf2d2c39b3d2860405160405180910390a15b5b565b600073ffffffffffffffffffffffffffffffffffffffff82169050919050565b60006110568261102b565b9050919050565b6110668161104b565b82525050565b6000602082019050611081600083018461105d565b92915050565b60008115159050919050565b61109c81611087565b82525050565b60006020820190506110b76000830184611093565b92915050565b6000819050919050565b6110d0816110bd565b82525050565b60006020820190506110eb60008301846110c7565b92915050565b600081519050919050565b600082825260208201905092915050565b6000819050602082019050919050565b6111268161104b565b82525050565b6000611138838361111d565b60208301905092915050565b6000602082019050919050565b600061115c826110f1565b61116681856110fc565b93506111718361110d565b8060005b838110156111a2578151611189888261112c565b975061119483611144565b925050600181019050611175565b5085935050505092915050565b600060208201905081810360008301526111c98184611151565b905092915050565b6000604051905090565b600080fd5b600080fd5b6111ee816110bd565b81146111f957600080fd5b50565b60008135905061120b816111e5565b92915050565b600060208284031215611227576112266111db565b5b6000611235848285016111fc565b91505092915050565b600080fd5b600080fd5b6000601f19601f8301169050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b61129182611248565b810181811067ffffffffffffffff821117156112b0576112af611259565b5b80604052505050565b60006112c36111d1565b90506112cf8282611288565b919050565b600067ffffffffffffffff8211156112ef576112ee611259565b5b6112f882611248565b9050602081019050919050565b82818337600083830152505050565b6000611327611322846112d4565b6112b9565b90508281526020810184848401111561134357611342611243565b5b61134e848285611305565b509392505050565b600082601f83011261136b5761136a61123e565b5b813561137b848260208601611314565b91505092915050565b60006020828403121561139a576113996111db565b5b600082013567ffffffffffffffff8111156113b8576113b76111e0565b5b6113c484828501611356565b91505092915050565b600081519050919050565b600082825260208201905092915050565b60005b838110156114075780820151818401526020810190506113ec565b60008484015250505050565b600061141e826113cd565b61142881856113d8565b93506114388185602086016113e9565b61144181611248565b840191505092915050565b600060208201905081810360008301526114668184611413565b905092915050565b6114778161104b565b811461148257600080fd5b50565b6000813590506114948161146e565b92915050565b6000602082840312156114b0576114af6111db565b5b60006114be84828501611485565b91505092915050565b6114d081611087565b81146114db57600080fd5b50565b6000813590506114ed816114c7565b92915050565b6000806040838503121561150a576115096111db565b5b6000611518858286016114de565b925050602083013567ffffffffffffffff811115611539576115386111e0565b5b61154585828601611356565b9150509250929050565b7f657874656e73696f6e20686173206265656e206d61726b65642061732066696e60008201527f6973686564000000000000000000000000000000000000000000000000000000602082015250565b60006115ab6025836113d8565b91506115b68261154f565b604082019050919050565b600060208201905081810360008301526115da8161159e565b9050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b6000600282049050600182168061162857607f821691505b60208210810361163b5761163a6115e1565b5b50919050565b60008190508160005260206000209050919050565b60006020601f8301049050919050565b600082821b905092915050565b6000600883026116a37fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff82611666565b6116ad8683611666565b95508019841693508086168417925050509392505050565b6000819050919050565b60006116ea6116e56116e0846110bd565b6116c5565b6110bd565b9050919050565b6000819050919050565b611704836116cf565b611718611710826116f1565b848454611673565b825550505050565b600090565b61172d611720565b6117388184846116fb565b505050565b5b8181101561175c57611751600082611725565b60018101905061173e565b5050565b601f8211156117a15761177281611641565b61177b84611656565b8101602085101561178a578190505b61179e61179685611656565b83018261173d565b50505b505050565b600082821c905092915050565b60006117c4600019846008026117a6565b1980831691505092915050565b60006117dd83836117b3565b9150826002028217905092915050565b6117f6826113cd565b67ffffffffffffffff81111561180f5761180e611259565b5b6118198254611610565b611824828285611760565b600060209050601f8311600181146118575760008415611845578287015190505b61184f85826117d1565b8655506118b7565b601f19841661186586611641565b60005b8281101561188d57848901518255600182019150602085019450602081019050611868565b868310156118aa57848901516118a6601f8916826117b3565b8355505b6001600288020188555050505b505050505050565b7f6f6e6c79206c6561646572206d617920706572666f726d20746869732061637460008201527f696f6e0000000000000000000000000000000000000000000000000000000000602082015250565b600061191b6023836113d8565b9150611926826118bf565b604082019050919050565b6000602082019050818103600083015261194a8161190e565b9050919050565b7f6e657720686173682063616e6e6f7420626520656d7074790000000000000000600082015250565b60006119876018836113d8565b915061199282611951565b602082019050919050565b600060208201905081810360008301526119b68161197a565b9050919050565b7f7374617465206861736820616c72656164792073657400000000000000000000600082015250565b60006119f36016836113d8565b91506119fe826119bd565b602082019050919050565b60006020820190508181036000830152611a22816119e6565b9050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052603260045260246000fd5b60008154611a6581611610565b611a6f81866113d8565b94506001821660008114611a8a5760018114611aa057611ad3565b60ff198316865281151560200286019350611ad3565b611aa985611641565b60005b83811015611acb57815481890152600182019150602081019050611aac565b808801955050505b50505092915050565b6000606082019050611af1600083018661105d565b8181036020830152611b038185611a58565b90508181036040830152611b178184611a58565b9050949350505050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b6000611b5b826110bd565b91507fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff8203611b8d57611b8c611b21565b5b600182019050919050565b6000604082019050611bad600083018561105d565b8181036020830152611bbf8184611a58565b90509392505050565b6000604082019050611bdd6000830185611093565b611bea602083018461105d565b9392505050565b7f657874656e73696f6e2070726f6365737320636f6d706c657465642e2063616e60008201527f6e6f7420766f7465000000000000000000000000000000000000000000000000602082015250565b6000611c4d6028836113d8565b9150611c5882611bf1565b604082019050919050565b60006020820190508181036000830152611c7c81611c40565b9050919050565b7f6e6f7420616c6c6f77656420746f20766f746500000000000000000000000000600082015250565b6000611cb96013836113d8565b9150611cc482611c83565b602082019050919050565b60006020820190508181036000830152611ce881611cac565b9050919050565b7f616c726561647920766f74656400000000000000000000000000000000000000600082015250565b6000611d25600d836113d8565b9150611d3082611cef565b602082019050919050565b60006020820190508181036000830152611d5481611d18565b9050919050565b7f766f74696e6720616c7265616479206465636c696e6564000000000000000000600082015250565b6000611d916017836113d8565b9150611d9c82611d5b565b602082019050919050565b60006020820190508181036000830152611dc081611d84565b905091905056fea2646970667358221220ddefa2c86da17411b68505451ecb016ca8c08b9df59a10fbbe5bd2283a1a96d064736f6c63430008150033"
 
 // DeployContractExtender deploys a new Ethereum contract, binding an instance of ContractExtender to it.
-func DeployContractExtender(auth *bind.TransactOpts, backend bind.ContractBackend, contractAddress common.Address, recipientAddress common.Address, recipientPTMKey string) (common.Address, *types.Transaction, *ContractExtender, error) {
+func DeployContractExtender(auth *bind.TransactOpts, backend bind.ContractBackend, contractAddress common.Address, recipientAddresses []common.Address, recipientPTMKeys []string, dependencyAddresses []common.Address) (common.Address, *types.Transaction, *ContractExtender, error) {
 	parsed, err := abi.JSON(strings.NewReader(ContractExtenderABI))
 	if err != nil {
 		return common.Address{}, nil, nil, err
 	}
 
-	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(ContractExtenderBin), backend, contractAddress, recipientAddress, recipientPTMKey)
+	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(ContractExtenderBin), backend, contractAddress, recipientAddresses, recipientPTMKeys, dependencyAddresses)
 	if err != nil {
 		return common.Address{}, nil, nil, err
 	}
@@ -314,6 +314,37 @@ func (_ContractExtender *ContractExtenderCallerSession) Creator() (common.Addres
 	return _ContractExtender.Contract.Creator(&_ContractExtender.CallOpts)
 }
 
+// GetDependencies is a free data retrieval call binding the contract method 0x3c81a345.
+//
+// Solidity: function getDependencies() view returns(address[])
+func (_ContractExtender *ContractExtenderCaller) GetDependencies(opts *bind.CallOpts) ([]common.Address, error) {
+	var out []interface{}
+	err := _ContractExtender.contract.Call(opts, &out, "getDependencies")
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetDependencies is a free data retrieval call binding the contract method 0x3c81a345.
+//
+// Solidity: function getDependencies() view returns(address[])
+func (_ContractExtender *ContractExtenderSession) GetDependencies() ([]common.Address, error) {
+	return _ContractExtender.Contract.GetDependencies(&_ContractExtender.CallOpts)
+}
+
+// GetDependencies is a free data retrieval call binding the contract method 0x3c81a345.
+//
+// Solidity: function getDependencies() view returns(address[])
+func (_ContractExtender *ContractExtenderCallerSession) GetDependencies() ([]common.Address, error) {
+	return _ContractExtender.Contract.GetDependencies(&_ContractExtender.CallOpts)
+}
+
 // HaveAllNodesVoted is a free data retrieval call binding the contract method 0xf57077d8.
 //
 // Solidity: function haveAllNodesVoted() view returns(bool)
@@ -376,6 +407,37 @@ func (_ContractExtender *ContractExtenderCallerSession) IsFinished() (bool, erro
 	return _ContractExtender.Contract.IsFinished(&_ContractExtender.CallOpts)
 }
 
+// NumberOfAcceptances is a free data retrieval call binding the contract method 0x7031d90a.
+//
+// Solidity: function numberOfAcceptances() view returns(uint256)
+func (_ContractExtender *ContractExtenderCaller) NumberOfAcceptances(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ContractExtender.contract.Call(opts, &out, "numberOfAcceptances")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// NumberOfAcceptances is a free data retrieval call binding the contract method 0x7031d90a.
+//
+// Solidity: function numberOfAcceptances() view returns(uint256)
+func (_ContractExtender *ContractExtenderSession) NumberOfAcceptances() (*big.Int, error) {
+	return _ContractExtender.Contract.NumberOfAcceptances(&_ContractExtender.CallOpts)
+}
+
+// NumberOfAcceptances is a free data retrieval call binding the contract method 0x7031d90a.
+//
+// Solidity: function numberOfAcceptances() view returns(uint256)
+func (_ContractExtender *ContractExtenderCallerSession) NumberOfAcceptances() (*big.Int, error) {
+	return _ContractExtender.Contract.NumberOfAcceptances(&_ContractExtender.CallOpts)
+}
+
 // SharedDataHash is a free data retrieval call binding the contract method 0x88f520a0.
 //
 // Solidity: function sharedDataHash() view returns(string)
@@ -799,6 +861,7 @@ func (_ContractExtender *ContractExtenderFilterer) ParseAllNodesHaveAccepted(log
 	if err := _ContractExtender.contract.UnpackLog(event, "AllNodesHaveAccepted", log); err != nil {
 		return nil, err
 	}
+	event.Raw = log
 	return event, nil
 }
 
@@ -933,6 +996,7 @@ func (_ContractExtender *ContractExtenderFilterer) ParseCanPerformStateShare(log
 	if err := _ContractExtender.contract.UnpackLog(event, "CanPerformStateShare", log); err != nil {
 		return nil, err
 	}
+	event.Raw = log
 	return event, nil
 }
 
@@ -1067,6 +1131,7 @@ func (_ContractExtender *ContractExtenderFilterer) ParseExtensionFinished(log ty
 	if err := _ContractExtender.contract.UnpackLog(event, "ExtensionFinished", log); err != nil {
 		return nil, err
 	}
+	event.Raw = log
 	return event, nil
 }
 
@@ -1139,15 +1204,15 @@ func (it *ContractExtenderNewContractExtensionContractCreatedIterator) Close() e
 
 // ContractExtenderNewContractExtensionContractCreated represents a NewContractExtensionContractCreated event raised by the ContractExtender contract.
 type ContractExtenderNewContractExtensionContractCreated struct {
-	ToExtend         common.Address
-	RecipientPTMKey  string
-	RecipientAddress common.Address
-	Raw              types.Log // Blockchain specific contextual infos
+	ToExtend           common.Address
+	RecipientAddresses []common.Address
+	RecipientPTMKeys   []string
+	Raw                types.Log // Blockchain specific contextual infos
 }
 
-// FilterNewContractExtensionContractCreated is a free log retrieval operation binding the contract event 0x04576ede6057794ada68966eebc285c98a2726cbc4929ffd1ad9900336728d93.
+// FilterNewContractExtensionContractCreated is a free log retrieval operation binding the contract event 0xd0e3d721030625e7dcc019968ee83d5398daa5f6d855811669581a2ec08f3965.
 //
-// Solidity: event NewContractExtensionContractCreated(address toExtend, string recipientPTMKey, address recipientAddress)
+// Solidity: event NewContractExtensionContractCreated(address toExtend, address[] recipientAddresses, string[] recipientPTMKeys)
 func (_ContractExtender *ContractExtenderFilterer) FilterNewContractExtensionContractCreated(opts *bind.FilterOpts) (*ContractExtenderNewContractExtensionContractCreatedIterator, error) {
 
 	logs, sub, err := _ContractExtender.contract.FilterLogs(opts, "NewContractExtensionContractCreated")
@@ -1157,11 +1222,11 @@ func (_ContractExtender *ContractExtenderFilterer) FilterNewContractExtensionCon
 	return &ContractExtenderNewContractExtensionContractCreatedIterator{contract: _ContractExtender.contract, event: "NewContractExtensionContractCreated", logs: logs, sub: sub}, nil
 }
 
-var NewContractExtensionContractCreatedTopicHash = "0x04576ede6057794ada68966eebc285c98a2726cbc4929ffd1ad9900336728d93"
+var NewContractExtensionContractCreatedTopicHash = "0xd0e3d721030625e7dcc019968ee83d5398daa5f6d855811669581a2ec08f3965"
 
-// WatchNewContractExtensionContractCreated is a free log subscription operation binding the contract event 0x04576ede6057794ada68966eebc285c98a2726cbc4929ffd1ad9900336728d93.
+// WatchNewContractExtensionContractCreated is a free log subscription operation binding the contract event 0xd0e3d721030625e7dcc019968ee83d5398daa5f6d855811669581a2ec08f3965.
 //
-// Solidity: event NewContractExtensionContractCreated(address toExtend, string recipientPTMKey, address recipientAddress)
+// Solidity: event NewContractExtensionContractCreated(address toExtend, address[] recipientAddresses, string[] recipientPTMKeys)
 func (_ContractExtender *ContractExtenderFilterer) WatchNewContractExtensionContractCreated(opts *bind.WatchOpts, sink chan<- *ContractExtenderNewContractExtensionContractCreated) (event.Subscription, error) {
 
 	logs, sub, err := _ContractExtender.contract.WatchLogs(opts, "NewContractExtensionContractCreated")
@@ -1196,14 +1261,15 @@ func (_ContractExtender *ContractExtenderFilterer) WatchNewContractExtensionCont
 	}), nil
 }
 
-// ParseNewContractExtensionContractCreated is a log parse operation binding the contract event 0x04576ede6057794ada68966eebc285c98a2726cbc4929ffd1ad9900336728d93.
+// ParseNewContractExtensionContractCreated is a log parse operation binding the contract event 0xd0e3d721030625e7dcc019968ee83d5398daa5f6d855811669581a2ec08f3965.
 //
-// Solidity: event NewContractExtensionContractCreated(address toExtend, string recipientPTMKey, address recipientAddress)
+// Solidity: event NewContractExtensionContractCreated(address toExtend, address[] recipientAddresses, string[] recipientPTMKeys)
 func (_ContractExtender *ContractExtenderFilterer) ParseNewContractExtensionContractCreated(log types.Log) (*ContractExtenderNewContractExtensionContractCreated, error) {
 	event := new(ContractExtenderNewContractExtensionContractCreated)
 	if err := _ContractExtender.contract.UnpackLog(event, "NewContractExtensionContractCreated", log); err != nil {
 		return nil, err
 	}
+	event.Raw = log
 	return event, nil
 }
 
@@ -1340,6 +1406,7 @@ func (_ContractExtender *ContractExtenderFilterer) ParseNewVote(log types.Log) (
 	if err := _ContractExtender.contract.UnpackLog(event, "NewVote", log); err != nil {
 		return nil, err
 	}
+	event.Raw = log
 	return event, nil
 }
 
@@ -1477,6 +1544,7 @@ func (_ContractExtender *ContractExtenderFilterer) ParseStateShared(log types.Lo
 	if err := _ContractExtender.contract.UnpackLog(event, "StateShared", log); err != nil {
 		return nil, err
 	}
+	event.Raw = log
 	return event, nil
 }
 
@@ -1613,5 +1681,6 @@ func (_ContractExtender *ContractExtenderFilterer) ParseUpdateMembers(log types.
 	if err := _ContractExtender.contract.UnpackLog(event, "UpdateMembers", log); err != nil {
 		return nil, err
 	}
+	event.Raw = log
 	return event, nil
 }
@@ -1,13 +1,17 @@
-pragma solidity ^0.5.3;
+pragma solidity >=0.5.3 <0.9.0;
+pragma experimental ABIEncoderV2;
 
 contract ContractExtender {
 
     //target details - what, who and when to extend
     address public creator;
+    //the PTM key of the first recipient, kept for the clients of the single recipient extension
     string public targetRecipientPTMKey;
     address public contractToExtend;
+    //the private contracts shared along with the contract, approved by the voters with the extension
+    address[] dependencies;
 
-    //list of wallet addresses that can cast votes
+    //list of wallet addresses that can cast votes, the creator followed by the recipients
     address[] public walletAddressesToVote;
     uint256 public totalNumberOfVoters;
     mapping(address => bool) walletAddressesToVoteMap;
@@ -15,8 +19,12 @@ contract ContractExtender {
     mapping(address => bool) hasVotedMapping;
     mapping(address => bool) public votes;
 
+    //number of recipients which accepted the extension
+    uint256 public numberOfAcceptances;
+
     //contains the total outcome of voting
-    //true if ALL nodes vote true, false if ANY node votes false
+    //false if the creator votes false, or if every recipient votes false.
+    //a recipient voting false only declines the extension for itself
     bool public voteOutcome;
 
     //the hash of the shared payload
@@ -27,33 +35,39 @@ contract ContractExtender {
     bool public isFinished;
 
     // General housekeeping
-    event NewContractExtensionContractCreated(address toExtend, string recipientPTMKey, address recipientAddress); //to tell nodes a new extension is happening
+    event NewContractExtensionContractCreated(address toExtend, address[] recipientAddresses, string[] recipientPTMKeys); //to tell nodes a new extension is happening
     event AllNodesHaveAccepted(bool outcome); //when all nodes have voted
-    event CanPerformStateShare(); //when all nodes have voted & the recipient has accepted
+    event CanPerformStateShare(); //when all nodes have voted & at least one recipient has accepted
     event ExtensionFinished(); //if the extension is cancelled or completed
     event NewVote(bool vote, address voter); // when someone voted (either true or false)
     event StateShared(address toExtend, string tesserahash, string uuid); //when the state is shared and can be replayed into the database
     event UpdateMembers(address toExtend, string uuid); //to update the original transaction hash for the new party member
 
-    constructor(address contractAddress, address recipientAddress, string memory recipientPTMKey) public {
+    constructor(address contractAddress, address[] memory recipientAddresses, string[] memory recipientPTMKeys, address[] memory dependencyAddresses) public {
+        require(recipientAddresses.length != 0, "at least one recipient required");
+        require(recipientAddresses.length == recipientPTMKeys.length, "number of recipient addresses and keys differ");
+
         creator = msg.sender;
 
-        targetRecipientPTMKey = recipientPTMKey;
+        targetRecipientPTMKey = recipientPTMKeys[0];
 
         contractToExtend = contractAddress;
+        dependencies = dependencyAddresses;
         walletAddressesToVote.push(msg.sender);
-        walletAddressesToVote.push(recipientAddress);
+        walletAddressesToVoteMap[msg.sender] = true;
+        for (uint256 i = 0; i < recipientAddresses.length; i++) {
+            require(!walletAddressesToVoteMap[recipientAddresses[i]], "duplicate voter");
+            walletAddressesToVote.push(recipientAddresses[i]);
+            walletAddressesToVoteMap[recipientAddresses[i]] = true;
+        }
 
         sharedDataHash = "";
 
         voteOutcome = true;
         numberOfVotesSoFar = 0;
 
-        for (uint256 i = 0; i < walletAddressesToVote.length; i++) {
-            walletAddressesToVoteMap[walletAddressesToVote[i]] = true;
-        }
         totalNumberOfVoters = walletAddressesToVote.length;
-        emit NewContractExtensionContractCreated(contractAddress, recipientPTMKey, recipientAddress);
+        emit NewContractExtensionContractCreated(contractAddress, recipientAddresses, recipientPTMKeys);
     }
 
     /////////////////////////////////////////////////////////////////////////////////////
@@ -76,6 +90,11 @@ contract ContractExtender {
         return walletAddressesToVote.length == numberOfVotesSoFar;
     }
 
+    // returns the private contracts shared along with the contract
+    function getDependencies() public view returns (address[] memory) {
+        return dependencies;
+    }
+
     // returns true if the sender address has already voted on the
     // extension contracts
     function checkIfVoted() public view returns (bool) {
@@ -140,8 +159,13 @@ contract ContractExtender {
     }
 
     // checks if all the conditions for voting have been met
-    // either all voted true and target accepted, or someone voted false
+    // either all voted with the creator and at least one recipient accepting,
+    // or the creator or every recipient voted false
     function checkVotes() internal {
+        if (haveAllNodesVoted() && numberOfAcceptances == 0) {
+            voteOutcome = false;
+        }
+
         if (!voteOutcome) {
             emit AllNodesHaveAccepted(false);
             setFinished();
@@ -163,6 +187,10 @@ contract ContractExtender {
         hasVotedMapping[msg.sender] = true;
         votes[msg.sender] = vote;
         numberOfVotesSoFar++;
-        voteOutcome = voteOutcome && vote;
+        if (msg.sender == creator) {
+            voteOutcome = vote;
+        } else if (vote) {
+            numberOfAcceptances++;
+        }
     }
 }
\ No newline at end of file
@@ -0,0 +1,280 @@
+package extensionContracts
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"io/ioutil"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	testifyassert "github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	toExtend  = common.HexToAddress("0x1932c48b2bf8102ba33b4a6b545c32236e342f34")
+	longUuid  = strings.Repeat("0123456789abcdef", 6)
+	shortUuid = "uuid"
+)
+
+type extenderTest struct {
+	t        *testing.T
+	backend  *backends.SimulatedBackend
+	keys     []*ecdsa.PrivateKey
+	accounts []common.Address
+}
+
+func newExtenderTest(t *testing.T, n int) *extenderTest {
+	et := &extenderTest{t: t}
+	alloc := make(core.GenesisAlloc)
+	for i := 0; i < n; i++ {
+		key, _ := crypto.GenerateKey()
+		et.keys = append(et.keys, key)
+		et.accounts = append(et.accounts, crypto.PubkeyToAddress(key.PublicKey))
+		alloc[et.accounts[i]] = core.GenesisAccount{Balance: big.NewInt(1000000000000000000)}
+	}
+	et.backend = backends.NewSimulatedBackend(alloc, 10000000)
+	return et
+}
+
+func (et *extenderTest) opts(i int) *bind.TransactOpts {
+	opts, err := bind.NewKeyedTransactorWithChainID(et.keys[i], big.NewInt(1337))
+	require.NoError(et.t, err)
+	return opts
+}
+
+// logs mines the transaction and returns the logs of the given event
+func (et *extenderTest) logs(tx *types.Transaction, topicHash string) []*types.Log {
+	et.backend.Commit()
+	receipt, err := et.backend.TransactionReceipt(context.Background(), tx.Hash())
+	require.NoError(et.t, err)
+	require.Equal(et.t, types.ReceiptStatusSuccessful, receipt.Status)
+	var found []*types.Log
+	for _, l := range receipt.Logs {
+		if l.Topics[0] == common.HexToHash(topicHash) {
+			found = append(found, l)
+		}
+	}
+	return found
+}
+
+func (et *extenderTest) deploy(recipients []common.Address, keys []string, dependencies ...common.Address) (common.Address, *ContractExtender, *types.Transaction) {
+	address, tx, extender, err := DeployContractExtender(et.opts(0), et.backend, toExtend, recipients, keys, dependencies)
+	require.NoError(et.t, err)
+	return address, extender, tx
+}
+
+func TestContractExtender_MultipleRecipients(t *testing.T) {
+	assert := testifyassert.New(t)
+	et := newExtenderTest(t, 4)
+	defer et.backend.Close()
+
+	recipients := et.accounts[1:]
+	keys := []string{"key1", strings.Repeat("k", 44), "key3"}
+	dependencies := []common.Address{common.HexToAddress("0x9999999999999999999999999999999999999999")}
+	_, extender, tx := et.deploy(recipients, keys, dependencies...)
+
+	created := et.logs(tx, NewContractExtensionContractCreatedTopicHash)
+	require.Len(t, created, 1)
+	event, err := UnpackNewExtensionCreatedLog(created[0].Data)
+	require.NoError(t, err)
+	assert.Equal(toExtend, event.ToExtend)
+	assert.Equal(recipients, event.RecipientAddresses)
+	assert.Equal(keys, event.RecipientPTMKeys)
+
+	total, err := extender.TotalNumberOfVoters(nil)
+	require.NoError(t, err)
+	assert.Equal(int64(4), total.Int64())
+	target, err := extender.TargetRecipientPTMKey(nil)
+	require.NoError(t, err)
+	assert.Equal("key1", target, "Expected the key of the first recipient")
+	approved, err := extender.GetDependencies(nil)
+	require.NoError(t, err)
+	assert.Equal(dependencies, approved)
+	for i, account := range et.accounts {
+		voter, err := extender.WalletAddressesToVote(nil, big.NewInt(int64(i)))
+		require.NoError(t, err)
+		assert.Equal(account, voter)
+	}
+	_, err = extender.WalletAddressesToVote(nil, big.NewInt(4))
+	assert.Error(err)
+
+	// the creator and the first recipient accept, the second recipient declines
+	tx, err = extender.DoVote(et.opts(0), true, longUuid)
+	require.NoError(t, err)
+	assert.Empty(et.logs(tx, CanPerformStateShareTopicHash))
+	tx, err = extender.DoVote(et.opts(1), true, shortUuid)
+	require.NoError(t, err)
+	et.logs(tx, NewVoteTopicHash)
+	tx, err = extender.DoVote(et.opts(2), false, "declined")
+	require.NoError(t, err)
+	assert.Empty(et.logs(tx, ExtensionFinishedTopicHash), "a recipient declining does not finish the extension")
+
+	_, err = extender.DoVote(et.opts(1), true, shortUuid)
+	assert.EqualError(err, "failed to estimate gas needed: execution reverted: already voted")
+
+	tx, err = extender.DoVote(et.opts(3), true, shortUuid+"3")
+	require.NoError(t, err)
+	assert.Len(et.logs(tx, CanPerformStateShareTopicHash), 1)
+
+	accepted, err := extender.NumberOfAcceptances(nil)
+	require.NoError(t, err)
+	assert.Equal(int64(2), accepted.Int64())
+	for i, expected := range []bool{true, true, false, true} {
+		vote, err := extender.Votes(nil, et.accounts[i])
+		require.NoError(t, err)
+		assert.Equal(expected, vote)
+		voted, err := extender.CheckIfVoted(&bind.CallOpts{From: et.accounts[i]})
+		require.NoError(t, err)
+		assert.True(voted)
+	}
+
+	_, err = extender.SetSharedStateHash(et.opts(1), "hash")
+	assert.EqualError(err, "failed to estimate gas needed: execution reverted: only leader may perform this action")
+	hash := strings.Repeat("h", 88)
+	tx, err = extender.SetSharedStateHash(et.opts(0), hash)
+	require.NoError(t, err)
+	shared := et.logs(tx, StateSharedTopicHash)
+	var uuids []string
+	for _, l := range shared {
+		address, tesserahash, uuid, err := UnpackStateSharedLog(l.Data)
+		require.NoError(t, err)
+		assert.Equal(toExtend, address)
+		assert.Equal(hash, tesserahash)
+		uuids = append(uuids, uuid)
+	}
+	assert.Equal([]string{longUuid, shortUuid, shortUuid + "3"}, uuids)
+
+	stored, err := extender.SharedDataHash(nil)
+	require.NoError(t, err)
+	assert.Equal(hash, stored)
+	finished, err := extender.CheckIfExtensionFinished(nil)
+	require.NoError(t, err)
+	assert.True(finished)
+
+	tx, err = extender.UpdatePartyMembers(et.opts(2))
+	require.NoError(t, err)
+	assert.Len(et.logs(tx, UpdateMembersTopicHash), 3)
+}
+
+func TestContractExtender_Declined(t *testing.T) {
+	assert := testifyassert.New(t)
+	et := newExtenderTest(t, 4)
+	defer et.backend.Close()
+
+	// every recipient declining declines the extension
+	_, extender, _ := et.deploy(et.accounts[1:3], []string{"key1", "key2"})
+	_, err := extender.DoVote(et.opts(3), true, shortUuid)
+	assert.EqualError(err, "failed to estimate gas needed: execution reverted: not allowed to vote")
+	for i, vote := range []bool{true, false} {
+		tx, err := extender.DoVote(et.opts(i), vote, shortUuid)
+		require.NoError(t, err)
+		assert.Empty(et.logs(tx, ExtensionFinishedTopicHash))
+	}
+	tx, err := extender.DoVote(et.opts(2), false, shortUuid)
+	require.NoError(t, err)
+	assert.Len(et.logs(tx, ExtensionFinishedTopicHash), 1)
+	outcome, err := extender.VoteOutcome(nil)
+	require.NoError(t, err)
+	assert.False(outcome)
+
+	// the creator declining declines the extension for everyone
+	_, extender, _ = et.deploy(et.accounts[1:3], []string{"key1", "key2"})
+	tx, err = extender.DoVote(et.opts(0), false, shortUuid)
+	require.NoError(t, err)
+	assert.Len(et.logs(tx, ExtensionFinishedTopicHash), 1)
+	_, err = extender.DoVote(et.opts(1), true, shortUuid)
+	assert.EqualError(err, "failed to estimate gas needed: execution reverted: extension has been marked as finished")
+}
+
+func TestContractExtender_InvalidRecipients(t *testing.T) {
+	assert := testifyassert.New(t)
+	et := newExtenderTest(t, 3)
+	defer et.backend.Close()
+
+	_, _, _, err := DeployContractExtender(et.opts(0), et.backend, toExtend, nil, nil, nil)
+	assert.EqualError(err, "failed to estimate gas needed: execution reverted: at least one recipient required")
+	_, _, _, err = DeployContractExtender(et.opts(0), et.backend, toExtend, et.accounts[1:], []string{"key1"}, nil)
+	assert.EqualError(err, "failed to estimate gas needed: execution reverted: number of recipient addresses and keys differ")
+	_, _, _, err = DeployContractExtender(et.opts(0), et.backend, toExtend, et.accounts[:2], []string{"key0", "key1"}, nil)
+	assert.EqualError(err, "failed to estimate gas needed: execution reverted: duplicate voter")
+}
+
+func TestUnpackLegacyNewExtensionCreatedLog(t *testing.T) {
+	assert := testifyassert.New(t)
+
+	recipient := common.HexToAddress("0x4444444444444444444444444444444444444444")
+	data, err := legacyNewExtensionCreatedParsedABI.Events["NewContractExtensionContractCreated"].Inputs.Pack(toExtend, "key1", recipient)
+	require.NoError(t, err)
+
+	event, err := UnpackLegacyNewExtensionCreatedLog(data)
+	require.NoError(t, err)
+	assert.Equal(toExtend, event.ToExtend)
+	assert.Equal([]common.Address{recipient}, event.RecipientAddresses)
+	assert.Equal([]string{"key1"}, event.RecipientPTMKeys)
+	assert.NotEqual(NewContractExtensionContractCreatedTopicHash, LegacyNewContractExtensionContractCreatedTopicHash)
+}
+
+type storageAt struct {
+	backend *backends.SimulatedBackend
+}
+
+func (s storageAt) GetCodeHash(address common.Address) common.Hash {
+	code, _ := s.backend.CodeAt(context.Background(), address, nil)
+	return crypto.Keccak256Hash(code)
+}
+
+func (s storageAt) GetState(address common.Address, key common.Hash) common.Hash {
+	value, _ := s.backend.StorageAt(context.Background(), address, key, nil)
+	return common.BytesToHash(value)
+}
+
+func TestApprovedDependencies(t *testing.T) {
+	et := newExtenderTest(t, 2)
+	defer et.backend.Close()
+
+	dependencies := []common.Address{common.HexToAddress("0x9999999999999999999999999999999999999999"), common.HexToAddress("0x8888888888888888888888888888888888888888")}
+	address, _, tx := et.deploy(et.accounts[1:], []string{"key1"}, dependencies...)
+	et.logs(tx, NewContractExtensionContractCreatedTopicHash)
+
+	approved, ok := ApprovedDependencies(storageAt{et.backend}, address)
+	require.True(t, ok)
+	testifyassert.Equal(t, dependencies, approved)
+
+	// an account which is not a management contract
+	approved, ok = ApprovedDependencies(storageAt{et.backend}, et.accounts[0])
+	require.True(t, ok)
+	testifyassert.Empty(t, approved)
+}
+
+func TestApprovedDependencies_whenLegacyContractExtender(t *testing.T) {
+	et := newExtenderTest(t, 2)
+	defer et.backend.Close()
+
+	// the ContractExtender deployed before extensions had several recipients
+	legacyABI, err := ioutil.ReadFile("testdata/contract_extender_legacy.abi")
+	require.NoError(t, err)
+	legacyBin, err := ioutil.ReadFile("testdata/contract_extender_legacy.bin")
+	require.NoError(t, err)
+	parsed, err := abi.JSON(bytes.NewReader(legacyABI))
+	require.NoError(t, err)
+	address, tx, _, err := bind.DeployContract(et.opts(0), parsed, common.FromHex(strings.TrimSpace(string(legacyBin))), et.backend, toExtend, et.accounts[1], "key1")
+	require.NoError(t, err)
+	require.Len(t, et.logs(tx, LegacyNewContractExtensionContractCreatedTopicHash), 1)
+
+	// the slot of the dependencies holds the voters
+	require.Equal(t, common.BigToHash(big.NewInt(2)), storageAt{et.backend}.GetState(address, dependenciesSlot))
+
+	approved, ok := ApprovedDependencies(storageAt{et.backend}, address)
+	require.True(t, ok)
+	testifyassert.Empty(t, approved)
+}
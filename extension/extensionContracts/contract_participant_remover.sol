@@ -0,0 +1,26 @@
+pragma solidity >=0.5.3 <0.9.0;
+pragma experimental ABIEncoderV2;
+
+contract ContractParticipantRemover {
+
+    //target details - which parties are removed from which contract
+    address public creator;
+    address public contractToRemoveFrom;
+
+    //the hash of the payload distributed to the remaining parties
+    string public sharedDataHash;
+
+    //to tell nodes the parties were removed and the contract privacy metadata must be updated
+    event ParticipantsRemoved(address toRemoveFrom, string[] removedPTMKeys, string tesserahash);
+
+    constructor(address contractAddress, string[] memory removedPTMKeys, string memory hash) public {
+        require(removedPTMKeys.length != 0, "at least one party to remove required");
+        require(bytes(hash).length != 0, "new hash cannot be empty");
+
+        creator = msg.sender;
+        contractToRemoveFrom = contractAddress;
+        emit ParticipantsRemoved(contractAddress, removedPTMKeys, hash);
+
+        sharedDataHash = hash;
+    }
+}
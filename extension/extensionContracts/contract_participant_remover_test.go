@@ -0,0 +1,54 @@
+package extensionContracts
+
+import (
+	"strings"
+	"testing"
+
+	testifyassert "github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContractParticipantRemover(t *testing.T) {
+	et := newExtenderTest(t, 1)
+	defer et.backend.Close()
+
+	keys := []string{"key1", strings.Repeat("k", 44)}
+	for _, hash := range []string{strings.Repeat("h", 44), "hash"} {
+		testRemoveParticipants(t, et, keys, hash)
+	}
+}
+
+func testRemoveParticipants(t *testing.T, et *extenderTest, keys []string, hash string) {
+	assert := testifyassert.New(t)
+	_, tx, remover, err := DeployContractParticipantRemover(et.opts(0), et.backend, toExtend, keys, hash)
+	require.NoError(t, err)
+
+	removed := et.logs(tx, ParticipantsRemovedTopicHash)
+	require.Len(t, removed, 1)
+	address, removedKeys, tesserahash, err := UnpackParticipantsRemovedLog(removed[0].Data)
+	require.NoError(t, err)
+	assert.Equal(toExtend, address)
+	assert.Equal(keys, removedKeys)
+	assert.Equal(hash, tesserahash)
+
+	creator, err := remover.Creator(nil)
+	require.NoError(t, err)
+	assert.Equal(et.accounts[0], creator)
+	contract, err := remover.ContractToRemoveFrom(nil)
+	require.NoError(t, err)
+	assert.Equal(toExtend, contract)
+	stored, err := remover.SharedDataHash(nil)
+	require.NoError(t, err)
+	assert.Equal(hash, stored)
+}
+
+func TestContractParticipantRemover_Invalid(t *testing.T) {
+	assert := testifyassert.New(t)
+	et := newExtenderTest(t, 1)
+	defer et.backend.Close()
+
+	_, _, _, err := DeployContractParticipantRemover(et.opts(0), et.backend, toExtend, nil, "hash")
+	assert.EqualError(err, "failed to estimate gas needed: execution reverted: at least one party to remove required")
+	_, _, _, err = DeployContractParticipantRemover(et.opts(0), et.backend, toExtend, []string{"key1"}, "")
+	assert.EqualError(err, "failed to estimate gas needed: execution reverted: new hash cannot be empty")
+}
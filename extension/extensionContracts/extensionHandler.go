@@ -1,6 +1,21 @@
 package extensionContracts
 
-import "github.com/ethereum/go-ethereum/common"
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// the creation event of the management contracts extending a contract to a
+// single recipient, deployed by earlier versions
+const legacyNewExtensionCreatedABI = `[{"anonymous":false,"inputs":[{"indexed":false,"internalType":"address","name":"toExtend","type":"address"},{"indexed":false,"internalType":"string","name":"recipientPTMKey","type":"string"},{"indexed":false,"internalType":"address","name":"recipientAddress","type":"address"}],"name":"NewContractExtensionContractCreated","type":"event"}]`
+
+var legacyNewExtensionCreatedParsedABI, _ = abi.JSON(strings.NewReader(legacyNewExtensionCreatedABI))
+
+// LegacyNewContractExtensionContractCreatedTopicHash is the topic of the
+// creation event of the single recipient management contracts
+var LegacyNewContractExtensionContractCreatedTopicHash = legacyNewExtensionCreatedParsedABI.Events["NewContractExtensionContractCreated"].ID.Hex()
 
 func UnpackStateSharedLog(logData []byte) (common.Address, string, string, error) {
 	decodedLog := new(ContractExtenderStateShared)
@@ -10,9 +25,35 @@ func UnpackStateSharedLog(logData []byte) (common.Address, string, string, error
 	return decodedLog.ToExtend, decodedLog.Tesserahash, decodedLog.Uuid, nil
 }
 
+func UnpackParticipantsRemovedLog(logData []byte) (common.Address, []string, string, error) {
+	decodedLog := new(ContractParticipantRemoverParticipantsRemoved)
+	if err := ContractParticipantRemoverParsedABI.UnpackIntoInterface(decodedLog, "ParticipantsRemoved", logData); err != nil {
+		return common.Address{}, nil, "", err
+	}
+	return decodedLog.ToRemoveFrom, decodedLog.RemovedPTMKeys, decodedLog.Tesserahash, nil
+}
+
 func UnpackNewExtensionCreatedLog(data []byte) (*ContractExtenderNewContractExtensionContractCreated, error) {
 	newExtensionEvent := new(ContractExtenderNewContractExtensionContractCreated)
 	err := ContractExtenderParsedABI.UnpackIntoInterface(newExtensionEvent, "NewContractExtensionContractCreated", data)
 
 	return newExtensionEvent, err
 }
+
+// UnpackLegacyNewExtensionCreatedLog unpacks the creation event of a single
+// recipient management contract
+func UnpackLegacyNewExtensionCreatedLog(data []byte) (*ContractExtenderNewContractExtensionContractCreated, error) {
+	var legacyEvent struct {
+		ToExtend         common.Address
+		RecipientPTMKey  string
+		RecipientAddress common.Address
+	}
+	if err := legacyNewExtensionCreatedParsedABI.UnpackIntoInterface(&legacyEvent, "NewContractExtensionContractCreated", data); err != nil {
+		return nil, err
+	}
+	return &ContractExtenderNewContractExtensionContractCreated{
+		ToExtend:           legacyEvent.ToExtend,
+		RecipientAddresses: []common.Address{legacyEvent.RecipientAddress},
+		RecipientPTMKeys:   []string{legacyEvent.RecipientPTMKey},
+	}, nil
+}
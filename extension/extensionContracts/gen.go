@@ -1,5 +1,12 @@
 package extensionContracts
 
-//go:generate solc --abi --bin -o . contract_extender.sol
+// the contracts are built with solc 0.8.21 and --evm-version istanbul, as the
+// EVM does not support PUSH0
+
+//go:generate solc --abi --bin --evm-version istanbul -o . contract_extender.sol
 //go:generate abigen -pkg extensionContract -abi ./ContractExtender.abi -bin ./ContractExtender.bin -type ContractExtender -out ./contract_extender.go
 //go:generate rm ContractExtender.abi ContractExtender.bin
+
+//go:generate solc --abi --bin --evm-version istanbul -o . contract_participant_remover.sol
+//go:generate abigen -pkg extensionContract -abi ./ContractParticipantRemover.abi -bin ./ContractParticipantRemover.bin -type ContractParticipantRemover -out ./contract_participant_remover.go
+//go:generate rm ContractParticipantRemover.abi ContractParticipantRemover.bin
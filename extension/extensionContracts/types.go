@@ -1,9 +1,112 @@
 package extensionContracts
 
 import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
+// MaxDependencies is the maximum number of private contracts shared along
+// with an extended contract
+const MaxDependencies = 32
+
+// dependenciesSlot is the storage slot of the dependencies array of the
+// ContractExtender contract
+var dependenciesSlot = common.BigToHash(big.NewInt(3))
+
+// legacyContractExtenderCodeHash is the code hash of the ContractExtender
+// deployed before extensions had several recipients. Its storage holds the
+// voters where the dependencies are held now, and it has no dependencies
+var legacyContractExtenderCodeHash = common.HexToHash("0x46db731f624a129484a95a2318862708e1345db933fd50fb4a14e559d1a24160")
+
+// StorageReader reads the storage of a contract
+type StorageReader interface {
+	GetCodeHash(address common.Address) common.Hash
+	GetState(address common.Address, key common.Hash) common.Hash
+}
+
 type AccountWithMetadata struct {
 	State state.DumpAccount `json:"state"`
 }
+
+// ReferencedAddresses returns the addresses pushed by the code of the account
+// and the storage values which fit in an address, sorted. These are the
+// candidate dependencies of a contract: linked libraries, proxy
+// implementations and registered contracts. Addresses packed with other
+// values in a storage slot are not found.
+func (a AccountWithMetadata) ReferencedAddresses() []common.Address {
+	found := make(map[common.Address]bool)
+	code := common.FromHex(a.State.Code)
+	for i := 0; i < len(code); i++ {
+		op := vm.OpCode(code[i])
+		if !op.IsPush() {
+			continue
+		}
+		size := int(op - vm.PUSH1 + 1)
+		if op == vm.PUSH20 && i+size < len(code) {
+			found[common.BytesToAddress(code[i+1:i+1+size])] = true
+		}
+		i += size
+	}
+	for _, value := range a.State.Storage {
+		if b := common.FromHex(value); len(b) > 0 && len(b) <= common.AddressLength {
+			found[common.BytesToAddress(b)] = true
+		}
+	}
+	addresses := make([]common.Address, 0, len(found))
+	for address := range found {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i][:], addresses[j][:]) < 0
+	})
+	return addresses
+}
+
+// DependencyClosure returns the address followed by the accounts referenced
+// by it, directly or through other referenced accounts, which are in the
+// given accounts
+func DependencyClosure(address common.Address, accounts map[string]AccountWithMetadata) []common.Address {
+	closure := []common.Address{address}
+	seen := map[common.Address]bool{address: true}
+	for i := 0; i < len(closure); i++ {
+		for _, referenced := range accounts[closure[i].Hex()].ReferencedAddresses() {
+			if _, ok := accounts[referenced.Hex()]; ok && !seen[referenced] {
+				seen[referenced] = true
+				closure = append(closure, referenced)
+			}
+		}
+	}
+	return closure
+}
+
+// ApprovedDependencies reads the dependencies approved with an extension from
+// the storage of its management contract. A legacy ContractExtender has none.
+// It returns false if the storage does not hold a list of at most
+// MaxDependencies addresses.
+func ApprovedDependencies(db StorageReader, managementContract common.Address) ([]common.Address, bool) {
+	if db.GetCodeHash(managementContract) == legacyContractExtenderCodeHash {
+		return []common.Address{}, true
+	}
+	length := db.GetState(managementContract, dependenciesSlot).Big()
+	if !length.IsUint64() || length.Uint64() > MaxDependencies {
+		return nil, false
+	}
+	// the elements of a dynamic array start at the hash of its slot
+	start := crypto.Keccak256Hash(dependenciesSlot.Bytes()).Big()
+	dependencies := make([]common.Address, length.Uint64())
+	for i := range dependencies {
+		key := common.BigToHash(new(big.Int).Add(start, big.NewInt(int64(i))))
+		value := db.GetState(managementContract, key)
+		if common.BytesToAddress(value.Bytes()).Hash() != value {
+			return nil, false
+		}
+		dependencies[i] = common.BytesToAddress(value.Bytes())
+	}
+	return dependencies, true
+}
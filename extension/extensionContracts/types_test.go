@@ -0,0 +1,45 @@
+package extensionContracts
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	testifyassert "github.com/stretchr/testify/assert"
+)
+
+var (
+	library  = common.HexToAddress("0x1111111111111111111111111111111111111111")
+	registry = common.HexToAddress("0x0000000000000000000000000000000000003333")
+)
+
+func TestAccountWithMetadata_ReferencedAddresses(t *testing.T) {
+	assert := testifyassert.New(t)
+
+	// PUSH20 library, PUSH32 with an address in its data, PUSH20 truncated
+	code := "73" + common.Bytes2Hex(library.Bytes()) + "7f" + common.Bytes2Hex(common.LeftPadBytes(toExtend.Bytes(), 32)) + "73aabb"
+	account := AccountWithMetadata{State: state.DumpAccount{
+		Code: code,
+		Storage: map[common.Hash]string{
+			{1}: common.Bytes2Hex(registry.Bytes()[18:]),
+			{2}: common.Bytes2Hex(common.LeftPadBytes([]byte{1}, 21)),
+			{3}: common.Bytes2Hex(library.Bytes()),
+		},
+	}}
+
+	assert.Equal([]common.Address{registry, library}, account.ReferencedAddresses())
+}
+
+func TestDependencyClosure(t *testing.T) {
+	assert := testifyassert.New(t)
+
+	accounts := map[string]AccountWithMetadata{
+		toExtend.Hex(): {State: state.DumpAccount{Code: "73" + common.Bytes2Hex(library.Bytes())}},
+		library.Hex():  {State: state.DumpAccount{Storage: map[common.Hash]string{{1}: common.Bytes2Hex(registry.Bytes())}}},
+		registry.Hex(): {State: state.DumpAccount{Code: "73" + common.Bytes2Hex(toExtend.Bytes())}},
+	}
+	assert.Equal([]common.Address{toExtend, library, registry}, DependencyClosure(toExtend, accounts))
+
+	delete(accounts, library.Hex())
+	assert.Equal([]common.Address{toExtend}, DependencyClosure(toExtend, accounts), "a dependency only referenced by a missing account is not in the closure")
+}
@@ -35,3 +35,12 @@ func checkAddressInList(addressToFind common.Address, addressList []common.Addre
 	}
 	return false
 }
+
+func checkKeyInList(keyToFind string, keyList []string) bool {
+	for _, key := range keyList {
+		if keyToFind == key {
+			return true
+		}
+	}
+	return false
+}
@@ -81,6 +81,13 @@ func logContainsExtensionTopic(receivedLog *types.Log) bool {
 	return receivedLog.Topics[0].String() == extension.StateSharedTopicHash
 }
 
+func logContainsParticipantsRemovedTopic(receivedLog *types.Log) bool {
+	if len(receivedLog.Topics) != 1 {
+		return false
+	}
+	return receivedLog.Topics[0].String() == extension.ParticipantsRemovedTopicHash
+}
+
 // validateAccountsExist checks that all the accounts in the expected list are
 // present in the state map, and that no  other accounts exist in the state map
 // that are unexpected
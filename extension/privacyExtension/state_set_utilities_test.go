@@ -285,6 +285,8 @@ type mockPSMR struct {
 type mockPrivateTransactionManager struct {
 	notinuse.PrivateTransactionManager
 	returns map[string][]interface{}
+	// the return values of Receive for a given hash, overriding returns
+	received map[common.EncryptedPayloadHash][]interface{}
 }
 
 func (mpsmr *mockPSMR) ResolveForManagedParty(managedParty string) (*mps.PrivateStateMetadata, error) {
@@ -304,6 +306,9 @@ func (mpsmr *mockPSMR) ResolveForManagedParty(managedParty string) (*mps.Private
 
 func (mpm *mockPrivateTransactionManager) Receive(data common.EncryptedPayloadHash) (string, []string, []byte, *engine.ExtraMetadata, error) {
 	values := mpm.returns["Receive"]
+	if v, ok := mpm.received[data]; ok {
+		values = v
+	}
 	var (
 		r1 string
 		r2 []string
@@ -40,6 +40,10 @@ func (handler *ExtensionHandler) SetPSMR(psmr mps.PrivateStateMetadataResolver)
 func (handler *ExtensionHandler) CheckExtensionAndSetPrivateState(txLogs []*types.Log, privateState *state.StateDB, psi types.PrivateStateIdentifier) {
 	extraMetaDataUpdated := false
 	for _, txLog := range txLogs {
+		if logContainsParticipantsRemovedTopic(txLog) {
+			handler.removeParticipants(txLog, privateState)
+			continue
+		}
 		if !logContainsExtensionTopic(txLog) {
 			continue
 		}
@@ -49,18 +53,37 @@ func (handler *ExtensionHandler) CheckExtensionAndSetPrivateState(txLogs []*type
 			continue
 		}
 
+		// the management contract records the dependencies the voters
+		// approved, the state share can not carry any other account
+		approved, ok := extension.ApprovedDependencies(privateState, txLog.Address)
+		if !ok {
+			log.Error("Extension: could not read the approved dependencies", "managementContract", txLog.Address)
+			continue
+		}
+
 		// check if state exists for the extension address. If yes then skip
 		// processing
 		if privateState.GetCode(address) != nil {
 			if extraMetaDataUpdated {
 				continue
 			}
+			accounts, found := handler.sharedAccounts(hash)
+			if !found {
+				continue
+			}
+			if !onlyApprovedAccounts(address, approved, accounts) {
+				log.Error("Extension: state share contains accounts which were not approved", "address", address, "approved", approved)
+				continue
+			}
 			// check the privacy flag of the contract. if its other than
 			// 0 then need to update the privacy metadata for the contract
 			//TODO: validate the old and new parties to ensure that all old parties are there
-			setPrivacyMetadata(privateState, address, hash)
-			if handler.isMultitenant {
-				setManagedParties(handler.ptm, privateState, address, hash)
+			handler.updateMetadata(privateState, address, hash)
+			// the dependencies shared along with the contract
+			for _, dependency := range extension.DependencyClosure(address, accounts)[1:] {
+				if privateState.GetCode(dependency) != nil {
+					handler.updateMetadata(privateState, dependency, hash)
+				}
 			}
 			extraMetaDataUpdated = true
 		} else {
@@ -71,12 +94,26 @@ func (handler *ExtensionHandler) CheckExtensionAndSetPrivateState(txLogs []*type
 			if !handler.isMultitenant {
 				managedParties = nil
 			}
-			if !validateAccountsExist([]common.Address{address}, accounts) {
+			if !onlyApprovedAccounts(address, approved, accounts) {
+				log.Error("Extension: state share contains accounts which were not approved", "address", address, "approved", approved)
+				continue
+			}
+			// besides the contract, the state can only hold the private
+			// contracts it depends on
+			if !validateAccountsExist(extension.DependencyClosure(address, accounts), accounts) {
 				log.Error("Account mismatch", "expected", address, "found", accounts)
 				continue
 			}
 			snapshotId := privateState.Snapshot()
 
+			// a dependency the node is already party to keeps its state
+			for key := range accounts {
+				if dependency := common.HexToAddress(key); dependency != address && privateState.GetCode(dependency) != nil {
+					delete(accounts, key)
+					handler.updateMetadata(privateState, dependency, hash)
+				}
+			}
+
 			if success := setState(privateState, accounts, privacyMetaData, managedParties); !success {
 				privateState.RevertToSnapshot(snapshotId)
 			}
@@ -84,6 +121,121 @@ func (handler *ExtensionHandler) CheckExtensionAndSetPrivateState(txLogs []*type
 	}
 }
 
+// sharedAccounts returns the accounts in the given state share payload
+func (handler *ExtensionHandler) sharedAccounts(hash string) (map[string]extension.AccountWithMetadata, bool) {
+	ptmHash, err := common.Base64ToEncryptedPayloadHash(hash)
+	if err != nil {
+		return nil, false
+	}
+	_, _, stateData, _, err := handler.ptm.Receive(ptmHash)
+	if err != nil || stateData == nil {
+		return nil, false
+	}
+	var accounts map[string]extension.AccountWithMetadata
+	if err := json.Unmarshal(stateData, &accounts); err != nil {
+		log.Error("Extension: Could not unmarshal data")
+		return nil, false
+	}
+	return accounts, true
+}
+
+// onlyApprovedAccounts checks that the accounts of a state share are the
+// extended contract and approved dependencies
+func onlyApprovedAccounts(address common.Address, approved []common.Address, accounts map[string]extension.AccountWithMetadata) bool {
+	for key := range accounts {
+		account := common.HexToAddress(key)
+		if account == address {
+			continue
+		}
+		isApproved := false
+		for _, dependency := range approved {
+			isApproved = isApproved || dependency == account
+		}
+		if !isApproved {
+			return false
+		}
+	}
+	return true
+}
+
+// updateMetadata replaces the privacy metadata of an existing contract with
+// the state share payload
+func (handler *ExtensionHandler) updateMetadata(privateState *state.StateDB, address common.Address, hash string) {
+	setPrivacyMetadata(privateState, address, hash)
+	if handler.isMultitenant {
+		setManagedParties(handler.ptm, privateState, address, hash)
+	}
+}
+
+// removeParticipants updates the privacy metadata of a contract after parties
+// were removed from it. The new creation hash is the payload sent to the
+// remaining parties, so transactions of the removed parties, which only know
+// the previous creation hash, fail the participation check. The log must be
+// emitted by a removal contract deployed by the sender of the current creation
+// hash, and the payload must be sent by the same party and contain the
+// contract address. A removed party does not receive the payload and keeps its
+// state unchanged.
+// Standard private contracts record no creation hash to check the sender
+// against, parties can not be removed from them.
+func (handler *ExtensionHandler) removeParticipants(txLog *types.Log, privateState *state.StateDB) {
+	address, removedKeys, hash, err := extension.UnpackParticipantsRemovedLog(txLog.Data)
+	if err != nil {
+		log.Error("Extension: could not unpack participants removed log", "err", err)
+		return
+	}
+	if privateState.GetCode(address) == nil {
+		return
+	}
+	existing, err := privateState.GetPrivacyMetadata(address)
+	if err != nil || existing.PrivacyFlag.IsStandardPrivate() {
+		log.Debug("Extension: ignoring participants removed from a standard private contract", "address", address)
+		return
+	}
+	ptmHash, err := common.Base64ToEncryptedPayloadHash(hash)
+	if err != nil {
+		log.Error("Extension: invalid participants removed hash", "hash", hash, "err", err)
+		return
+	}
+	sender, managedParties, data, _, err := handler.ptm.Receive(ptmHash)
+	if err != nil || data == nil {
+		// we are not one of the remaining parties
+		return
+	}
+	if !bytes.Equal(data, address.Bytes()) {
+		log.Error("Extension: wrong address in participants removed payload", "expected", address)
+		return
+	}
+	creationSender, _, _, _, err := handler.ptm.Receive(existing.CreationTxHash)
+	if err != nil || creationSender == "" || creationSender != sender {
+		log.Error("Extension: participants removed by a party other than the contract creator", "address", address, "sender", sender)
+		return
+	}
+	if !handler.isRemoverDeployedBy(privateState, txLog.Address, creationSender) {
+		log.Error("Extension: participants removed log not emitted by a removal contract of the contract creator", "address", address, "emitter", txLog.Address)
+		return
+	}
+
+	log.Info("Extension: removing parties from contract", "address", address, "removed", removedKeys)
+	privateState.SetPrivacyMetadata(address, state.NewStatePrivacyMetadata(ptmHash, existing.PrivacyFlag))
+	if handler.isMultitenant {
+		privateState.SetManagedParties(address, managedParties)
+	}
+}
+
+// isRemoverDeployedBy checks that the given contract was created from the
+// participant removal contract code by a payload of the given sender
+func (handler *ExtensionHandler) isRemoverDeployedBy(privateState *state.StateDB, remover common.Address, sender string) bool {
+	metadata, err := privateState.GetPrivacyMetadata(remover)
+	if err != nil {
+		return false
+	}
+	creationSender, _, creationData, _, err := handler.ptm.Receive(metadata.CreationTxHash)
+	if err != nil || creationSender != sender {
+		return false
+	}
+	return bytes.HasPrefix(creationData, common.FromHex(extension.ContractParticipantRemoverBin))
+}
+
 func (handler *ExtensionHandler) FetchStateData(address common.Address, hash string, uuid string, psi types.PrivateStateIdentifier) ([]string, map[string]extension.AccountWithMetadata, *state.PrivacyMetadata, bool) {
 	if uuidIsSentByUs := handler.UuidIsOwn(address, uuid, psi); !uuidIsSentByUs {
 		return nil, nil, nil, false
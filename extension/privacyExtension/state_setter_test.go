@@ -1,13 +1,20 @@
 package privacyExtension
 
 import (
+	"encoding/json"
 	"errors"
+	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/mps"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	extension "github.com/ethereum/go-ethereum/extension/extensionContracts"
+	"github.com/ethereum/go-ethereum/private/engine"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -216,3 +223,222 @@ func TestExtensionHandler_UuidIsOwn_PrivatePSMRSucceeds(t *testing.T) {
 
 	assert.True(t, isOwn)
 }
+
+var participantRemoverAddress = common.HexToAddress("0x9ccd1e1089c79fe1cca81601fc9ccfa24f77eb58")
+
+func participantsRemovedLog(t *testing.T, address common.Address, hash common.EncryptedPayloadHash) *types.Log {
+	data, err := extension.ContractParticipantRemoverParsedABI.Events["ParticipantsRemoved"].Inputs.Pack(address, []string{"removedKey"}, hash.ToBase64())
+	if err != nil {
+		t.Fatalf("failed to pack log: %v", err)
+	}
+	return &types.Log{
+		Address: participantRemoverAddress,
+		Topics:  []common.Hash{common.HexToHash(extension.ParticipantsRemovedTopicHash)},
+		Data:    data,
+	}
+}
+
+func TestExtensionHandler_CheckExtensionAndSetPrivateState_ParticipantsRemoved(t *testing.T) {
+	address := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	creationHash := common.EncryptedPayloadHash{1}
+	removalHash := common.EncryptedPayloadHash{2}
+	removerHash := common.EncryptedPayloadHash{3}
+	removerCode := append(common.FromHex(extension.ContractParticipantRemoverBin), address.Bytes()...)
+
+	tests := []struct {
+		name            string
+		creationSender  string
+		payload         []byte
+		removerSender   string
+		removerCode     []byte
+		expectedHash    common.EncryptedPayloadHash
+		expectedParties []string
+	}{
+		{"removed", "creator", address.Bytes(), "creator", removerCode, removalHash, []string{"mp1"}},
+		{"sent by another party", "other", address.Bytes(), "creator", removerCode, creationHash, []string{"mp1", "removedKey"}},
+		{"wrong address", "creator", common.HexToAddress("0x3333").Bytes(), "creator", removerCode, creationHash, []string{"mp1", "removedKey"}},
+		{"not a remaining party", "creator", nil, "creator", removerCode, creationHash, []string{"mp1", "removedKey"}},
+		{"remover deployed by another party", "creator", address.Bytes(), "other", removerCode, creationHash, []string{"mp1", "removedKey"}},
+		{"emitted by another contract", "creator", address.Bytes(), "creator", []byte{1}, creationHash, []string{"mp1", "removedKey"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statedb := createStateDb(t, &state.PrivacyMetadata{CreationTxHash: creationHash, PrivacyFlag: engine.PrivacyFlagPartyProtection})
+			statedb.SetManagedParties(address, []string{"mp1", "removedKey"})
+			statedb.SetCode(participantRemoverAddress, []byte{1})
+			statedb.SetPrivacyMetadata(participantRemoverAddress, &state.PrivacyMetadata{CreationTxHash: removerHash, PrivacyFlag: engine.PrivacyFlagPartyProtection})
+			ptm := &mockPrivateTransactionManager{
+				received: map[common.EncryptedPayloadHash][]interface{}{
+					creationHash: {tt.creationSender, nil, []byte("creation"), nil, nil},
+					removalHash:  {"creator", []string{"mp1"}, tt.payload, nil, nil},
+					removerHash:  {tt.removerSender, nil, tt.removerCode, nil, nil},
+				},
+			}
+			handler := NewExtensionHandler(ptm)
+			handler.SupportMultitenancy(true)
+
+			handler.CheckExtensionAndSetPrivateState([]*types.Log{participantsRemovedLog(t, address, removalHash)}, statedb, types.DefaultPrivateStateIdentifier)
+
+			metadata, err := statedb.GetPrivacyMetadata(address)
+			assert.NoError(t, err)
+			assert.Equal(t, engine.PrivacyFlagPartyProtection, metadata.PrivacyFlag)
+			assert.Equal(t, tt.expectedHash, metadata.CreationTxHash)
+			parties, err := statedb.GetManagedParties(address)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedParties, parties)
+		})
+	}
+}
+
+func TestExtensionHandler_CheckExtensionAndSetPrivateState_ParticipantsRemovedStandardPrivate(t *testing.T) {
+	address := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	removalHash := common.EncryptedPayloadHash{2}
+
+	statedb := createStateDb(t, &state.PrivacyMetadata{})
+	ptm := &mockPrivateTransactionManager{
+		returns: map[string][]interface{}{"Receive": {"creator", []string{"mp1"}, address.Bytes(), nil, nil}},
+	}
+	handler := NewExtensionHandler(ptm)
+	handler.SupportMultitenancy(true)
+	handler.CheckExtensionAndSetPrivateState([]*types.Log{participantsRemovedLog(t, address, removalHash)}, statedb, types.DefaultPrivateStateIdentifier)
+
+	_, err := statedb.GetPrivacyMetadata(address)
+	assert.Error(t, err, "expected the contract to stay standard private")
+}
+
+func TestExtensionHandler_CheckExtensionAndSetPrivateState_Dependencies(t *testing.T) {
+	address := common.HexToAddress("0x8888888888888888888888888888888888888888")
+	library := common.HexToAddress("0x9999999999999999999999999999999999999999")
+	other := common.HexToAddress("0x7777777777777777777777777777777777777777")
+	managementContract := common.HexToAddress("0x9ccd1e1089c79fe1cca81601fc9ccfa24f77eb58")
+	uuid := "0xabcd"
+	stateHash := common.EncryptedPayloadHash{9}
+
+	account := func(code []byte, storage string) extension.AccountWithMetadata {
+		return extension.AccountWithMetadata{State: state.DumpAccount{
+			Balance: "0",
+			Code:    common.Bytes2Hex(code),
+			Storage: map[common.Hash]string{{1}: storage},
+		}}
+	}
+	contractAccount := account(append([]byte{byte(vm.PUSH20)}, library.Bytes()...), "2a")
+	libraryAccount := account([]byte{1}, "01")
+
+	tests := []struct {
+		name            string
+		accounts        map[string]extension.AccountWithMetadata
+		approved        []common.Address
+		existingLibrary bool
+		expectSet       bool
+	}{
+		{"dependency shared", map[string]extension.AccountWithMetadata{address.Hex(): contractAccount, library.Hex(): libraryAccount}, []common.Address{library}, false, true},
+		{"dependency already present", map[string]extension.AccountWithMetadata{address.Hex(): contractAccount, library.Hex(): libraryAccount}, []common.Address{library}, true, true},
+		{"unreferenced account", map[string]extension.AccountWithMetadata{address.Hex(): contractAccount, library.Hex(): libraryAccount, other.Hex(): libraryAccount}, []common.Address{library, other}, false, false},
+		{"dependency not approved", map[string]extension.AccountWithMetadata{address.Hex(): contractAccount, library.Hex(): libraryAccount}, nil, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+			setApprovedDependencies(statedb, managementContract, tt.approved...)
+			if tt.existingLibrary {
+				statedb.SetCode(library, []byte{2})
+				statedb.SetState(library, common.Hash{1}, common.Hash{2})
+				statedb.SetPrivacyMetadata(library, &state.PrivacyMetadata{CreationTxHash: common.EncryptedPayloadHash{1}, PrivacyFlag: engine.PrivacyFlagPartyProtection})
+			}
+			stateData, _ := json.Marshal(tt.accounts)
+			ptm := &mockPrivateTransactionManager{
+				returns: map[string][]interface{}{
+					"IsSender":       {true, nil},
+					"DecryptPayload": {managementContract.Bytes(), nil, nil},
+				},
+				received: map[common.EncryptedPayloadHash][]interface{}{
+					common.BytesToEncryptedPayloadHash(common.FromHex(uuid)): {"psi1", nil, []byte(`{}`), nil, nil},
+					stateHash: {"psi1", []string{"mp1"}, stateData, &engine.ExtraMetadata{PrivacyFlag: engine.PrivacyFlagPartyProtection}, nil},
+				},
+			}
+			handler := NewExtensionHandler(ptm)
+			handler.SetPSMR(&mockPSMR{
+				returns: map[string][]interface{}{
+					"ResolveForManagedParty": {&mps.PrivateStateMetadata{ID: "psi1", Type: mps.Resident}, nil},
+				},
+			})
+			data, err := extension.ContractExtenderParsedABI.Events["StateShared"].Inputs.Pack(address, stateHash.ToBase64(), uuid)
+			assert.NoError(t, err)
+			stateShared := &types.Log{Address: managementContract, Topics: []common.Hash{common.HexToHash(extension.StateSharedTopicHash)}, Data: data}
+
+			handler.CheckExtensionAndSetPrivateState([]*types.Log{stateShared}, statedb, "psi1")
+
+			if !tt.expectSet {
+				assert.Nil(t, statedb.GetCode(address))
+				assert.Nil(t, statedb.GetCode(library))
+				return
+			}
+			assert.Equal(t, common.BytesToHash([]byte{0x2a}), statedb.GetState(address, common.Hash{1}))
+			metadata, err := statedb.GetPrivacyMetadata(library)
+			assert.NoError(t, err)
+			assert.Equal(t, stateHash, metadata.CreationTxHash)
+			if tt.existingLibrary {
+				assert.Equal(t, []byte{2}, statedb.GetCode(library), "the state of an existing dependency is kept")
+				assert.Equal(t, common.Hash{2}, statedb.GetState(library, common.Hash{1}))
+			} else {
+				assert.Equal(t, []byte{1}, statedb.GetCode(library))
+				assert.Equal(t, common.BytesToHash([]byte{1}), statedb.GetState(library, common.Hash{1}))
+			}
+		})
+	}
+}
+
+func TestExtensionHandler_CheckExtensionAndSetPrivateState_DependenciesOfExistingContract(t *testing.T) {
+	address := common.HexToAddress("0x8888888888888888888888888888888888888888")
+	library := common.HexToAddress("0x9999999999999999999999999999999999999999")
+	stateHash := common.EncryptedPayloadHash{9}
+
+	tests := []struct {
+		name         string
+		approved     []common.Address
+		expectedHash common.EncryptedPayloadHash
+	}{
+		{"dependency approved", []common.Address{library}, stateHash},
+		{"dependency not approved", nil, common.EncryptedPayloadHash{1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+			for _, contract := range []common.Address{address, library} {
+				statedb.SetCode(contract, []byte{1})
+				statedb.SetPrivacyMetadata(contract, &state.PrivacyMetadata{CreationTxHash: common.EncryptedPayloadHash{1}, PrivacyFlag: engine.PrivacyFlagPartyProtection})
+			}
+			setApprovedDependencies(statedb, common.Address{}, tt.approved...)
+			stateData, _ := json.Marshal(map[string]extension.AccountWithMetadata{
+				address.Hex(): {State: state.DumpAccount{Code: common.Bytes2Hex(append([]byte{byte(vm.PUSH20)}, library.Bytes()...))}},
+				library.Hex(): {State: state.DumpAccount{Code: "01"}},
+			})
+			ptm := &mockPrivateTransactionManager{
+				returns: map[string][]interface{}{"Receive": {"", []string{"mp1"}, stateData, nil, nil}},
+			}
+			handler := NewExtensionHandler(ptm)
+			data, err := extension.ContractExtenderParsedABI.Events["StateShared"].Inputs.Pack(address, stateHash.ToBase64(), "0xabcd")
+			assert.NoError(t, err)
+			stateShared := &types.Log{Topics: []common.Hash{common.HexToHash(extension.StateSharedTopicHash)}, Data: data}
+
+			handler.CheckExtensionAndSetPrivateState([]*types.Log{stateShared}, statedb, types.DefaultPrivateStateIdentifier)
+
+			for _, contract := range []common.Address{address, library} {
+				metadata, err := statedb.GetPrivacyMetadata(contract)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedHash, metadata.CreationTxHash)
+			}
+		})
+	}
+}
+
+// setApprovedDependencies stores the dependencies approved with an extension
+// the way the management contract does
+func setApprovedDependencies(statedb *state.StateDB, managementContract common.Address, dependencies ...common.Address) {
+	slot := common.BigToHash(big.NewInt(3))
+	statedb.SetState(managementContract, slot, common.BigToHash(big.NewInt(int64(len(dependencies)))))
+	start := crypto.Keccak256Hash(slot.Bytes()).Big()
+	for i, dependency := range dependencies {
+		statedb.SetState(managementContract, common.BigToHash(new(big.Int).Add(start, big.NewInt(int64(i)))), dependency.Hash())
+	}
+}
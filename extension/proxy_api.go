@@ -68,6 +68,27 @@ func (api *PrivateExtensionProxyAPI) ExtendContract(ctx context.Context, toExten
 	return result, err
 }
 
+func (api *PrivateExtensionProxyAPI) ExtendContractToRecipients(ctx context.Context, toExtend common.Address, recipientPtmPublicKeys []string, recipientAddrs []common.Address, dependencies []common.Address, txa ethapi.SendTxArgs) (string, error) {
+	log.Info("QLight - proxy enabled")
+	var result string
+	err := api.proxyClient.CallContext(ctx, &result, "quorumExtension_extendContractToRecipients", toExtend, recipientPtmPublicKeys, recipientAddrs, dependencies, txa)
+	return result, err
+}
+
+func (api *PrivateExtensionProxyAPI) RemoveParticipants(ctx context.Context, toRemoveFrom common.Address, removedPtmPublicKeys []string, txa ethapi.SendTxArgs) (string, error) {
+	log.Info("QLight - proxy enabled")
+	var result string
+	err := api.proxyClient.CallContext(ctx, &result, "quorumExtension_removeParticipants", toRemoveFrom, removedPtmPublicKeys, txa)
+	return result, err
+}
+
+func (api *PrivateExtensionProxyAPI) GetExtensionDependencies(ctx context.Context, toExtend common.Address) ([]ExtensionDependency, error) {
+	log.Info("QLight - proxy enabled")
+	var result []ExtensionDependency
+	err := api.proxyClient.CallContext(ctx, &result, "quorumExtension_getExtensionDependencies", toExtend)
+	return result, err
+}
+
 func (api *PrivateExtensionProxyAPI) CancelExtension(ctx context.Context, extensionContract common.Address, txa ethapi.SendTxArgs) (string, error) {
 	log.Info("QLight - proxy enabled")
 	var result string
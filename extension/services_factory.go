@@ -19,7 +19,7 @@ type ServicesFactory interface {
 type DefaultServicesFactory struct {
 	backendService *PrivacyService
 	accountManager *accounts.Manager
-	dataHandler    *JsonFileDataHandler
+	dataHandler    DataHandler
 	stateFetcher   *StateFetcher
 }
 
@@ -27,7 +27,7 @@ func NewServicesFactory(stack *node.Node, ptm private.PrivateTransactionManager,
 	factory := &DefaultServicesFactory{}
 
 	factory.accountManager = ethService.AccountManager()
-	factory.dataHandler = NewJsonFileDataHandler(stack.InstanceDir())
+	factory.dataHandler = NewDBDataHandler(ethService.ChainDb(), stack.InstanceDir())
 	factory.stateFetcher = NewStateFetcher(ethService.BlockChain())
 
 	backendService, err := New(stack, ptm, factory.AccountManager(), factory.DataHandler(), factory.StateFetcher(), ethService.APIBackend, ethService.BlockChain().Config())
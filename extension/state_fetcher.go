@@ -34,6 +34,15 @@ type APIBackendHelper interface {
 	IsPrivacyMarkerTransactionCreationEnabled() bool
 }
 
+// ExtensionDependency is a private contract referenced by the code or the
+// storage of an extended contract, or of one of its shared dependencies
+type ExtensionDependency struct {
+	Address      common.Address `json:"address"`
+	ReferencedBy common.Address `json:"referencedBy"`
+	Shared       bool           `json:"shared"`
+	Reason       string         `json:"reason,omitempty"`
+}
+
 // StateFetcher manages retrieving state from the database and returning it in
 // a usable form by the extension API.
 type StateFetcher struct {
@@ -53,14 +62,14 @@ func (fetcher *StateFetcher) getCurrentBlockHash() common.Hash {
 }
 
 // GetAddressStateFromBlock is a public method that combines the other
-// functions of a StateFetcher, retrieving the state of an address at a given
-// block, represented in JSON.
-func (fetcher *StateFetcher) GetAddressStateFromBlock(blockHash common.Hash, addressToFetch common.Address, psi types.PrivateStateIdentifier) ([]byte, error) {
+// functions of a StateFetcher, retrieving the state of an address, and of its
+// dependencies, at a given block, represented in JSON.
+func (fetcher *StateFetcher) GetAddressStateFromBlock(blockHash common.Hash, addressToFetch common.Address, psi types.PrivateStateIdentifier, dependencies ...common.Address) ([]byte, error) {
 	privateState, err := fetcher.privateState(blockHash, psi)
 	if err != nil {
 		return nil, err
 	}
-	stateData, err := fetcher.addressStateAsJson(privateState, addressToFetch)
+	stateData, err := fetcher.addressStateAsJson(privateState, append([]common.Address{addressToFetch}, dependencies...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -75,23 +84,83 @@ func (fetcher *StateFetcher) privateState(blockHash common.Hash, psi types.Priva
 	return privateState, err
 }
 
-// addressStateAsJson returns the state of an address, including the balance,
-// nonce, code and state data as a JSON map.
-func (fetcher *StateFetcher) addressStateAsJson(privateState *state.StateDB, addressToShare common.Address) ([]byte, error) {
+// addressStateAsJson returns the state of the addresses, including the
+// balance, nonce, code and state data as a JSON map.
+func (fetcher *StateFetcher) addressStateAsJson(privateState *state.StateDB, addressesToShare ...common.Address) ([]byte, error) {
 	keepAddresses := make(map[string]extensionContracts.AccountWithMetadata)
 
-	if account, found := privateState.DumpAddress(addressToShare); found {
+	for _, addressToShare := range addressesToShare {
+		account, found := privateState.DumpAddress(addressToShare)
+		if !found {
+			return nil, fmt.Errorf("error in contract state fetch")
+		}
 		keepAddresses[addressToShare.Hex()] = extensionContracts.AccountWithMetadata{
 			State: account,
 		}
-	} else {
-		return nil, fmt.Errorf("error in contract state fetch")
 	}
 	//types can be marshalled, so errors can't occur
 	out, _ := json.Marshal(&keepAddresses)
 	return out, nil
 }
 
+// GetDependencies returns the private contracts referenced by the contract at
+// a given block, directly or through other dependencies. A dependency is shared
+// unless the check function rejects it, the dependencies of a dependency which
+// is not shared are not followed.
+func (fetcher *StateFetcher) GetDependencies(blockHash common.Hash, address common.Address, psi types.PrivateStateIdentifier, check func(common.Address) error) ([]ExtensionDependency, error) {
+	privateState, err := fetcher.privateState(blockHash, psi)
+	if err != nil {
+		return nil, err
+	}
+	return fetcher.dependencies(privateState, address, check)
+}
+
+func (fetcher *StateFetcher) dependencies(privateState *state.StateDB, address common.Address, check func(common.Address) error) ([]ExtensionDependency, error) {
+	var dependencies []ExtensionDependency
+	seen := map[common.Address]bool{address: true}
+	queue := []common.Address{address}
+	shared := 0
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		account, found := privateState.DumpAddress(current)
+		if !found {
+			return nil, fmt.Errorf("error in contract state fetch")
+		}
+		for _, referenced := range (extensionContracts.AccountWithMetadata{State: account}).ReferencedAddresses() {
+			if seen[referenced] || len(privateState.GetCode(referenced)) == 0 {
+				continue
+			}
+			seen[referenced] = true
+			dependency := ExtensionDependency{Address: referenced, ReferencedBy: current, Shared: true}
+			if err := check(referenced); err != nil {
+				dependency.Shared, dependency.Reason = false, err.Error()
+			} else {
+				if shared++; shared > extensionContracts.MaxDependencies {
+					return nil, fmt.Errorf("contract has more than %d dependencies", extensionContracts.MaxDependencies)
+				}
+				queue = append(queue, referenced)
+			}
+			dependencies = append(dependencies, dependency)
+		}
+	}
+	return dependencies, nil
+}
+
+// GetApprovedDependencies returns the dependencies approved with an extension,
+// as recorded by its management contract at a given block.
+func (fetcher *StateFetcher) GetApprovedDependencies(blockHash common.Hash, managementContract common.Address, psi types.PrivateStateIdentifier) ([]common.Address, error) {
+	privateState, err := fetcher.privateState(blockHash, psi)
+	if err != nil {
+		return nil, err
+	}
+	approved, ok := extensionContracts.ApprovedDependencies(privateState, managementContract)
+	if !ok {
+		return nil, fmt.Errorf("invalid dependencies in management contract %s", managementContract.Hex())
+	}
+	return approved, nil
+}
+
 // returns the privacy metadata
 func (fetcher *StateFetcher) GetPrivacyMetaData(blockHash common.Hash, address common.Address, psi types.PrivateStateIdentifier) (*state.PrivacyMetadata, error) {
 	privateState, err := fetcher.privateState(blockHash, psi)
@@ -1,12 +1,17 @@
 package extension
 
 import (
+	"encoding/json"
+	"errors"
 	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/extension/extensionContracts"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestDumpAddressWhenFound(t *testing.T) {
@@ -47,3 +52,52 @@ func TestDumpAddressWhenNotFound(t *testing.T) {
 		t.Errorf("dump mismatch:\ngot: %s\nwant: nil\n", string(out))
 	}
 }
+
+func TestDependencies(t *testing.T) {
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	stateFetcher := NewStateFetcher(nil)
+
+	contract := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	library := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	registry := common.HexToAddress("0x4444444444444444444444444444444444444444")
+	rejected := common.HexToAddress("0x5555555555555555555555555555555555555555")
+	rejectedDependency := common.HexToAddress("0x6666666666666666666666666666666666666666")
+	publicContract := common.HexToAddress("0x7777777777777777777777777777777777777777")
+
+	push := func(addresses ...common.Address) []byte {
+		var code []byte
+		for _, address := range addresses {
+			code = append(append(code, byte(vm.PUSH20)), address.Bytes()...)
+		}
+		return code
+	}
+	// the contract calls a library and a contract which is not shared, the
+	// library reads the address of a registry from its storage
+	statedb.SetCode(contract, push(library, rejected, publicContract))
+	statedb.SetCode(library, push(contract))
+	statedb.SetState(library, common.Hash{1}, registry.Hash())
+	statedb.SetCode(registry, []byte{1})
+	statedb.SetCode(rejected, push(rejectedDependency))
+	statedb.SetCode(rejectedDependency, []byte{1})
+	statedb.Commit(false)
+
+	dependencies, err := stateFetcher.dependencies(statedb, contract, func(address common.Address) error {
+		if address == rejected {
+			return errors.New("rejected")
+		}
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []ExtensionDependency{
+		{Address: library, ReferencedBy: contract, Shared: true},
+		{Address: rejected, ReferencedBy: contract, Reason: "rejected"},
+		{Address: registry, ReferencedBy: library, Shared: true},
+	}, dependencies)
+
+	out, err := stateFetcher.addressStateAsJson(statedb, contract, library, registry)
+	assert.NoError(t, err)
+	var accounts map[string]extensionContracts.AccountWithMetadata
+	assert.NoError(t, json.Unmarshal(out, &accounts))
+	assert.Equal(t, []common.Address{contract, library, registry}, extensionContracts.DependencyClosure(contract, accounts))
+}
@@ -11,7 +11,10 @@ var (
 	newExtensionQuery = ethereum.FilterQuery{
 		FromBlock: nil,
 		ToBlock:   nil,
-		Topics:    [][]common.Hash{{common.HexToHash(extensionContracts.NewContractExtensionContractCreatedTopicHash)}},
+		Topics: [][]common.Hash{{
+			common.HexToHash(extensionContracts.NewContractExtensionContractCreatedTopicHash),
+			common.HexToHash(extensionContracts.LegacyNewContractExtensionContractCreatedTopicHash),
+		}},
 		Addresses: []common.Address{},
 	}
 
@@ -30,11 +33,35 @@ var (
 	}
 )
 
+// ExtensionContract is a management contract extending a contract to one or
+// more recipients. Recipient and RecipientPtmKey hold the first recipient,
+// they are the only recipient fields of the entries saved by earlier versions.
+// The block is the block of the creation event, unknown for the entries saved
+// by earlier versions.
 type ExtensionContract struct {
-	ContractExtended          common.Address `json:"contractExtended"`
-	Initiator                 common.Address `json:"initiator"`
-	Recipient                 common.Address `json:"recipient"`
-	ManagementContractAddress common.Address `json:"managementContractAddress"`
-	RecipientPtmKey           string         `json:"recipientPtmKey"`
-	CreationData              []byte         `json:"creationData"`
+	ContractExtended          common.Address       `json:"contractExtended"`
+	Initiator                 common.Address       `json:"initiator"`
+	Recipient                 common.Address       `json:"recipient"`
+	ManagementContractAddress common.Address       `json:"managementContractAddress"`
+	RecipientPtmKey           string               `json:"recipientPtmKey"`
+	Recipients                []ExtensionRecipient `json:"recipients,omitempty"`
+	CreationData              []byte               `json:"creationData"`
+	BlockNumber               uint64               `json:"blockNumber,omitempty"`
+	BlockHash                 common.Hash          `json:"blockHash,omitempty"`
+}
+
+// ExtensionRecipient is a party a contract is extended to, identified by the
+// account accepting the extension and the PTM key receiving the state
+type ExtensionRecipient struct {
+	Address common.Address `json:"address"`
+	PtmKey  string         `json:"ptmKey"`
+}
+
+// AllRecipients returns the recipients of the extension, including those of
+// the entries saved by earlier versions
+func (c *ExtensionContract) AllRecipients() []ExtensionRecipient {
+	if len(c.Recipients) == 0 && c.Recipient != (common.Address{}) {
+		return []ExtensionRecipient{{Address: c.Recipient, PtmKey: c.RecipientPtmKey}}
+	}
+	return c.Recipients
 }
@@ -1119,6 +1119,11 @@ func (r *Resolver) SendRawTransaction(ctx context.Context, args struct{ Data hex
 	if err := tx.UnmarshalBinary(args.Data); err != nil {
 		return common.Hash{}, err
 	}
+	// Quorum
+	if err := ethapi.CheckTransactionPolicy(ctx, r.backend, tx, tx.From(), nil); err != nil {
+		return common.Hash{}, err
+	}
+	// End Quorum
 	hash, err := ethapi.SubmitTransaction(ctx, r.backend, tx, "", true)
 	return hash, err
 }
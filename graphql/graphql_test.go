@@ -46,6 +46,7 @@ import (
 	"github.com/ethereum/go-ethereum/multitenancy"
 	"github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/plugin/txpolicy"
 	"github.com/ethereum/go-ethereum/private"
 	"github.com/ethereum/go-ethereum/private/engine"
 	"github.com/ethereum/go-ethereum/private/engine/notinuse"
@@ -811,6 +812,10 @@ func (sb *StubBackend) IsPrivacyMarkerTransactionCreationEnabled() bool {
 	panic("implement me")
 }
 
+func (sb *StubBackend) TransactionPolicy() txpolicy.TransactionPolicy {
+	return nil
+}
+
 func (sb *StubBackend) UnprotectedAllowed() bool {
 	panic("implement me")
 }
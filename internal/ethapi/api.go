@@ -51,6 +51,7 @@ import (
 	"github.com/ethereum/go-ethereum/multitenancy"
 	"github.com/ethereum/go-ethereum/p2p"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/plugin/txpolicy"
 	"github.com/ethereum/go-ethereum/private"
 	"github.com/ethereum/go-ethereum/private/engine"
 	"github.com/ethereum/go-ethereum/rlp"
@@ -431,7 +432,7 @@ func (s *PrivateAccountAPI) signTransaction(ctx context.Context, args *SendTxArg
 	}
 	// /Quorum
 
-	return wallet.SignTxWithPassphrase(account, passwd, tx, chainID)
+	return signTxWithPassphrase(wallet, account, passwd, tx, chainID, &args.PrivateTxArgs)
 }
 
 // SendTransaction will create a transaction from the given arguments and
@@ -451,6 +452,14 @@ func (s *PrivateAccountAPI) SendTransaction(ctx context.Context, args SendTxArgs
 	}
 
 	// Quorum
+	// evaluate the policy before the private payload is distributed
+	policyTx := args.toTransaction()
+	if args.IsPrivate() {
+		policyTx.SetPrivate()
+	}
+	if err := CheckTransactionPolicy(ctx, s.b, policyTx, args.From, &args.PrivateTxArgs); err != nil {
+		return common.Hash{}, err
+	}
 	_, replaceDataWithHash, data, err := checkAndHandlePrivateTransaction(ctx, s.b, args.toTransaction(), &args.PrivateTxArgs, args.From, NormalTransaction)
 	if err != nil {
 		return common.Hash{}, err
@@ -487,7 +496,7 @@ func (s *PrivateAccountAPI) SendTransaction(ctx context.Context, args SendTxArgs
 			pmtChainID = config.ChainID
 		}
 
-		signed, err = wallet.SignTxWithPassphrase(account, passwd, pmt, pmtChainID)
+		signed, err = signTxWithPassphrase(wallet, account, passwd, pmt, pmtChainID, &args.PrivateTxArgs)
 		if err != nil {
 			log.Warn("Failed to sign privacy marker transaction for private transaction", "from", args.From, "to", args.To, "value", args.Value.ToInt(), "err", err)
 			return common.Hash{}, err
@@ -1407,6 +1416,7 @@ type RPCTransaction struct {
 	Type             hexutil.Uint64    `json:"type"`
 	Accesses         *types.AccessList `json:"accessList,omitempty"`
 	ChainID          *hexutil.Big      `json:"chainId,omitempty"`
+	PrivacyFlag      *hexutil.Uint64   `json:"privacyFlag,omitempty"` // Quorum: typed private transactions only
 	V                *hexutil.Big      `json:"v"`
 	R                *hexutil.Big      `json:"r"`
 	S                *hexutil.Big      `json:"s"`
@@ -1420,7 +1430,7 @@ func newRPCTransaction(tx *types.Transaction, blockHash common.Hash, blockNumber
 	// transactions. For non-protected transactions, the homestead signer signer is used
 	// because the return value of ChainId is zero for those transactions.
 	var signer types.Signer
-	if tx.Protected() && !tx.IsPrivate() {
+	if tx.Protected() && !(tx.IsPrivate() && tx.Type() == types.LegacyTxType) {
 		signer = types.LatestSignerForChainID(tx.ChainId())
 	} else {
 		signer = types.HomesteadSigner{}
@@ -1447,11 +1457,17 @@ func newRPCTransaction(tx *types.Transaction, blockHash common.Hash, blockNumber
 		result.BlockNumber = (*hexutil.Big)(new(big.Int).SetUint64(blockNumber))
 		result.TransactionIndex = (*hexutil.Uint64)(&index)
 	}
-	if tx.Type() == types.AccessListTxType {
+	if tx.Type() == types.AccessListTxType || tx.Type() == types.PrivateTxType {
 		al := tx.AccessList()
 		result.Accesses = &al
 		result.ChainID = (*hexutil.Big)(tx.ChainId())
 	}
+	// Quorum
+	if tx.Type() == types.PrivateTxType {
+		privacyFlag := hexutil.Uint64(tx.PrivacyFlag())
+		result.PrivacyFlag = &privacyFlag
+	}
+	// End Quorum
 	return result
 }
 
@@ -1883,8 +1899,9 @@ func (s *PublicTransactionPoolAPI) GetPrivateTransactionReceipt(ctx context.Cont
 }
 
 // Quorum: if signing a private TX, set with tx.SetPrivate() before calling this method.
+// privateTxArgs are given to wallets implementing accounts.PrivateTxWallet, nil if unknown.
 // sign is a helper function that signs a transaction with the private key of the given address.
-func (s *PublicTransactionPoolAPI) sign(addr common.Address, tx *types.Transaction) (*types.Transaction, error) {
+func (s *PublicTransactionPoolAPI) sign(addr common.Address, tx *types.Transaction, privateTxArgs *PrivateTxArgs) (*types.Transaction, error) {
 	// Look up the wallet containing the requested signer
 	account := accounts.Account{Address: addr}
 
@@ -1901,7 +1918,7 @@ func (s *PublicTransactionPoolAPI) sign(addr common.Address, tx *types.Transacti
 	// /Quorum
 
 	// Request the wallet to sign the transaction
-	return wallet.SignTx(account, tx, chainID)
+	return signTx(wallet, account, tx, chainID, privateTxArgs)
 }
 
 // SendTxArgs represents the arguments to sumbit a new transaction into the transaction pool.
@@ -1951,6 +1968,15 @@ type PrivateTxArgs struct {
 	MandatoryRecipients []string               `json:"mandatoryFor"`
 }
 
+func (args *PrivateTxArgs) toPrivateTxInfo() *accounts.PrivateTxInfo {
+	return &accounts.PrivateTxInfo{
+		PrivateFrom:         args.PrivateFrom,
+		PrivateFor:          args.PrivateFor,
+		PrivacyFlag:         args.PrivacyFlag,
+		MandatoryRecipients: args.MandatoryRecipients,
+	}
+}
+
 func (args *PrivateTxArgs) SetDefaultPrivateFrom(ctx context.Context, b Backend) error {
 	if args.PrivateFor != nil && len(args.PrivateFrom) == 0 && b.ChainConfig().IsMPS {
 		psm, err := b.PSMR().ResolveForUserContext(ctx)
@@ -2105,7 +2131,7 @@ func SubmitTransaction(ctx context.Context, b Backend, tx *types.Transaction, pr
 	// Print a log with full tx details for manual investigations and interventions
 	// Quorum
 	var signer types.Signer
-	if tx.IsPrivate() {
+	if tx.IsPrivate() && tx.Type() == types.LegacyTxType {
 		signer = types.QuorumPrivateTxSigner{}
 	} else {
 		signer = types.MakeSigner(b.ChainConfig(), b.CurrentBlock().Number())
@@ -2253,6 +2279,15 @@ func (s *PublicTransactionPoolAPI) SendTransaction(ctx context.Context, args Sen
 		return common.Hash{}, err
 	}
 
+	// Quorum
+	// evaluate the policy before the private payload is distributed
+	policyTx := args.toTransaction()
+	if args.IsPrivate() {
+		policyTx.SetPrivate()
+	}
+	if err := CheckTransactionPolicy(ctx, s.b, policyTx, args.From, &args.PrivateTxArgs); err != nil {
+		return common.Hash{}, err
+	}
 	_, replaceDataWithHash, data, err := checkAndHandlePrivateTransaction(ctx, s.b, args.toTransaction(), &args.PrivateTxArgs, args.From, NormalTransaction)
 	if err != nil {
 		return common.Hash{}, err
@@ -2277,7 +2312,7 @@ func (s *PublicTransactionPoolAPI) SendTransaction(ctx context.Context, args Sen
 	}
 	// /Quorum
 
-	signed, err := wallet.SignTx(account, tx, chainID)
+	signed, err := signTx(wallet, account, tx, chainID, &args.PrivateTxArgs)
 	if err != nil {
 		return common.Hash{}, err
 	}
@@ -2294,7 +2329,7 @@ func (s *PublicTransactionPoolAPI) SendTransaction(ctx context.Context, args Sen
 			pmtChainID = config.ChainID
 		}
 
-		signed, err = wallet.SignTx(account, pmt, pmtChainID)
+		signed, err = signTx(wallet, account, pmt, pmtChainID, &args.PrivateTxArgs)
 		if err != nil {
 			log.Warn("Failed to sign privacy marker transaction for private transaction", "from", args.From, "to", args.To, "value", args.Value.ToInt(), "err", err)
 			return common.Hash{}, err
@@ -2344,9 +2379,59 @@ func (s *PublicTransactionPoolAPI) SendRawTransaction(ctx context.Context, input
 	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
 	}
+	// Quorum
+	if err := CheckTransactionPolicy(ctx, s.b, tx, tx.From(), nil); err != nil {
+		return common.Hash{}, err
+	}
+	if tx.Type() == types.PrivateTxType {
+		privateFrom, err := checkTypedPrivateTransaction(s.b, tx)
+		if err != nil {
+			return common.Hash{}, err
+		}
+		return SubmitTransaction(ctx, s.b, tx, privateFrom, true)
+	}
+	// End Quorum
 	return SubmitTransaction(ctx, s.b, tx, "", true)
 }
 
+// Quorum
+//
+// checkTypedPrivateTransaction makes sure the encrypted payload referenced by a typed private
+// transaction has already been distributed by the private transaction manager, with the same
+// privacy flag as the one signed in the transaction. It returns the sender of the payload
+func checkTypedPrivateTransaction(b Backend, tx *types.Transaction) (string, error) {
+	if err := checkPrivateTxTypeEnabled(b, tx); err != nil {
+		return "", err
+	}
+	if !private.IsQuorumPrivacyEnabled() {
+		return "", engine.ErrPrivateTxManagerNotinUse
+	}
+	if len(tx.Data()) != common.EncryptedPayloadHashLength {
+		return "", core.ErrInvalidPrivatePayloadHash
+	}
+	privateFrom, _, payload, extra, err := private.P.Receive(common.BytesToEncryptedPayloadHash(tx.Data()))
+	if err != nil {
+		return "", err
+	}
+	if len(payload) == 0 {
+		return "", fmt.Errorf("encrypted payload %x is unknown to the private transaction manager", tx.Data())
+	}
+	if extra == nil || extra.PrivacyFlag != tx.PrivacyFlag() {
+		return "", fmt.Errorf("privacy flag of the transaction does not match the one of the encrypted payload")
+	}
+	return privateFrom, nil
+}
+
+// Quorum
+//
+// checkPrivateTxTypeEnabled rejects typed private transactions until the chain accepts them
+func checkPrivateTxTypeEnabled(b Backend, tx *types.Transaction) error {
+	if tx.Type() == types.PrivateTxType && !b.ChainConfig().IsPrivateTxTypeEnabled(b.CurrentBlock().Number()) {
+		return core.ErrTxTypeNotSupported
+	}
+	return nil
+}
+
 // Quorum
 //
 // SendRawPrivateTransaction will add the signed transaction to the transaction pool.
@@ -2358,9 +2443,18 @@ func (s *PublicTransactionPoolAPI) SendRawPrivateTransaction(ctx context.Context
 	}
 
 	// Quorum
+	if err := checkPrivateTxTypeEnabled(s.b, tx); err != nil {
+		return common.Hash{}, err
+	}
+	if tx.Type() == types.PrivateTxType && args.PrivacyFlag != tx.PrivacyFlag() {
+		return common.Hash{}, fmt.Errorf("privacyFlag %d does not match the one signed in the transaction (%d)", args.PrivacyFlag, tx.PrivacyFlag())
+	}
 	if err := args.SetRawTransactionPrivateFrom(ctx, s.b, tx); err != nil {
 		return common.Hash{}, err
 	}
+	if err := CheckTransactionPolicy(ctx, s.b, tx, tx.From(), &args.PrivateTxArgs); err != nil {
+		return common.Hash{}, err
+	}
 	isPrivate, _, _, err := checkAndHandlePrivateTransaction(ctx, s.b, tx, &args.PrivateTxArgs, tx.From(), RawTransaction)
 	if err != nil {
 		return common.Hash{}, err
@@ -2380,13 +2474,19 @@ func (s *PublicTransactionPoolAPI) DistributePrivateTransaction(ctx context.Cont
 	log.Info("distributing raw private tx")
 
 	tx := new(types.Transaction)
-	if err := rlp.DecodeBytes(encodedTx, tx); err != nil {
+	if err := tx.UnmarshalBinary(encodedTx); err != nil {
 		return "", err
 	}
 
 	log.Debug("deserialised raw private tx", "hash", tx.Hash())
 
 	// Quorum
+	if err := checkPrivateTxTypeEnabled(s.b, tx); err != nil {
+		return "", err
+	}
+	if tx.Type() == types.PrivateTxType && args.PrivacyFlag != tx.PrivacyFlag() {
+		return "", fmt.Errorf("privacyFlag %d does not match the one signed in the transaction (%d)", args.PrivacyFlag, tx.PrivacyFlag())
+	}
 	if err := args.SetRawTransactionPrivateFrom(ctx, s.b, tx); err != nil {
 		return "", err
 	}
@@ -2479,7 +2579,7 @@ func (s *PublicTransactionPoolAPI) SignTransaction(ctx context.Context, args Sen
 	}
 	// End Quorum
 
-	tx, err := s.sign(args.From, toSign)
+	tx, err := s.sign(args.From, toSign, &args.PrivateTxArgs)
 	if err != nil {
 		return nil, err
 	}
@@ -2563,10 +2663,15 @@ func (s *PublicTransactionPoolAPI) Resend(ctx context.Context, sendArgs SendTxAr
 			if sendArgs.IsPrivate() {
 				newTx.SetPrivate()
 			}
-			signedTx, err := s.sign(sendArgs.From, newTx)
+			signedTx, err := s.sign(sendArgs.From, newTx, &sendArgs.PrivateTxArgs)
 			if err != nil {
 				return common.Hash{}, err
 			}
+			// Quorum
+			if err := CheckTransactionPolicy(ctx, s.b, signedTx, sendArgs.From, &sendArgs.PrivateTxArgs); err != nil {
+				return common.Hash{}, err
+			}
+			// End Quorum
 			if err = s.b.SendTx(ctx, signedTx); err != nil {
 				return common.Hash{}, err
 			}
@@ -2951,6 +3056,50 @@ func (s *PublicBlockChainAPI) DecryptQuorumPayload(ctx context.Context, payloadH
 	}, nil
 }
 
+// Quorum
+// CheckTransactionPolicy evaluates a transaction submitted via the RPC API against the transaction policy, if any.
+// Private transaction arguments are passed to the policy when privateFor is given. It is called once per
+// submission, before the private payload is distributed and the transaction is added to the pool.
+func CheckTransactionPolicy(ctx context.Context, b Backend, tx *types.Transaction, from common.Address, privateTxArgs *PrivateTxArgs) error {
+	policy := b.TransactionPolicy()
+	if policy == nil {
+		return nil
+	}
+	req := &txpolicy.EvaluationRequest{
+		Source:      txpolicy.SourceRPC,
+		Transaction: tx,
+		From:        from,
+	}
+	if privateTxArgs != nil && privateTxArgs.PrivateFor != nil {
+		req.PrivateArgs = &txpolicy.PrivateArgs{
+			PrivateFrom:  privateTxArgs.PrivateFrom,
+			PrivateFor:   privateTxArgs.PrivateFor,
+			PrivacyFlag:  uint64(privateTxArgs.PrivacyFlag),
+			MandatoryFor: privateTxArgs.MandatoryRecipients,
+		}
+	}
+	return txpolicy.Check(ctx, policy, req)
+}
+
+// Quorum
+// signTx requests the wallet to sign tx. Wallets implementing accounts.PrivateTxWallet are also given
+// the privacy details when tx is a private transaction, or the privacy marker transaction of one.
+func signTx(wallet accounts.Wallet, account accounts.Account, tx *types.Transaction, chainID *big.Int, privateTxArgs *PrivateTxArgs) (*types.Transaction, error) {
+	if w, ok := wallet.(accounts.PrivateTxWallet); ok && privateTxArgs != nil && privateTxArgs.PrivateFor != nil {
+		return w.SignPrivateTx(account, tx, chainID, privateTxArgs.toPrivateTxInfo())
+	}
+	return wallet.SignTx(account, tx, chainID)
+}
+
+// Quorum
+// signTxWithPassphrase is identical to signTx, but also takes a password
+func signTxWithPassphrase(wallet accounts.Wallet, account accounts.Account, passwd string, tx *types.Transaction, chainID *big.Int, privateTxArgs *PrivateTxArgs) (*types.Transaction, error) {
+	if w, ok := wallet.(accounts.PrivateTxWallet); ok && privateTxArgs != nil && privateTxArgs.PrivateFor != nil {
+		return w.SignPrivateTxWithPassphrase(account, passwd, tx, chainID, privateTxArgs.toPrivateTxInfo())
+	}
+	return wallet.SignTxWithPassphrase(account, passwd, tx, chainID)
+}
+
 // Quorum
 // for raw private transaction, privateTxArgs.privateFrom will be updated with value from Tessera when payload is retrieved
 func checkAndHandlePrivateTransaction(ctx context.Context, b Backend, tx *types.Transaction, privateTxArgs *PrivateTxArgs, from common.Address, txnType TransactionType) (isPrivate bool, replaceDataWithHash bool, hash common.EncryptedPayloadHash, err error) {
@@ -3054,7 +3203,19 @@ func handleRawPrivateTransaction(ctx context.Context, b Backend, tx *types.Trans
 
 	privateTxArgs.PrivateFrom = privateFrom
 	var privateTx *types.Transaction
-	if tx.To() == nil {
+	if tx.Type() == types.PrivateTxType {
+		// the access list of typed private transactions is applied to the simulation
+		privateTx = types.NewTx(&types.AccessListTx{
+			ChainID:    tx.ChainId(),
+			Nonce:      tx.Nonce(),
+			GasPrice:   tx.GasPrice(),
+			Gas:        tx.Gas(),
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       privatePayload,
+			AccessList: tx.AccessList(),
+		})
+	} else if tx.To() == nil {
 		privateTx = types.NewContractCreation(tx.Nonce(), tx.Value(), tx.Gas(), tx.GasPrice(), privatePayload)
 	} else {
 		privateTx = types.NewTransaction(tx.Nonce(), *tx.To(), tx.Value(), tx.Gas(), tx.GasPrice(), privatePayload)
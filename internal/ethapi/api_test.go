@@ -29,6 +29,7 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/multitenancy"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/plugin/txpolicy"
 	"github.com/ethereum/go-ethereum/private"
 	"github.com/ethereum/go-ethereum/private/engine"
 	"github.com/ethereum/go-ethereum/private/engine/notinuse"
@@ -525,6 +526,74 @@ func TestHandlePrivateTransaction_whenRawStandardPrivateCreation(t *testing.T) {
 	assert.True(isPrivate, "must be a private transaction")
 }
 
+func newTypedPrivateTransaction(privacyFlag engine.PrivacyFlagType) *types.Transaction {
+	return types.NewTx(&types.PrivateTx{
+		ChainID:     big.NewInt(10),
+		Gas:         hexutil.MustDecodeUint64("0x47b760"),
+		GasPrice:    big.NewInt(0),
+		To:          &arbitraryStandardPrivateSimpleStorageContractAddress,
+		Value:       big.NewInt(0),
+		Data:        arbitrarySimpleStorageContractEncryptedPayloadHash.Bytes(),
+		PrivacyFlag: privacyFlag,
+		AccessList:  types.AccessList{{Address: arbitraryStandardPrivateSimpleStorageContractAddress}},
+	})
+}
+
+func TestHandlePrivateTransaction_whenRawTypedPrivateMessageCall(t *testing.T) {
+	assert := assert.New(t)
+	private.P = &StubPrivateTransactionManager{creation: false}
+	privateTxArgs.PrivacyFlag = engine.PrivacyFlagStandardPrivate
+
+	isPrivate, _, _, err := checkAndHandlePrivateTransaction(arbitraryCtx, &StubBackend{}, newTypedPrivateTransaction(engine.PrivacyFlagStandardPrivate), privateTxArgs, arbitraryFrom, RawTransaction)
+
+	assert.NoError(err, "raw typed private msg call succeeded")
+	assert.True(isPrivate, "must be a private transaction")
+}
+
+func TestSendRawPrivateTransaction_whenPrivacyFlagDiffersFromTypedPrivateTransaction(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	tx, err := types.SignTx(newTypedPrivateTransaction(engine.PrivacyFlagStateValidation), types.NewEIP2930Signer(big.NewInt(10)), key)
+	require.NoError(t, err)
+	encodedTx, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	public := NewPublicTransactionPoolAPI(&StubBackend{}, nil)
+
+	_, err = public.SendRawPrivateTransaction(arbitraryCtx, encodedTx, SendRawTxArgs{PrivateTxArgs: PrivateTxArgs{PrivateFor: []string{"R1"}}})
+
+	assert.EqualError(t, err, "privacyFlag 0 does not match the one signed in the transaction (3)")
+}
+
+func TestSendRawTransaction_whenPrivateTxTypeNotEnabled(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	tx, err := types.SignTx(newTypedPrivateTransaction(engine.PrivacyFlagStandardPrivate), types.NewEIP2930Signer(big.NewInt(10)), key)
+	require.NoError(t, err)
+	encodedTx, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	public := NewPublicTransactionPoolAPI(&StubBackend{privateTxTypeDisabled: true}, nil)
+
+	_, err = public.SendRawTransaction(arbitraryCtx, encodedTx)
+	assert.Equal(t, core.ErrTxTypeNotSupported, err)
+
+	_, err = public.SendRawPrivateTransaction(arbitraryCtx, encodedTx, SendRawTxArgs{PrivateTxArgs: PrivateTxArgs{PrivateFor: []string{"R1"}}})
+	assert.Equal(t, core.ErrTxTypeNotSupported, err)
+}
+
+func TestNewRPCTransaction_whenTypedPrivateTransaction(t *testing.T) {
+	assert := assert.New(t)
+	key, _ := crypto.GenerateKey()
+	tx, err := types.SignTx(newTypedPrivateTransaction(engine.PrivacyFlagPartyProtection), types.NewEIP2930Signer(big.NewInt(10)), key)
+	require.NoError(t, err)
+
+	rpcTx := newRPCPendingTransaction(tx)
+
+	assert.Equal(crypto.PubkeyToAddress(key.PublicKey), rpcTx.From)
+	assert.Equal(hexutil.Uint64(types.PrivateTxType), rpcTx.Type)
+	assert.Equal(big.NewInt(10), rpcTx.ChainID.ToInt())
+	assert.Equal(tx.AccessList(), *rpcTx.Accesses)
+	require.NotNil(t, rpcTx.PrivacyFlag)
+	assert.Equal(hexutil.Uint64(engine.PrivacyFlagPartyProtection), *rpcTx.PrivacyFlag)
+}
+
 func TestHandlePrivateTransaction_whenRawStandardPrivateMessageCall(t *testing.T) {
 	assert := assert.New(t)
 	private.P = &StubPrivateTransactionManager{creation: false}
@@ -715,6 +784,110 @@ func TestSubmitPrivateTransaction(t *testing.T) {
 	assert.Equal(uint64(123), stbBackend.txThatWasSent.Nonce(), "incorrect nonce on transaction")
 }
 
+type StubTransactionPolicy struct {
+	req *txpolicy.EvaluationRequest
+}
+
+func (p *StubTransactionPolicy) Evaluate(_ context.Context, req *txpolicy.EvaluationRequest) (*txpolicy.Decision, error) {
+	p.req = req
+	return &txpolicy.Decision{Allowed: false, Reason: "arbitrary reason"}, nil
+}
+
+func TestSendTransaction_whenDeniedByTransactionPolicy(t *testing.T) {
+	assert := assert.New(t)
+
+	keystore, fromAcct, toAcct := createKeystore(t)
+
+	policy := &StubTransactionPolicy{}
+	stbBackend := &StubBackend{}
+	stbBackend.ks = keystore
+	stbBackend.accountManager = accounts.NewManager(&accounts.Config{InsecureUnlockAllowed: true}, stbBackend)
+	stbBackend.txPolicy = policy
+
+	txPoolAPI := NewPublicTransactionPoolAPI(stbBackend, new(AddrLocker))
+
+	gas := hexutil.Uint64(999999)
+	nonce := hexutil.Uint64(123)
+	payload := hexutil.Bytes([]byte("arbitrary payload"))
+	txArgs := SendTxArgs{
+		PrivateTxArgs: PrivateTxArgs{PrivateFor: []string{"arbitrary for"}, PrivacyFlag: engine.PrivacyFlagPartyProtection},
+		From:          fromAcct.Address,
+		To:            &toAcct.Address,
+		Gas:           &gas,
+		Nonce:         &nonce,
+		Data:          &payload,
+	}
+
+	_, err := txPoolAPI.SendTransaction(arbitraryCtx, txArgs)
+
+	assert.EqualError(err, "transaction denied by policy: arbitrary reason")
+	assert.False(stbBackend.sendTxCalled, "transaction must not be sent")
+	assert.Equal(txpolicy.SourceRPC, policy.req.Source)
+	assert.Equal(fromAcct.Address, policy.req.From)
+	assert.True(policy.req.Transaction.IsPrivate())
+	assert.Equal([]byte("arbitrary payload"), policy.req.Transaction.Data())
+	assert.Equal(&txpolicy.PrivateArgs{PrivateFor: []string{"arbitrary for"}, PrivacyFlag: uint64(engine.PrivacyFlagPartyProtection)}, policy.req.PrivateArgs)
+}
+
+func TestSendRawTransaction_whenDeniedByTransactionPolicy(t *testing.T) {
+	assert := assert.New(t)
+
+	key, _ := crypto.GenerateKey()
+	tx, err := types.SignTx(types.NewTransaction(0, common.Address{1}, big.NewInt(0), 21000, big.NewInt(0), nil), types.HomesteadSigner{}, key)
+	assert.NoError(err)
+	input, err := tx.MarshalBinary()
+	assert.NoError(err)
+
+	policy := &StubTransactionPolicy{}
+	stbBackend := &StubBackend{txPolicy: policy}
+	txPoolAPI := NewPublicTransactionPoolAPI(stbBackend, new(AddrLocker))
+
+	_, err = txPoolAPI.SendRawTransaction(arbitraryCtx, input)
+
+	assert.EqualError(err, "transaction denied by policy: arbitrary reason")
+	assert.False(stbBackend.sendTxCalled, "transaction must not be sent")
+	assert.Equal(crypto.PubkeyToAddress(key.PublicKey), policy.req.From)
+	assert.Equal(tx.Hash(), policy.req.Transaction.Hash())
+	assert.Nil(policy.req.PrivateArgs)
+}
+
+// privateTxWallet records the privacy details given when signing
+type privateTxWallet struct {
+	accounts.Wallet
+	gotInfo *accounts.PrivateTxInfo
+}
+
+func (w *privateTxWallet) SignPrivateTx(_ accounts.Account, tx *types.Transaction, _ *big.Int, info *accounts.PrivateTxInfo) (*types.Transaction, error) {
+	w.gotInfo = info
+	return tx, nil
+}
+
+func (w *privateTxWallet) SignPrivateTxWithPassphrase(account accounts.Account, _ string, tx *types.Transaction, chainID *big.Int, info *accounts.PrivateTxInfo) (*types.Transaction, error) {
+	return w.SignPrivateTx(account, tx, chainID, info)
+}
+
+func TestSignTx_whenPrivateTxWallet(t *testing.T) {
+	assert := assert.New(t)
+	wallet := &privateTxWallet{}
+	tx := types.NewTransaction(0, common.Address{}, nil, 0, nil, nil)
+	tx.SetPrivate()
+
+	_, err := signTx(wallet, accounts.Account{}, tx, nil, &PrivateTxArgs{
+		PrivateFrom:         arbitraryPrivateFrom,
+		PrivateFor:          arbitraryPrivateFor,
+		PrivacyFlag:         engine.PrivacyFlagMandatoryRecipients,
+		MandatoryRecipients: arbitraryMandatoryFor,
+	})
+
+	assert.NoError(err)
+	assert.Equal(&accounts.PrivateTxInfo{
+		PrivateFrom:         arbitraryPrivateFrom,
+		PrivateFor:          arbitraryPrivateFor,
+		PrivacyFlag:         engine.PrivacyFlagMandatoryRecipients,
+		MandatoryRecipients: arbitraryMandatoryFor,
+	}, wallet.gotInfo)
+}
+
 func TestSubmitPrivateTransactionWithPrivacyMarkerEnabled(t *testing.T) {
 	assert := assert.New(t)
 
@@ -902,6 +1075,8 @@ type StubBackend struct {
 	ks                                        *keystore.KeyStore
 	poolNonce                                 uint64
 	allowUnprotectedTxs                       bool
+	txPolicy                                  txpolicy.TransactionPolicy
+	privateTxTypeDisabled                     bool
 
 	IstanbulBlock     *big.Int
 	CurrentHeadNumber *big.Int
@@ -1116,7 +1291,12 @@ func (sb *StubBackend) SubscribeRemovedLogsEvent(ch chan<- core.RemovedLogsEvent
 }
 
 func (sb *StubBackend) ChainConfig() *params.ChainConfig {
-	return params.QuorumTestChainConfig
+	if sb.privateTxTypeDisabled {
+		return params.QuorumTestChainConfig
+	}
+	config := *params.QuorumTestChainConfig
+	config.PrivateTxTypeBlock = common.Big0
+	return &config
 }
 
 func (sb *StubBackend) SubscribePendingLogsEvent(ch chan<- []*types.Log) event.Subscription {
@@ -1144,6 +1324,10 @@ func (sb *StubBackend) IsPrivacyMarkerTransactionCreationEnabled() bool {
 	return sb.isPrivacyMarkerTransactionCreationEnabled
 }
 
+func (sb *StubBackend) TransactionPolicy() txpolicy.TransactionPolicy {
+	return sb.txPolicy
+}
+
 type StubMinimalApiState struct {
 }
 
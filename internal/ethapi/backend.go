@@ -34,6 +34,7 @@ import (
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/plugin/txpolicy"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/jpmorganchase/quorum-security-plugin-sdk-go/proto"
 )
@@ -99,6 +100,8 @@ type Backend interface {
 	SupportsMultitenancy(rpcCtx context.Context) (*proto.PreAuthenticatedAuthenticationToken, bool)
 	// IsPrivacyMarkerTransactionCreationEnabled returns true if privacy marker transactions are enabled and should be created
 	IsPrivacyMarkerTransactionCreationEnabled() bool
+	// TransactionPolicy returns the policy transactions must satisfy before being submitted, nil if there is none
+	TransactionPolicy() txpolicy.TransactionPolicy
 }
 
 func GetAPIs(apiBackend Backend) []rpc.API {
@@ -19,3 +19,15 @@ var (
 
 	ErrNotSupported = errors.New("not supported")
 )
+
+// CallErrorFunc translates the error of a call to a plugin, e.g. to report that the
+// plugin process exited during the call
+type CallErrorFunc func(err error) error
+
+// Apply returns err translated by f, or err unchanged if f is nil
+func (f CallErrorFunc) Apply(err error) error {
+	if f == nil {
+		return err
+	}
+	return f(err)
+}
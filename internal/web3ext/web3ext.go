@@ -916,7 +916,8 @@ web3._extend({
                new web3._extend.Method({
                        name: 'addPeer',
                        call: 'raft_addPeer',
-                       params: 1
+                       params: 2,
+                       inputFormatter: [null, null]
                }),
                new web3._extend.Method({
                        name: 'addLearner',
@@ -926,13 +927,35 @@ web3._extend({
                new web3._extend.Method({
                        name: 'promoteToPeer',
                        call: 'raft_promoteToPeer',
-                       params: 1
+                       params: 2,
+                       inputFormatter: [null, null]
                }),
                new web3._extend.Method({
                        name: 'removePeer',
                        call: 'raft_removePeer',
+                       params: 2,
+                       inputFormatter: [null, null]
+               }),
+               new web3._extend.Method({
+                       name: 'planMembershipChange',
+                       call: 'raft_planMembershipChange',
+                       params: 2
+               }),
+               new web3._extend.Method({
+                       name: 'transferLeadership',
+                       call: 'raft_transferLeadership',
                        params: 1
                }),
+               new web3._extend.Method({
+                       name: 'drain',
+                       call: 'raft_drain',
+                       params: 0
+               }),
+               new web3._extend.Method({
+                       name: 'resume',
+                       call: 'raft_resume',
+                       params: 0
+               }),
                new web3._extend.Property({
                        name: 'leader',
                        getter: 'raft_leader'
@@ -1076,6 +1099,64 @@ web3._extend({
                        params: 4,
                        inputFormatter: [null, null, null, null]
                }),
+               new web3._extend.Method({
+                       name: 'setAccessRule',
+                       call: 'quorumPermission_setAccessRule',
+                       params: 7,
+                       inputFormatter: [null,null,web3._extend.formatters.inputAddressFormatter,null,null,web3._extend.utils.fromDecimal,web3._extend.formatters.inputTransactionFormatter]
+               }),
+               new web3._extend.Method({
+                       name: 'removeAccessRule',
+                       call: 'quorumPermission_removeAccessRule',
+                       params: 5,
+                       inputFormatter: [null,null,web3._extend.formatters.inputAddressFormatter,null,web3._extend.formatters.inputTransactionFormatter]
+               }),
+               new web3._extend.Method({
+                       name: 'getAccountHistory',
+                       call: 'quorumPermission_getAccountHistory',
+                       params: 1,
+                       inputFormatter: [web3._extend.formatters.inputAddressFormatter]
+               }),
+               new web3._extend.Method({
+                       name: 'getOrgHistory',
+                       call: 'quorumPermission_getOrgHistory',
+                       params: 1,
+                       inputFormatter: [null]
+               }),
+               new web3._extend.Method({
+                       name: 'getPermissionsAt',
+                       call: 'quorumPermission_getPermissionsAt',
+                       params: 1,
+                       inputFormatter: [web3._extend.utils.fromDecimal]
+               }),
+               new web3._extend.Method({
+                       name: 'checkCacheConsistency',
+                       call: 'quorumPermission_checkCacheConsistency',
+                       params: 0
+               }),
+               new web3._extend.Method({
+                       name: 'proposeAction',
+                       call: 'quorumPermission_proposeAction',
+                       params: 7,
+                       inputFormatter: [null,null,null,web3._extend.formatters.inputAddressFormatter,null,null,web3._extend.formatters.inputTransactionFormatter]
+               }),
+               new web3._extend.Method({
+                       name: 'voteAction',
+                       call: 'quorumPermission_voteAction',
+                       params: 2,
+                       inputFormatter: [null,web3._extend.formatters.inputTransactionFormatter]
+               }),
+               new web3._extend.Method({
+                       name: 'cancelAction',
+                       call: 'quorumPermission_cancelAction',
+                       params: 2,
+                       inputFormatter: [null,web3._extend.formatters.inputTransactionFormatter]
+               }),
+               new web3._extend.Method({
+                       name: 'pendingActions',
+                       call: 'quorumPermission_pendingActions',
+                       params: 0
+               }),
 
        ],
        properties:
@@ -1096,6 +1177,10 @@ web3._extend({
 					   name: 'acctList',
 				       getter: 'quorumPermission_acctList'
 			  }),
+              new web3._extend.Property({
+					   name: 'accessRuleList',
+				       getter: 'quorumPermission_accessRuleList'
+			  }),
        ]
 })
 `
@@ -1161,6 +1246,12 @@ web3._extend({
 			params: 1,
             inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
 		}),
+		new web3._extend.Method({
+			name: 'getFinalityProof',
+			call: 'istanbul_getFinalityProof',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter]
+		}),
 
 	],
 	properties:
@@ -1173,6 +1264,10 @@ web3._extend({
 			name: 'nodeAddress',
 			getter: 'istanbul_nodeAddress'
 		}),
+		new web3._extend.Property({
+			name: 'blsPublicKey',
+			getter: 'istanbul_blsPublicKey'
+		}),
 	]
 });
 `
@@ -1269,6 +1364,24 @@ web3._extend({
 			params: 4,
 			inputFormatter: [web3._extend.formatters.inputAddressFormatter, null, web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputTransactionFormatter]
 		}),
+		new web3._extend.Method({
+			name: 'extendContractToRecipients',
+			call: 'quorumExtension_extendContractToRecipients',
+			params: 5,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter, null, null, null, web3._extend.formatters.inputTransactionFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getExtensionDependencies',
+			call: 'quorumExtension_getExtensionDependencies',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'removeParticipants',
+			call: 'quorumExtension_removeParticipants',
+			params: 3,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter, null, web3._extend.formatters.inputTransactionFormatter]
+		}),
 		new web3._extend.Method({
 			name: 'cancelExtension',
 			call: 'quorumExtension_cancelExtension',
@@ -1286,6 +1399,12 @@ web3._extend({
 			params: 1,
 			inputFormatter: [web3._extend.formatters.inputAddressFormatter]
 		}),
+		new web3._extend.Method({
+			name: 'getExtensionRecipientsStatus',
+			call: 'quorumExtension_getExtensionRecipientsStatus',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter]
+		}),
 
 	],
 	properties:
@@ -22,9 +22,14 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
+	"unicode"
 
+	"github.com/ethereum/go-ethereum/internal/debug"
+	"github.com/ethereum/go-ethereum/plugin"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/stretchr/testify/assert"
 )
@@ -350,3 +355,56 @@ func not(ok bool) string {
 	}
 	return "not "
 }
+
+// Every state-changing admin and debug method registered by the node or the
+// plugin manager must be audited by default.
+func TestDefaultAuditedMethods_whenNodeAPIs(t *testing.T) {
+	stack, err := New(&Config{})
+	if err != nil {
+		t.Fatalf("can't create node: %v", err)
+	}
+	defer stack.Close()
+	apis := append(stack.apis(), rpc.API{Namespace: "admin", Service: plugin.NewPluginManagerAPI(nil)})
+	readOnly := map[string]bool{
+		"admin_peerEvents": true,
+		"admin_peers":      true,
+		"admin_qpeers":     true,
+		"admin_nodeInfo":   true,
+		"admin_qnodeInfo":  true,
+		"admin_datadir":    true,
+	}
+
+	if unaudited := unauditedMethods(t, apis, readOnly); len(unaudited) > 0 {
+		t.Errorf("state-changing methods not audited: %v", unaudited)
+	}
+}
+
+// unauditedMethods returns the admin, personal and debug methods of the APIs which
+// are neither read-only nor in rpc.DefaultAuditedMethods. Profiling and logging of
+// the debug handler don't change the node state and are skipped.
+func unauditedMethods(t *testing.T, apis []rpc.API, readOnly map[string]bool) []string {
+	audit, err := rpc.NewAuditLog(rpc.AuditLogConfig{File: filepath.Join(t.TempDir(), "audit.log")})
+	if err != nil {
+		t.Fatalf("can't create audit log: %v", err)
+	}
+	defer audit.Close()
+	var unaudited []string
+	for _, api := range apis {
+		if api.Namespace != "admin" && api.Namespace != "personal" && api.Namespace != "debug" {
+			continue
+		}
+		if api.Service == debug.Handler {
+			continue
+		}
+		typ := reflect.TypeOf(api.Service)
+		for i := 0; i < typ.NumMethod(); i++ {
+			name := []rune(typ.Method(i).Name)
+			name[0] = unicode.ToLower(name[0])
+			method := api.Namespace + "_" + string(name)
+			if !readOnly[method] && !audit.IsAudited(method) {
+				unaudited = append(unaudited, method)
+			}
+		}
+	}
+	return unaudited
+}
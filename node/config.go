@@ -39,6 +39,7 @@ import (
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/plugin"
+	"github.com/ethereum/go-ethereum/plugin/security"
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
@@ -209,6 +210,14 @@ type Config struct {
 	Plugins              *plugin.Settings `toml:",omitempty"`
 	EnableNodePermission bool             `toml:",omitempty"` // comes from EnableNodePermissionFlag --permissioned.
 	EnableMultitenancy   bool             `toml:",omitempty"` // comes from MultitenancyFlag flag
+	// JWTAuth configures the built-in JWT authentication manager for RPC security,
+	// as an alternative to the security plugin
+	JWTAuth *security.JWTAuthConfig `toml:",omitempty"`
+	// RPCRateLimits configures rate limits and concurrency caps for authenticated
+	// principals calling HTTP and WebSocket RPC APIs
+	RPCRateLimits *rpc.RateLimitConfig `toml:",omitempty"`
+	// RPCAuditLog configures the audit log of state-changing RPC calls served over HTTP, WebSocket and IPC
+	RPCAuditLog *rpc.AuditLogConfig `toml:",omitempty"`
 }
 
 // IPCEndpoint resolves an IPC endpoint based on a configured value, taking into
@@ -66,6 +66,7 @@ type Node struct {
 
 	// Quorum
 	pluginManager *plugin.PluginManager // Manage all plugins for this node. If plugin is not enabled, an EmptyPluginManager is set.
+	auditLog      *rpc.AuditLog         // Records state-changing RPC calls, nil if not configured
 	// End Quorum
 }
 
@@ -176,10 +177,24 @@ func New(conf *Config) (*Node, error) {
 		return nil, err
 	}
 
+	// Quorum
+	var rateLimiter *rpc.RateLimiter
+	if conf.RPCRateLimits != nil {
+		if rateLimiter, err = rpc.NewRateLimiter(*conf.RPCRateLimits); err != nil {
+			return nil, err
+		}
+	}
+	if conf.RPCAuditLog != nil {
+		if node.auditLog, err = rpc.NewAuditLog(*conf.RPCAuditLog); err != nil {
+			return nil, err
+		}
+	}
+	// End Quorum
+
 	// Configure RPC servers.
-	node.http = newHTTPServer(node.log, conf.HTTPTimeouts).withMultitenancy(node.config.EnableMultitenancy)
-	node.ws = newHTTPServer(node.log, rpc.DefaultHTTPTimeouts).withMultitenancy(node.config.EnableMultitenancy)
-	node.ipc = newIPCServer(node.log, conf.IPCEndpoint()).withMultitenancy(node.config.EnableMultitenancy)
+	node.http = newHTTPServer(node.log, conf.HTTPTimeouts).withMultitenancy(node.config.EnableMultitenancy).withRateLimiter(rateLimiter).withAuditLog(node.auditLog)
+	node.ws = newHTTPServer(node.log, rpc.DefaultHTTPTimeouts).withMultitenancy(node.config.EnableMultitenancy).withRateLimiter(rateLimiter).withAuditLog(node.auditLog)
+	node.ipc = newIPCServer(node.log, conf.IPCEndpoint()).withMultitenancy(node.config.EnableMultitenancy).withAuditLog(node.auditLog)
 
 	return node, nil
 }
@@ -281,6 +296,13 @@ func (n *Node) doClose(errs []error) error {
 		}
 	}
 
+	// Quorum
+	if n.auditLog != nil {
+		if err := n.auditLog.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	// Release instance directory lock.
 	n.closeDataDir()
 
@@ -733,8 +755,20 @@ func (n *Node) closeDatabases() (errors []error) {
 }
 
 // Quorum
+//
+// The built-in JWT authentication manager is used instead of the security plugin when
+// Config.JWTAuth is set. Both can't be configured at the same time.
 func (n *Node) GetSecuritySupports() (tlsConfigSource security.TLSConfigurationSource, authManager security.AuthenticationManager, err error) {
-	if n.pluginManager.IsEnabled(plugin.SecurityPluginInterfaceName) {
+	if n.config.JWTAuth != nil {
+		if n.pluginManager.IsEnabled(plugin.SecurityPluginInterfaceName) {
+			err = errors.New("built-in JWT authentication and the security plugin are mutually exclusive")
+			return
+		}
+		if authManager, err = security.NewJWTAuthenticationManager(n.config.JWTAuth); err != nil {
+			return
+		}
+		log.Info("Using built-in JWT authentication manager", "jwksFile", n.config.JWTAuth.JWKSFile, "jwksURL", n.config.JWTAuth.JWKSURL)
+	} else if n.pluginManager.IsEnabled(plugin.SecurityPluginInterfaceName) {
 		sp := new(plugin.SecurityPluginTemplate)
 		if err = n.pluginManager.GetPluginTemplate(plugin.SecurityPluginInterfaceName, sp); err != nil {
 			return
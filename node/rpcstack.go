@@ -83,6 +83,10 @@ type httpServer struct {
 	// Quorum
 	// isMultitenant determines if the server supports mutlitenancy
 	isMultitenant bool
+	// rateLimiter is shared by all servers of the node, nil if unlimited
+	rateLimiter *rpc.RateLimiter
+	// auditLog is shared by all servers of the node, nil if disabled
+	auditLog *rpc.AuditLog
 }
 
 func newHTTPServer(log log.Logger, timeouts rpc.HTTPTimeouts) *httpServer {
@@ -100,6 +104,18 @@ func (h *httpServer) withMultitenancy(b bool) *httpServer {
 	return h
 }
 
+// withRateLimiter sets the limiter applied to the calls served
+func (h *httpServer) withRateLimiter(l *rpc.RateLimiter) *httpServer {
+	h.rateLimiter = l
+	return h
+}
+
+// withAuditLog sets the audit log of the calls served
+func (h *httpServer) withAuditLog(a *rpc.AuditLog) *httpServer {
+	h.auditLog = a
+	return h
+}
+
 // setListenAddr configures the listening address of the server.
 // The address can only be set while the server isn't running.
 func (h *httpServer) setListenAddr(host string, port int) error {
@@ -295,6 +311,8 @@ func (h *httpServer) enableRPC(apis []rpc.API, config httpConfig, authManager se
 
 	// Create RPC server and handler.
 	srv := rpc.NewProtectedServer(authManager, h.isMultitenant)
+	srv.SetRateLimiter(h.rateLimiter)
+	srv.SetAuditLog(h.auditLog)
 	if err := RegisterApisFromWhitelist(apis, config.Modules, srv, false); err != nil {
 		return err
 	}
@@ -328,6 +346,8 @@ func (h *httpServer) enableWS(apis []rpc.API, config wsConfig, authManager secur
 
 	// Create RPC server and handler.
 	srv := rpc.NewProtectedServer(authManager, h.isMultitenant)
+	srv.SetRateLimiter(h.rateLimiter)
+	srv.SetAuditLog(h.auditLog)
 	if err := RegisterApisFromWhitelist(apis, config.Modules, srv, false); err != nil {
 		return err
 	}
@@ -496,6 +516,8 @@ type ipcServer struct {
 	// Quorum
 	// isMultitenant determines if the server supports mutlitenancy
 	isMultitenant bool
+	// auditLog is shared by all servers of the node, nil if disabled
+	auditLog *rpc.AuditLog
 }
 
 func newIPCServer(log log.Logger, endpoint string) *ipcServer {
@@ -509,6 +531,12 @@ func (is *ipcServer) withMultitenancy(b bool) *ipcServer {
 	return is
 }
 
+// withAuditLog sets the audit log of the calls served
+func (is *ipcServer) withAuditLog(a *rpc.AuditLog) *ipcServer {
+	is.auditLog = a
+	return is
+}
+
 // Start starts the httpServer's http.Server
 func (is *ipcServer) start(apis []rpc.API) error {
 	is.mu.Lock()
@@ -523,6 +551,7 @@ func (is *ipcServer) start(apis []rpc.API) error {
 		return err
 	}
 	srv.EnableMultitenancy(is.isMultitenant)
+	srv.SetAuditLog(is.auditLog)
 	is.log.Info("IPC endpoint opened", "url", is.endpoint, "isMultitenant", is.isMultitenant)
 	is.listener, is.srv = listener, srv
 	return nil
@@ -495,6 +495,11 @@ func (srv *Server) Start() (err error) {
 
 	srv.loopWG.Add(1)
 	go srv.run()
+	// Quorum
+	if srv.EnableNodePermission {
+		srv.loopWG.Add(1)
+		go srv.watchNodeFiles()
+	}
 	return nil
 }
 
@@ -1009,11 +1014,7 @@ func (srv *Server) setupConn(c *conn, flags connFlag, dialDest *enode.Node) erro
 			log.Trace("Node Permissioning", "Connection Direction", direction)
 		}
 
-		if srv.isNodePermissionedFunc == nil {
-			if !core.IsNodePermissioned(nodeId, currentNode, srv.DataDir, direction) {
-				return newPeerError(errPermissionDenied, "id=%s…%s %s id=%s…%s", currentNode[:4], currentNode[len(currentNode)-4:], direction, nodeId[:4], nodeId[len(nodeId)-4:])
-			}
-		} else if !srv.isNodePermissionedFunc(node, nodeId, currentNode, srv.DataDir, direction) {
+		if !srv.isNodePermissioned(node, nodeId, currentNode, direction) {
 			return newPeerError(errPermissionDenied, "id=%s…%s %s id=%s…%s", currentNode[:4], currentNode[len(currentNode)-4:], direction, nodeId[:4], nodeId[len(nodeId)-4:])
 		}
 	} else {
@@ -1188,6 +1189,50 @@ func (srv *Server) SetCheckPeerInRaft(f func(*enode.Node) bool) {
 	srv.checkPeerInRaft = f
 }
 
+// isNodePermissioned checks the node with the permission function set by
+// SetIsNodePermissioned, defaulting to the node permission files
+func (srv *Server) isNodePermissioned(node *enode.Node, nodeId, currentNode, direction string) bool {
+	if srv.isNodePermissionedFunc == nil {
+		return core.IsNodePermissionedEnode(node, nodeId, currentNode, srv.DataDir, direction)
+	}
+	return srv.isNodePermissionedFunc(node, nodeId, currentNode, srv.DataDir, direction)
+}
+
+// watchNodeFiles re-checks the connected peers when the node permission files
+// are reloaded, and stops watching the files when the server stops
+func (srv *Server) watchNodeFiles() {
+	defer srv.loopWG.Done()
+	defer core.StopWatchingNodeFiles(srv.DataDir)
+	reloads := make(chan core.NodeFilesReloaded, 1)
+	sub := core.NodeFilesFeed.Subscribe(reloads)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case <-reloads:
+			srv.disconnectUnpermissionedPeers()
+		case <-srv.quit:
+			return
+		}
+	}
+}
+
+// disconnectUnpermissionedPeers disconnects the peers which are no longer
+// permissioned
+func (srv *Server) disconnectUnpermissionedPeers() {
+	currentNode := srv.NodeInfo().ID
+	for _, p := range srv.Peers() {
+		direction := "OUTGOING"
+		if p.Inbound() {
+			direction = "INCOMING"
+		}
+		if !srv.isNodePermissioned(p.Node(), p.ID().String(), currentNode, direction) {
+			srv.log.Info("Disconnecting peer no longer permissioned", "id", p.ID(), "addr", p.RemoteAddr())
+			permissionDisconnectMeter.Mark(1)
+			p.Disconnect(DiscRequested)
+		}
+	}
+}
+
 func (srv *Server) SetIsNodePermissioned(f func(*enode.Node, string, string, string, string) bool) {
 	if srv.isNodePermissionedFunc == nil {
 		srv.isNodePermissionedFunc = f
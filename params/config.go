@@ -17,6 +17,7 @@
 package params
 
 import (
+	"bytes"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -24,6 +25,7 @@ import (
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/log"
 	"golang.org/x/crypto/sha3"
@@ -248,21 +250,21 @@ var (
 	//
 	// This configuration is intentionally not using keyed fields to force anyone
 	// adding flags to the config to also have to set these fields.
-	AllEthashProtocolChanges = &ChainConfig{big.NewInt(1337), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, new(EthashConfig), nil, nil, nil, nil, nil, false, 32, 35, big.NewInt(0), big.NewInt(0), nil, nil, false, nil, nil}
+	AllEthashProtocolChanges = &ChainConfig{big.NewInt(1337), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, new(EthashConfig), nil, nil, nil, nil, nil, false, 32, 35, big.NewInt(0), big.NewInt(0), nil, nil, false, nil, nil, nil, 0}
 
 	// AllCliqueProtocolChanges contains every protocol change (EIPs) introduced
 	// and accepted by the Ethereum core developers into the Clique consensus.
 	//
 	// This configuration is intentionally not using keyed fields to force anyone
 	// adding flags to the config to also have to set these fields.
-	AllCliqueProtocolChanges = &ChainConfig{big.NewInt(10), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, nil, &CliqueConfig{Period: 0, Epoch: 30000}, nil, nil, nil, nil, false, 32, 32, big.NewInt(0), big.NewInt(0), nil, nil, false, nil, nil}
+	AllCliqueProtocolChanges = &ChainConfig{big.NewInt(10), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, nil, &CliqueConfig{Period: 0, Epoch: 30000}, nil, nil, nil, nil, false, 32, 32, big.NewInt(0), big.NewInt(0), nil, nil, false, nil, nil, nil, 0}
 
 	// Quorum chainID should 10
-	TestChainConfig = &ChainConfig{big.NewInt(10), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, new(EthashConfig), nil, nil, nil, nil, nil, false, 32, 32, big.NewInt(0), big.NewInt(0), nil, nil, false, nil, nil}
+	TestChainConfig = &ChainConfig{big.NewInt(10), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, new(EthashConfig), nil, nil, nil, nil, nil, false, 32, 32, big.NewInt(0), big.NewInt(0), nil, nil, false, nil, nil, nil, 0}
 	TestRules       = TestChainConfig.Rules(new(big.Int))
 
-	QuorumTestChainConfig    = &ChainConfig{big.NewInt(10), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, nil, nil, new(EthashConfig), nil, nil, nil, nil, nil, true, 64, 32, big.NewInt(0), big.NewInt(0), nil, big.NewInt(0), false, nil, nil}
-	QuorumMPSTestChainConfig = &ChainConfig{big.NewInt(10), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, nil, nil, new(EthashConfig), nil, nil, nil, nil, nil, true, 64, 32, big.NewInt(0), big.NewInt(0), nil, big.NewInt(0), true, nil, nil}
+	QuorumTestChainConfig    = &ChainConfig{big.NewInt(10), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, nil, nil, new(EthashConfig), nil, nil, nil, nil, nil, true, 64, 32, big.NewInt(0), big.NewInt(0), nil, big.NewInt(0), false, nil, nil, nil, 0}
+	QuorumMPSTestChainConfig = &ChainConfig{big.NewInt(10), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, nil, nil, new(EthashConfig), nil, nil, nil, nil, nil, true, 64, 32, big.NewInt(0), big.NewInt(0), nil, big.NewInt(0), true, nil, nil, nil, 0}
 )
 
 // TrustedCheckpoint represents a set of post-processed trie roots (CHT and
@@ -369,9 +371,11 @@ type ChainConfig struct {
 	// to track multiple changes to maxCodeSize
 	MaxCodeSizeConfig        []MaxCodeConfigStruct `json:"maxCodeSizeConfig,omitempty"`
 	PrivacyEnhancementsBlock *big.Int              `json:"privacyEnhancementsBlock,omitempty"`
-	IsMPS                    bool                  `json:"isMPS"`                            // multiple private states flag
-	PrivacyPrecompileBlock   *big.Int              `json:"privacyPrecompileBlock,omitempty"` // Switch block to enable privacy precompiled contract to process privacy marker transactions
-	EnableGasPriceBlock      *big.Int              `json:"enableGasPriceBlock,omitempty"`    // Switch block to enable usage of gas price
+	IsMPS                    bool                  `json:"isMPS"`                             // multiple private states flag
+	PrivacyPrecompileBlock   *big.Int              `json:"privacyPrecompileBlock,omitempty"`  // Switch block to enable privacy precompiled contract to process privacy marker transactions
+	EnableGasPriceBlock      *big.Int              `json:"enableGasPriceBlock,omitempty"`     // Switch block to enable usage of gas price
+	PrivateTxTypeBlock       *big.Int              `json:"privateTxTypeBlock,omitempty"`      // Switch block to accept EIP-2718 typed private transactions
+	PermissionConfirmations  uint64                `json:"permissionConfirmations,omitempty"` // Number of blocks on top of the block of a permission event before the event is applied to the permission caches
 
 	// End of Quorum specific configs
 }
@@ -447,30 +451,37 @@ const (
 
 	ContractMode    = "contract"
 	BlockHeaderMode = "blockheader"
+
+	BLSPublicKeyLength = 96 // Length of an uncompressed BLS12-381 G1 public key
 )
 
 type Transition struct {
-	Block                        *big.Int              `json:"block"`
-	Algorithm                    string                `json:"algorithm,omitempty"`
-	EpochLength                  uint64                `json:"epochlength,omitempty"`                  // Number of blocks that should pass before pending validator votes are reset
-	BlockPeriodSeconds           uint64                `json:"blockperiodseconds,omitempty"`           // Minimum time between two consecutive IBFT or QBFT blocks’ timestamps in seconds
-	EmptyBlockPeriodSeconds      *uint64               `json:"emptyblockperiodseconds,omitempty"`      // Minimum time between two consecutive IBFT or QBFT a block and empty block’ timestamps in seconds
-	RequestTimeoutSeconds        uint64                `json:"requesttimeoutseconds,omitempty"`        // Minimum request timeout for each IBFT or QBFT round in milliseconds
-	ContractSizeLimit            uint64                `json:"contractsizelimit,omitempty"`            // Maximum smart contract code size
-	ValidatorContractAddress     common.Address        `json:"validatorcontractaddress"`               // Smart contract address for list of validators
-	Validators                   []common.Address      `json:"validators"`                             // List of validators
-	ValidatorSelectionMode       string                `json:"validatorselectionmode,omitempty"`       // Validator selection mode to switch to
-	EnhancedPermissioningEnabled *bool                 `json:"enhancedPermissioningEnabled,omitempty"` // aka QIP714Block
-	PrivacyEnhancementsEnabled   *bool                 `json:"privacyEnhancementsEnabled,omitempty"`   // privacy enhancements (mandatory party, private state validation)
-	PrivacyPrecompileEnabled     *bool                 `json:"privacyPrecompileEnabled,omitempty"`     // enable marker transactions support
-	GasPriceEnabled              *bool                 `json:"gasPriceEnabled,omitempty"`              // enable gas price
-	MinerGasLimit                uint64                `json:"miner.gaslimit,omitempty"`               // Gas Limit
-	TwoFPlusOneEnabled           *bool                 `json:"2FPlus1Enabled,omitempty"`               // Ceil(2N/3) is the default you need to explicitly use 2F + 1
-	TransactionSizeLimit         uint64                `json:"transactionSizeLimit,omitempty"`         // Modify TransactionSizeLimit
-	BlockReward                  *math.HexOrDecimal256 `json:"blockReward,omitempty"`                  // validation rewards
-	BeneficiaryMode              *string               `json:"beneficiaryMode,omitempty"`              // Mode for setting the beneficiary, either: list, besu, validators (beneficiary list is the list of validators)
-	MiningBeneficiary            *common.Address       `json:"miningBeneficiary,omitempty"`            // Wallet address that benefits at every new block (besu mode)
-	MaxRequestTimeoutSeconds     *uint64               `json:"maxRequestTimeoutSeconds,omitempty"`     // The max a timeout should be for a round change
+	Block                        *big.Int                         `json:"block"`
+	Algorithm                    string                           `json:"algorithm,omitempty"`
+	EpochLength                  uint64                           `json:"epochlength,omitempty"`                  // Number of blocks that should pass before pending validator votes are reset
+	BlockPeriodSeconds           uint64                           `json:"blockperiodseconds,omitempty"`           // Minimum time between two consecutive IBFT or QBFT blocks’ timestamps in seconds
+	EmptyBlockPeriodSeconds      *uint64                          `json:"emptyblockperiodseconds,omitempty"`      // Minimum time between two consecutive IBFT or QBFT a block and empty block’ timestamps in seconds
+	RequestTimeoutSeconds        uint64                           `json:"requesttimeoutseconds,omitempty"`        // Minimum request timeout for each IBFT or QBFT round in milliseconds
+	ContractSizeLimit            uint64                           `json:"contractsizelimit,omitempty"`            // Maximum smart contract code size
+	ValidatorContractAddress     common.Address                   `json:"validatorcontractaddress"`               // Smart contract address for list of validators
+	Validators                   []common.Address                 `json:"validators"`                             // List of validators
+	ValidatorSelectionMode       string                           `json:"validatorselectionmode,omitempty"`       // Validator selection mode to switch to
+	EnhancedPermissioningEnabled *bool                            `json:"enhancedPermissioningEnabled,omitempty"` // aka QIP714Block
+	PrivacyEnhancementsEnabled   *bool                            `json:"privacyEnhancementsEnabled,omitempty"`   // privacy enhancements (mandatory party, private state validation)
+	PrivacyPrecompileEnabled     *bool                            `json:"privacyPrecompileEnabled,omitempty"`     // enable marker transactions support
+	PrivateTxTypeEnabled         *bool                            `json:"privateTxTypeEnabled,omitempty"`         // accept EIP-2718 typed private transactions
+	GasPriceEnabled              *bool                            `json:"gasPriceEnabled,omitempty"`              // enable gas price
+	MinerGasLimit                uint64                           `json:"miner.gaslimit,omitempty"`               // Gas Limit
+	TwoFPlusOneEnabled           *bool                            `json:"2FPlus1Enabled,omitempty"`               // Ceil(2N/3) is the default you need to explicitly use 2F + 1
+	TransactionSizeLimit         uint64                           `json:"transactionSizeLimit,omitempty"`         // Modify TransactionSizeLimit
+	BlockReward                  *math.HexOrDecimal256            `json:"blockReward,omitempty"`                  // validation rewards
+	BeneficiaryMode              *string                          `json:"beneficiaryMode,omitempty"`              // Mode for setting the beneficiary, either: list, besu, validators (beneficiary list is the list of validators)
+	MiningBeneficiary            *common.Address                  `json:"miningBeneficiary,omitempty"`            // Wallet address that benefits at every new block (besu mode)
+	MaxRequestTimeoutSeconds     *uint64                          `json:"maxRequestTimeoutSeconds,omitempty"`     // The max a timeout should be for a round change
+	BLSSealsEnabled              *bool                            `json:"blsSealsEnabled,omitempty"`              // Replace qbft committed seals with a BLS aggregate signature and a signer bitmap
+	BLSPublicKeys                map[common.Address]hexutil.Bytes `json:"blsPublicKeys,omitempty"`                // BLS public keys registered for validators from this block onwards
+	BLSProofsOfPossession        map[common.Address]hexutil.Bytes `json:"blsProofsOfPossession,omitempty"`        // Proof of possession of the secret key of each registered BLS public key
+	AccessRuleManagerAddress     *common.Address                  `json:"accessRuleManagerAddress,omitempty"`     // Contract holding the access rules enforced in block validation, the zero address stops the enforcement
 }
 
 // String implements the fmt.Stringer interface.
@@ -486,7 +497,7 @@ func (c *ChainConfig) String() string {
 	default:
 		engine = "unknown"
 	}
-	return fmt.Sprintf("{ChainID: %v Homestead: %v DAO: %v DAOSupport: %v EIP150: %v EIP155: %v EIP158: %v Byzantium: %v IsQuorum: %v Constantinople: %v TransactionSizeLimit: %v MaxCodeSize: %v Petersburg: %v Istanbul: %v, Muir Glacier: %v, Berlin: %v  Catalyst: %v YOLO v3: %v PrivacyEnhancements: %v PrivacyPrecompile: %v EnableGasPriceBlock: %v Engine: %v}",
+	return fmt.Sprintf("{ChainID: %v Homestead: %v DAO: %v DAOSupport: %v EIP150: %v EIP155: %v EIP158: %v Byzantium: %v IsQuorum: %v Constantinople: %v TransactionSizeLimit: %v MaxCodeSize: %v Petersburg: %v Istanbul: %v, Muir Glacier: %v, Berlin: %v  Catalyst: %v YOLO v3: %v PrivacyEnhancements: %v PrivacyPrecompile: %v EnableGasPriceBlock: %v PrivateTxType: %v Engine: %v}",
 		c.ChainID,
 		c.HomesteadBlock,
 		c.DAOForkBlock,
@@ -508,6 +519,7 @@ func (c *ChainConfig) String() string {
 		c.PrivacyEnhancementsBlock, //Quorum
 		c.PrivacyPrecompileBlock,   //Quorum
 		c.EnableGasPriceBlock,      //Quorum
+		c.PrivateTxTypeBlock,       //Quorum
 		engine,
 	)
 }
@@ -781,6 +793,14 @@ func (c *ChainConfig) CheckTransitionsData() error {
 		if transition.TransactionSizeLimit != 0 && transition.TransactionSizeLimit < 32 || transition.TransactionSizeLimit > 128 {
 			return ErrTransactionSizeLimit
 		}
+		if transition.BLSSealsEnabled != nil && *transition.BLSSealsEnabled && !isQBFT {
+			return ErrBLSSealsRequireQBFT
+		}
+		for _, key := range transition.BLSPublicKeys {
+			if len(key) != BLSPublicKeyLength {
+				return ErrBLSPublicKey
+			}
+		}
 		if transition.BeneficiaryMode != nil && *transition.BeneficiaryMode != "fixed" && *transition.BeneficiaryMode != "validators" && *transition.BeneficiaryMode != "" && *transition.BeneficiaryMode != "list" {
 			return ErrBeneficiaryMode
 		}
@@ -921,11 +941,46 @@ func isTransitionsConfigCompatible(c1, c2 *ChainConfig, head *big.Int) (error, *
 		if isSameBlock || c1.Transitions[i].MinerGasLimit != c2.Transitions[i].MinerGasLimit {
 			return ErrTransitionIncompatible("TransactionSizeLimit"), head, head
 		}
+		if isSameBlock || isBLSSealsEnabled(c1.Transitions[i]) != isBLSSealsEnabled(c2.Transitions[i]) {
+			return ErrTransitionIncompatible("BLSSealsEnabled"), head, head
+		}
+		if isSameBlock || !isSameBLSKeys(c1.Transitions[i].BLSPublicKeys, c2.Transitions[i].BLSPublicKeys) {
+			return ErrTransitionIncompatible("BLSPublicKeys"), head, head
+		}
+		if isSameBlock || !isSameBLSKeys(c1.Transitions[i].BLSProofsOfPossession, c2.Transitions[i].BLSProofsOfPossession) {
+			return ErrTransitionIncompatible("BLSProofsOfPossession"), head, head
+		}
+		if isSameBlock || !isSameAddress(c1.Transitions[i].AccessRuleManagerAddress, c2.Transitions[i].AccessRuleManagerAddress) {
+			return ErrTransitionIncompatible("AccessRuleManagerAddress"), head, head
+		}
 	}
 
 	return nil, big.NewInt(0), big.NewInt(0)
 }
 
+func isBLSSealsEnabled(t Transition) bool {
+	return t.BLSSealsEnabled != nil && *t.BLSSealsEnabled
+}
+
+func isSameBLSKeys(a, b map[common.Address]hexutil.Bytes) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for validator, key := range a {
+		if other, ok := b[validator]; !ok || !bytes.Equal(key, other) {
+			return false
+		}
+	}
+	return true
+}
+
+func isSameAddress(a, b *common.Address) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
 // Quorum
 //
 // IsPrivacyEnhancementsEnabled returns whether num represents a block number after the PrivacyEnhancementsEnabled fork
@@ -954,6 +1009,34 @@ func (c *ChainConfig) IsPrivacyPrecompileEnabled(num *big.Int) bool {
 	return isForked(c.PrivacyPrecompileBlock, num) || isPrivacyPrecompileEnabled
 }
 
+// Quorum
+//
+// IsPrivateTxTypeEnabled returns whether EIP-2718 typed private transactions are accepted in the block num
+func (c *ChainConfig) IsPrivateTxTypeEnabled(num *big.Int) bool {
+	isPrivateTxTypeEnabled := false
+	c.GetTransitionValue(num, func(transition Transition) {
+		if transition.PrivateTxTypeEnabled != nil {
+			isPrivateTxTypeEnabled = *transition.PrivateTxTypeEnabled
+		}
+	})
+
+	return isForked(c.PrivateTxTypeBlock, num) || isPrivateTxTypeEnabled
+}
+
+// Quorum
+//
+// GetAccessRuleManagerAddress returns the contract holding the access rules
+// enforced in the block num, the zero address if they are not enforced
+func (c *ChainConfig) GetAccessRuleManagerAddress(num *big.Int) common.Address {
+	var accessRuleManagerAddress common.Address
+	c.GetTransitionValue(num, func(transition Transition) {
+		if transition.AccessRuleManagerAddress != nil {
+			accessRuleManagerAddress = *transition.AccessRuleManagerAddress
+		}
+	})
+	return accessRuleManagerAddress
+}
+
 // Quorum
 func (c *ChainConfig) GetTransactionSizeLimit(num *big.Int) uint64 {
 	transactionSizeLimit := uint64(0)
@@ -1134,6 +1217,9 @@ func (c *ChainConfig) checkCompatible(newcfg *ChainConfig, head *big.Int, isQuor
 	if isForkIncompatible(c.PrivacyPrecompileBlock, newcfg.PrivacyPrecompileBlock, head) {
 		return newCompatError("Privacy Precompile fork block", c.PrivacyPrecompileBlock, newcfg.PrivacyPrecompileBlock)
 	}
+	if isForkIncompatible(c.PrivateTxTypeBlock, newcfg.PrivateTxTypeBlock, head) {
+		return newCompatError("Private transaction type fork block", c.PrivateTxTypeBlock, newcfg.PrivateTxTypeBlock)
+	}
 	return nil
 }
 
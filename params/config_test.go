@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 // Quorum - test code size and transaction size limit in chain config
@@ -118,6 +119,12 @@ func TestCheckCompatible(t *testing.T) {
 	passedValidMaxConfig1 = append(passedValidMaxConfig1, rec1)
 	passedValidMaxConfig1 = append(passedValidMaxConfig1, rec3)
 
+	accessRuleManager := common.HexToAddress("0x1932c48b2bf8102ba33b4a6b545c32236e342f34")
+	otherAccessRuleManager := common.HexToAddress("0x9d13c6d3afe1721beef56b55d303b09e021e27ab")
+	validator := common.HexToAddress("0x0638e1574728b6d862dd5d3a3e0942c3be47d996")
+	blsKeys := map[common.Address]hexutil.Bytes{validator: {0x01, 0x02}}
+	otherBLSKeys := map[common.Address]hexutil.Bytes{validator: {0x01, 0x03}}
+
 	tests := []test{
 		{stored: AllEthashProtocolChanges, new: AllEthashProtocolChanges, head: 0, wantErr: nil},
 		{stored: AllEthashProtocolChanges, new: AllEthashProtocolChanges, head: 100, wantErr: nil},
@@ -314,6 +321,57 @@ func TestCheckCompatible(t *testing.T) {
 			head:    15,
 			wantErr: nil,
 		},
+		{
+			stored:  &ChainConfig{Transitions: []Transition{{Block: big.NewInt(10), AccessRuleManagerAddress: &accessRuleManager}}},
+			new:     &ChainConfig{Transitions: []Transition{{Block: big.NewInt(10), AccessRuleManagerAddress: &otherAccessRuleManager}}},
+			head:    5,
+			wantErr: nil,
+		},
+		{
+			stored: &ChainConfig{Transitions: []Transition{{Block: big.NewInt(10), AccessRuleManagerAddress: &accessRuleManager}}},
+			new:    &ChainConfig{Transitions: []Transition{{Block: big.NewInt(10), AccessRuleManagerAddress: &otherAccessRuleManager}}},
+			head:   15,
+			wantErr: &ConfigCompatError{
+				What:         ErrTransitionIncompatible("AccessRuleManagerAddress").Error(),
+				StoredConfig: big.NewInt(15),
+				NewConfig:    big.NewInt(15),
+				RewindTo:     14,
+			},
+		},
+		{
+			stored:  &ChainConfig{Transitions: []Transition{{Block: big.NewInt(10), BLSPublicKeys: blsKeys}}},
+			new:     &ChainConfig{Transitions: []Transition{{Block: big.NewInt(10), BLSPublicKeys: otherBLSKeys}}},
+			head:    5,
+			wantErr: nil,
+		},
+		{
+			stored: &ChainConfig{Transitions: []Transition{{Block: big.NewInt(10), BLSPublicKeys: blsKeys}}},
+			new:    &ChainConfig{Transitions: []Transition{{Block: big.NewInt(10), BLSPublicKeys: otherBLSKeys}}},
+			head:   15,
+			wantErr: &ConfigCompatError{
+				What:         ErrTransitionIncompatible("BLSPublicKeys").Error(),
+				StoredConfig: big.NewInt(15),
+				NewConfig:    big.NewInt(15),
+				RewindTo:     14,
+			},
+		},
+		{
+			stored:  &ChainConfig{Transitions: []Transition{{Block: big.NewInt(10), BLSProofsOfPossession: blsKeys}}},
+			new:     &ChainConfig{Transitions: []Transition{{Block: big.NewInt(10), BLSProofsOfPossession: blsKeys}}},
+			head:    15,
+			wantErr: nil,
+		},
+		{
+			stored: &ChainConfig{Transitions: []Transition{{Block: big.NewInt(10), BLSProofsOfPossession: blsKeys}}},
+			new:    &ChainConfig{Transitions: []Transition{{Block: big.NewInt(10)}}},
+			head:   15,
+			wantErr: &ConfigCompatError{
+				What:         ErrTransitionIncompatible("BLSProofsOfPossession").Error(),
+				StoredConfig: big.NewInt(15),
+				NewConfig:    big.NewInt(15),
+				RewindTo:     14,
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -332,10 +390,10 @@ func TestCheckTransitionsData(t *testing.T) {
 	var ibftTransitionsConfig, qbftTransitionsConfig, invalidTransition, invalidBlockOrder []Transition
 	var emptyBlockPeriodSeconds uint64 = 10
 
-	tranI0 := Transition{big.NewInt(0), IBFT, 30000, 5, nil, 10, 50, common.Address{}, nil, "", nil, nil, nil, nil, 0, nil, 0, nil, nil, nil, nil}
-	tranQ5 := Transition{big.NewInt(5), QBFT, 30000, 5, &emptyBlockPeriodSeconds, 10, 50, common.Address{}, nil, "", nil, nil, nil, nil, 0, nil, 0, nil, nil, nil, nil}
-	tranI10 := Transition{big.NewInt(10), IBFT, 30000, 5, nil, 10, 50, common.Address{}, nil, "", nil, nil, nil, nil, 0, nil, 0, nil, nil, nil, nil}
-	tranQ8 := Transition{big.NewInt(8), QBFT, 30000, 5, &emptyBlockPeriodSeconds, 10, 50, common.Address{}, nil, "", nil, nil, nil, nil, 0, nil, 0, nil, nil, nil, nil}
+	tranI0 := Transition{big.NewInt(0), IBFT, 30000, 5, nil, 10, 50, common.Address{}, nil, "", nil, nil, nil, nil, nil, 0, nil, 0, nil, nil, nil, nil, nil, nil, nil, nil}
+	tranQ5 := Transition{big.NewInt(5), QBFT, 30000, 5, &emptyBlockPeriodSeconds, 10, 50, common.Address{}, nil, "", nil, nil, nil, nil, nil, 0, nil, 0, nil, nil, nil, nil, nil, nil, nil, nil}
+	tranI10 := Transition{big.NewInt(10), IBFT, 30000, 5, nil, 10, 50, common.Address{}, nil, "", nil, nil, nil, nil, nil, 0, nil, 0, nil, nil, nil, nil, nil, nil, nil, nil}
+	tranQ8 := Transition{big.NewInt(8), QBFT, 30000, 5, &emptyBlockPeriodSeconds, 10, 50, common.Address{}, nil, "", nil, nil, nil, nil, nil, 0, nil, 0, nil, nil, nil, nil, nil, nil, nil, nil}
 
 	ibftTransitionsConfig = append(ibftTransitionsConfig, tranI0, tranI10)
 	qbftTransitionsConfig = append(qbftTransitionsConfig, tranQ5, tranQ8)
@@ -395,7 +453,7 @@ func TestCheckTransitionsData(t *testing.T) {
 			wantErr: ErrBlockOrder,
 		},
 		{
-			stored:  &ChainConfig{Transitions: []Transition{{nil, IBFT, 30000, 5, &emptyBlockPeriodSeconds, 10, 50, common.Address{}, nil, "", nil, nil, nil, nil, 0, nil, 0, nil, nil, nil, nil}}},
+			stored:  &ChainConfig{Transitions: []Transition{{nil, IBFT, 30000, 5, &emptyBlockPeriodSeconds, 10, 50, common.Address{}, nil, "", nil, nil, nil, nil, nil, 0, nil, 0, nil, nil, nil, nil, nil, nil, nil, nil}}},
 			wantErr: ErrBlockNumberMissing,
 		},
 		{
@@ -619,6 +677,35 @@ func TestIsGasPriceEnabled(t *testing.T) {
 	}
 }
 
+func TestGetAccessRuleManagerAddress(t *testing.T) {
+	type test struct {
+		blockNumber              int64
+		AccessRuleManagerAddress common.Address
+	}
+
+	manager := common.HexToAddress("0x1932c48b2bf8102ba33b4a6b545c32236e342f34")
+	config := *TestChainConfig
+	config.Transitions = []Transition{
+		{Block: big.NewInt(10), AccessRuleManagerAddress: &manager},
+		{Block: big.NewInt(20), ContractSizeLimit: 32},
+		{Block: big.NewInt(30), AccessRuleManagerAddress: &common.Address{}},
+	}
+
+	tests := []test{
+		{9, common.Address{}},
+		{10, manager},
+		{20, manager},
+		{30, common.Address{}},
+	}
+
+	for _, test := range tests {
+		accessRuleManagerAddress := config.GetAccessRuleManagerAddress(big.NewInt(test.blockNumber))
+		if accessRuleManagerAddress != test.AccessRuleManagerAddress {
+			t.Errorf("error mismatch on %v:\nexpected: %v\nreceived: %v\n", test.blockNumber, test.AccessRuleManagerAddress, accessRuleManagerAddress)
+		}
+	}
+}
+
 func newPBool(b bool) *bool {
 	return &b
 }
@@ -17,6 +17,8 @@ var (
 	ErrMissingValidatorSelectionMode   = errors.New("validator selection mode is missing, should specify `contract` when using validatorcontractaddress")
 	ErrTransactionSizeLimit            = errors.New("genesis transaction size limit must be between 32 and 128")
 	ErrBeneficiaryMode                 = errors.New("beneficiary mode is not valid")
+	ErrBLSSealsRequireQBFT             = errors.New("BLS committed seals can only be enabled with qbft consensus")
+	ErrBLSPublicKey                    = errors.New("BLS public key must be an uncompressed 96 byte G1 point")
 )
 
 func ErrTransitionIncompatible(field string) error {
@@ -7,11 +7,13 @@ import (
 	"regexp"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/internal/ethapi"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/permission/core"
 	ptype "github.com/ethereum/go-ethereum/permission/core/types"
+	v2 "github.com/ethereum/go-ethereum/permission/v2"
 )
 
 var isStringAlphaNumeric = regexp.MustCompile(`^[a-zA-Z0-9_-]*$`).MatchString
@@ -44,6 +46,11 @@ const (
 	InitiateAccountRecovery
 	ApproveNodeRecovery
 	ApproveAccountRecovery
+	SetAccessRule
+	RemoveAccessRule
+	ProposeAction
+	VoteAction
+	CancelAction
 )
 
 type AccountUpdateAction int
@@ -106,6 +113,94 @@ func (q *QuorumControlsAPI) AcctList() []core.AccountInfo {
 	return core.AcctInfoMap.GetAcctList()
 }
 
+func (q *QuorumControlsAPI) AccessRuleList() []core.AccessRule {
+	if core.AccessRuleMap == nil {
+		return nil
+	}
+	return core.AccessRuleMap.GetAccessRuleList()
+}
+
+// PendingActions returns the proposals of the action manager which can still
+// be voted on
+func (q *QuorumControlsAPI) PendingActions() ([]v2.ActionInfo, error) {
+	if !q.permCtrl.IsV2Permission() {
+		return nil, ptype.ErrNoActionContract
+	}
+	return q.permCtrl.contract.(*v2.Init).GetPendingActions(q.permCtrl.eth.BlockChain().CurrentBlock().NumberU64())
+}
+
+var errNoPermissionHistory = errors.New("permission history is only available with the v2 permissions model")
+
+// GetAccountHistory returns the permission events which changed the access of the account
+func (q *QuorumControlsAPI) GetAccountHistory(acct common.Address) ([]v2.PermissionEvent, error) {
+	if q.permCtrl.history == nil {
+		return nil, errNoPermissionHistory
+	}
+	return q.permCtrl.history.AccountHistory(acct)
+}
+
+// GetOrgHistory returns the permission events related to the org
+func (q *QuorumControlsAPI) GetOrgHistory(orgId string) ([]v2.PermissionEvent, error) {
+	if q.permCtrl.history == nil {
+		return nil, errNoPermissionHistory
+	}
+	return q.permCtrl.history.OrgHistory(orgId)
+}
+
+// GetPermissionsAt returns the orgs, nodes, roles and accounts as they were at the given block
+func (q *QuorumControlsAPI) GetPermissionsAt(blockNumber hexutil.Uint64) (*v2.PermissionsSnapshot, error) {
+	if q.permCtrl.history == nil {
+		return nil, errNoPermissionHistory
+	}
+	return q.permCtrl.history.PermissionsAt(uint64(blockNumber))
+}
+
+// CacheConsistency is the result of comparing the permission caches with the
+// state of the permission contracts
+type CacheConsistency struct {
+	BlockNumber     uint64               `json:"blockNumber"`
+	PendingEvents   int                  `json:"pendingEvents"` // events waiting for confirmations
+	Inconsistencies []core.Inconsistency `json:"inconsistencies"`
+}
+
+// CheckCacheConsistency compares the permission caches with the state of the
+// permission contracts. Events waiting for confirmations and pending
+// transactions show up as inconsistencies until they are applied.
+func (q *QuorumControlsAPI) CheckCacheConsistency() (*CacheConsistency, error) {
+	p := q.permCtrl
+	if p.events == nil {
+		return nil, errors.New("permission service not started")
+	}
+	result := &CacheConsistency{
+		BlockNumber:   p.eth.BlockChain().CurrentBlock().NumberU64(),
+		PendingEvents: p.events.Pending(),
+	}
+	orgs, err := ptype.ReadOrgs(p.contract)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := ptype.ReadNodes(p.contract)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := ptype.ReadRoles(p.contract)
+	if err != nil {
+		return nil, err
+	}
+	accounts, err := ptype.ReadAccounts(p.contract)
+	if err != nil {
+		return nil, err
+	}
+	var rules []core.AccessRule
+	if p.IsV2Permission() {
+		if rules, err = p.contract.(*v2.Init).GetAccessRules(); err != nil {
+			return nil, err
+		}
+	}
+	result.Inconsistencies = core.GlobalCaches().Compare(orgs, nodes, roles, accounts, rules)
+	return result, nil
+}
+
 func (q *QuorumControlsAPI) GetOrgDetails(orgId string) (core.OrgDetailInfo, error) {
 	o, err := core.OrgInfoMap.GetOrg(orgId)
 	if err != nil {
@@ -473,6 +568,99 @@ func (q *QuorumControlsAPI) ApproveBlackListedAccountRecovery(orgId string, acct
 	return actionSuccess, nil
 }
 
+func (q *QuorumControlsAPI) SetAccessRule(orgId string, roleId string, target common.Address, selector hexutil.Bytes, allowed bool, valueCap *hexutil.Big, txa ethapi.SendTxArgs) (string, error) {
+	accessRuleService, err := q.permCtrl.NewPermissionAccessRuleService(txa)
+	if err != nil {
+		return "", err
+	}
+	args := ptype.TxArgs{OrgId: orgId, RoleId: roleId, Target: target, Allowed: allowed, ValueCap: (*big.Int)(valueCap), Txa: txa}
+	if err := q.valAccessRule(args, selector); err != nil {
+		return "", err
+	}
+	copy(args.Selector[:], selector)
+	tx, err := accessRuleService.SetAccessRule(args)
+	if err != nil {
+		return reportExecError(SetAccessRule, err)
+	}
+	log.Debug("executed permission action", "action", SetAccessRule, "tx", tx)
+	return actionSuccess, nil
+}
+
+func (q *QuorumControlsAPI) RemoveAccessRule(orgId string, roleId string, target common.Address, selector hexutil.Bytes, txa ethapi.SendTxArgs) (string, error) {
+	accessRuleService, err := q.permCtrl.NewPermissionAccessRuleService(txa)
+	if err != nil {
+		return "", err
+	}
+	args := ptype.TxArgs{OrgId: orgId, RoleId: roleId, Target: target, Txa: txa}
+	if err := q.valAccessRule(args, selector); err != nil {
+		return "", err
+	}
+	copy(args.Selector[:], selector)
+	tx, err := accessRuleService.RemoveAccessRule(args)
+	if err != nil {
+		return reportExecError(RemoveAccessRule, err)
+	}
+	log.Debug("executed permission action", "action", RemoveAccessRule, "tx", tx)
+	return actionSuccess, nil
+}
+
+// ProposeAction proposes a network admin action for multi-signature approval.
+// value is the status action for org status approvals and the new threshold
+// for threshold changes, duration the number of blocks the proposal is open.
+func (q *QuorumControlsAPI) ProposeAction(actionType uint8, orgId string, url string, acct common.Address, value uint64, duration uint64, txa ethapi.SendTxArgs) (string, error) {
+	actionService, err := q.permCtrl.NewPermissionActionService(txa)
+	if err != nil {
+		return "", err
+	}
+	args := ptype.TxArgs{ActionType: actionType, OrgId: orgId, Url: url, AcctId: acct, Duration: duration, Txa: txa}
+	switch actionType {
+	case v2.ActionApproveOrgStatus:
+		args.Action = uint8(value)
+	case v2.ActionChangeThreshold:
+		args.Threshold = value
+	}
+	if err := q.valProposeAction(args); err != nil {
+		return "", err
+	}
+	tx, err := actionService.ProposeAction(args)
+	if err != nil {
+		return reportExecError(ProposeAction, err)
+	}
+	log.Debug("executed permission action", "action", ProposeAction, "tx", tx)
+	return actionSuccess, nil
+}
+
+func (q *QuorumControlsAPI) VoteAction(id uint64, txa ethapi.SendTxArgs) (string, error) {
+	actionService, err := q.permCtrl.NewPermissionActionService(txa)
+	if err != nil {
+		return "", err
+	}
+	if !q.isNetworkAdmin(txa.From) {
+		return "", ptype.ErrNotNetworkAdmin
+	}
+	tx, err := actionService.VoteAction(ptype.TxArgs{ActionId: id, Txa: txa})
+	if err != nil {
+		return reportExecError(VoteAction, err)
+	}
+	log.Debug("executed permission action", "action", VoteAction, "tx", tx)
+	return actionSuccess, nil
+}
+
+// CancelAction cancels a pending proposal, the contract only allows its
+// proposer to cancel it
+func (q *QuorumControlsAPI) CancelAction(id uint64, txa ethapi.SendTxArgs) (string, error) {
+	actionService, err := q.permCtrl.NewPermissionActionService(txa)
+	if err != nil {
+		return "", err
+	}
+	tx, err := actionService.CancelAction(ptype.TxArgs{ActionId: id, Txa: txa})
+	if err != nil {
+		return reportExecError(CancelAction, err)
+	}
+	log.Debug("executed permission action", "action", CancelAction, "tx", tx)
+	return actionSuccess, nil
+}
+
 func (q *QuorumControlsAPI) TransactionAllowed(txa ethapi.SendTxArgs) bool {
 	var value, gasPrice, gasLimit *big.Int
 	var payload []byte
@@ -996,6 +1184,54 @@ func (q *QuorumControlsAPI) valRemoveRole(args ptype.TxArgs) error {
 	return nil
 }
 
+func (q *QuorumControlsAPI) valAccessRule(args ptype.TxArgs, selector hexutil.Bytes) error {
+	// an empty selector applies the rule to all functions
+	if args.RoleId == "" || (len(selector) != 0 && len(selector) != len(args.Selector)) {
+		return ptype.ErrInvalidInput
+	}
+	if !q.isNetworkAdmin(args.Txa.From) {
+		if er := q.isOrgAdmin(args.Txa.From, args.OrgId); er != nil {
+			return er
+		}
+	}
+	// admin roles are not subject to access rules
+	if args.RoleId == q.permCtrl.permConfig.OrgAdminRole || args.RoleId == q.permCtrl.permConfig.NwAdminRole {
+		return ptype.ErrAdminRoles
+	}
+	if r, _ := core.RoleInfoMap.GetRole(args.OrgId, args.RoleId); r == nil {
+		return ptype.ErrInvalidRole
+	}
+	return nil
+}
+
+// valProposeAction applies the checks of the direct approval of the action
+func (q *QuorumControlsAPI) valProposeAction(args ptype.TxArgs) error {
+	if args.Duration == 0 {
+		return ptype.ErrInvalidInput
+	}
+	switch args.ActionType {
+	case v2.ActionApproveOrg:
+		return q.valApproveOrg(args)
+	case v2.ActionApproveOrgStatus:
+		return q.valApproveOrgStatus(args)
+	case v2.ActionApproveAdminRole:
+		return q.valApproveAdminRole(args)
+	case v2.ActionApproveNodeRecovery:
+		return q.valRecoverNode(args, ApproveNodeRecovery)
+	case v2.ActionApproveAccountRecovery:
+		return q.valRecoverAccount(args, ApproveAccountRecovery)
+	case v2.ActionChangeThreshold:
+		if !q.isNetworkAdmin(args.Txa.From) {
+			return ptype.ErrNotNetworkAdmin
+		}
+		if args.Threshold == 0 {
+			return ptype.ErrInvalidInput
+		}
+		return nil
+	}
+	return ptype.ErrOpNotAllowed
+}
+
 func (q *QuorumControlsAPI) valAssignRole(args ptype.TxArgs) error {
 	if args.AcctId == (common.Address{0}) {
 		return ptype.ErrInvalidInput
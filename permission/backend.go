@@ -31,6 +31,8 @@ type PermissionCtrl struct {
 	permConfig     *ptype.PermissionConfig
 	contract       ptype.InitService
 	backend        ptype.Backend
+	history        *v2.History        // nil with the v1 model
+	events         *core.StateMachine // applies the contract events to the caches
 	useDns         bool
 	isRaft         bool
 	startWaitGroup *sync.WaitGroup // waitgroup to make sure all dependencies are ready before we start the service
@@ -179,6 +181,22 @@ func (p *PermissionCtrl) NewPermissionAccountService(txa ethapi.SendTxArgs) (pty
 	return p.backend.GetAccountService(transactOpts, p.getContractBackend())
 }
 
+func (p *PermissionCtrl) NewPermissionAccessRuleService(txa ethapi.SendTxArgs) (ptype.AccessRuleService, error) {
+	transactOpts, err := p.getTxParams(txa)
+	if err != nil {
+		return nil, err
+	}
+	return p.backend.GetAccessRuleService(transactOpts, p.getContractBackend())
+}
+
+func (p *PermissionCtrl) NewPermissionActionService(txa ethapi.SendTxArgs) (ptype.ActionService, error) {
+	transactOpts, err := p.getTxParams(txa)
+	if err != nil {
+		return nil, err
+	}
+	return p.backend.GetActionService(transactOpts, p.getContractBackend())
+}
+
 func (p *PermissionCtrl) NewPermissionAuditService() (ptype.AuditService, error) {
 	return p.backend.GetAuditService(p.getContractBackend())
 }
@@ -45,12 +45,12 @@ func isNodePermissionedV2(node *enode.Node, nodename string, currentNode string,
 func IsNodePermissioned(node *enode.Node, nodename string, currentNode string, datadir string, direction string) bool {
 	//if we have not reached QIP714 block return full access
 	if !core.PermissionsEnabled() {
-		return core.IsNodePermissioned(nodename, currentNode, datadir, direction)
+		return core.IsNodePermissionedEnode(node, nodename, currentNode, datadir, direction)
 	}
 
 	switch core.PermissionModel {
 	case core.Default:
-		return core.IsNodePermissioned(nodename, currentNode, datadir, direction)
+		return core.IsNodePermissionedEnode(node, nodename, currentNode, datadir, direction)
 
 	case core.V1:
 		return isNodePermissionedV1(node.EnodeID(), nodename, currentNode, direction)
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"math/big"
@@ -9,6 +10,7 @@ import (
 	"sync/atomic"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	lru "github.com/hashicorp/golang-lru"
 )
@@ -124,6 +126,26 @@ type AccountInfo struct {
 	Status     AcctStatus     `json:"status"`
 }
 
+// FunctionSelector is the 4 byte identifier of a contract function. The zero
+// selector matches any function
+type FunctionSelector [4]byte
+
+func (s FunctionSelector) MarshalText() ([]byte, error) {
+	return hexutil.Bytes(s[:]).MarshalText()
+}
+
+// AccessRule allows or denies the accounts of a role to call a target contract,
+// or a single function of it, and caps the value sent along. The zero target
+// matches any target and a zero value cap means no cap
+type AccessRule struct {
+	OrgId    string           `json:"orgId"`
+	RoleId   string           `json:"roleId"`
+	Target   common.Address   `json:"target"`
+	Selector FunctionSelector `json:"selector"`
+	Allowed  bool             `json:"allowed"`
+	ValueCap *big.Int         `json:"valueCap"`
+}
+
 type OrgDetailInfo struct {
 	NodeList   []NodeInfo    `json:"nodeList"`
 	RoleList   []RoleInfo    `json:"roleList"`
@@ -144,6 +166,13 @@ var (
 	NodeInfoMap *NodeCache
 	RoleInfoMap *RoleCache
 	AcctInfoMap *AcctCache
+	// access rules are only available with the v2 model, nil otherwise
+	AccessRuleMap *AccessRuleCache
+)
+
+var (
+	ErrAccessRuleDenied      = errors.New("transaction denied by the access rules of the account role")
+	ErrAccessRuleValueCapped = errors.New("transaction value exceeds the cap set by the access rules of the account role")
 )
 
 type OrgKey struct {
@@ -247,6 +276,22 @@ func NewAcctCache(cacheSize int) *AcctCache {
 	return &acctCache
 }
 
+type accessRuleKey struct {
+	Target   common.Address
+	Selector FunctionSelector
+}
+
+// AccessRuleCache holds all access rules by role. Unlike the other caches it
+// is not bounded, dropping a deny rule would silently widen the access of a role
+type AccessRuleCache struct {
+	rules map[RoleKey]map[accessRuleKey]*AccessRule
+	mux   sync.RWMutex
+}
+
+func NewAccessRuleCache() *AccessRuleCache {
+	return &AccessRuleCache{rules: make(map[RoleKey]map[accessRuleKey]*AccessRule)}
+}
+
 func SetSyncStatus() {
 	syncStarted = true
 }
@@ -529,6 +574,119 @@ func (r *RoleCache) GetRoleList() []RoleInfo {
 	return rlist
 }
 
+func (r *AccessRuleCache) UpsertAccessRule(orgId, roleId string, target common.Address, selector FunctionSelector, allowed bool, valueCap *big.Int) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	key := RoleKey{OrgId: orgId, RoleId: roleId}
+	if r.rules[key] == nil {
+		r.rules[key] = make(map[accessRuleKey]*AccessRule)
+	}
+	r.rules[key][accessRuleKey{target, selector}] = &AccessRule{orgId, roleId, target, selector, allowed, valueCap}
+}
+
+func (r *AccessRuleCache) RemoveAccessRule(orgId, roleId string, target common.Address, selector FunctionSelector) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	key := RoleKey{OrgId: orgId, RoleId: roleId}
+	delete(r.rules[key], accessRuleKey{target, selector})
+	if len(r.rules[key]) == 0 {
+		delete(r.rules, key)
+	}
+}
+
+func (r *AccessRuleCache) GetAccessRuleList() []AccessRule {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	var rlist []AccessRule
+	for _, rules := range r.rules {
+		for _, rule := range rules {
+			rlist = append(rlist, *rule)
+		}
+	}
+	return rlist
+}
+
+// matches returns whether the rule applies to a call of the given target with
+// the given payload, along with how specific the match is. A rule on a target
+// is more specific than a rule on a function of any target
+func (a *AccessRule) matches(to common.Address, payload []byte) (int, bool) {
+	specificity := 0
+	if a.Target != (common.Address{}) {
+		if a.Target != to {
+			return 0, false
+		}
+		specificity += 2
+	}
+	if a.Selector != (FunctionSelector{}) {
+		if len(payload) < len(a.Selector) || !bytes.Equal(payload[:len(a.Selector)], a.Selector[:]) {
+			return 0, false
+		}
+		specificity += 1
+	}
+	return specificity, true
+}
+
+// check applies the rules of the role, defined either at the org or at its
+// ultimate parent. The most specific matching rule wins, deny winning over
+// allow when equally specific. If the role has allow rules, the transaction
+// must match one of them. Rules on functions are skipped when the payload is
+// not call data, so a role only allowing functions denies such transactions
+func (r *AccessRuleCache) check(orgIds []string, roleId string, to common.Address, value *big.Int, payload []byte, callData bool) error {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+
+	var (
+		best        *AccessRule
+		specificity int
+		whitelist   bool
+	)
+	for _, orgId := range orgIds {
+		for _, rule := range r.rules[RoleKey{OrgId: orgId, RoleId: roleId}] {
+			whitelist = whitelist || rule.Allowed
+			if !callData && rule.Selector != (FunctionSelector{}) {
+				continue
+			}
+			s, ok := rule.matches(to, payload)
+			if !ok {
+				continue
+			}
+			if best == nil || s > specificity || (s == specificity && !rule.Allowed) {
+				best, specificity = rule, s
+			}
+		}
+	}
+	switch {
+	case best == nil && whitelist:
+		return ErrAccessRuleDenied
+	case best == nil:
+		return nil
+	case !best.Allowed:
+		return ErrAccessRuleDenied
+	case best.ValueCap != nil && best.ValueCap.Sign() > 0 && value != nil && value.Cmp(best.ValueCap) > 0:
+		return ErrAccessRuleValueCapped
+	}
+	return nil
+}
+
+// CheckAccessRules applies the access rules of the role of the sender to a value
+// transfer or contract call. Admin accounts and contract deployments are not
+// subject to access rules. The payload of a private transaction is the hash of
+// the encrypted payload, so only the rules on its target apply
+func CheckAccessRules(from common.Address, to common.Address, value *big.Int, payload []byte, transactionType TransactionType, private bool) error {
+	if AccessRuleMap == nil || transactionType == ContractDeployTxn || CheckIfAdminAccount(from) {
+		return nil
+	}
+	a, _ := AcctInfoMap.GetAccount(from)
+	if a == nil {
+		return nil
+	}
+	orgIds := []string{a.OrgId}
+	if o, _ := OrgInfoMap.GetOrg(a.OrgId); o != nil && o.UltimateParent != a.OrgId {
+		orgIds = append(orgIds, o.UltimateParent)
+	}
+	return AccessRuleMap.check(orgIds, a.RoleId, to, value, payload, !private)
+}
+
 // Returns the access type for an account. If not found returns
 // default access
 func GetAcctAccess(acctId common.Address) AccessType {
@@ -457,3 +457,101 @@ func Test_checkIfOrgActive(t *testing.T) {
 		})
 	}
 }
+
+func TestAccessRuleCache_check(t *testing.T) {
+	assert := testifyassert.New(t)
+
+	token := common.BytesToAddress([]byte("token"))
+	other := common.BytesToAddress([]byte("other"))
+	transfer := FunctionSelector{0xa9, 0x05, 0x9c, 0xbb}
+	approve := FunctionSelector{0x09, 0x5e, 0xa7, 0xb3}
+	orgs := []string{"SUB1", ORGADMIN}
+
+	rules := NewAccessRuleCache()
+	err := rules.check(orgs, "ROLE1", token, big.NewInt(0), approve[:], true)
+	assert.NoError(err, "Expected no restriction without rules")
+
+	// blacklist a single function of the token
+	rules.UpsertAccessRule(ORGADMIN, "ROLE1", token, approve, false, nil)
+	assert.Equal(ErrAccessRuleDenied, rules.check(orgs, "ROLE1", token, big.NewInt(0), approve[:], true))
+	assert.NoError(rules.check(orgs, "ROLE1", token, big.NewInt(0), transfer[:], true))
+	assert.NoError(rules.check(orgs, "ROLE2", token, big.NewInt(0), approve[:], true), "Expected rules to apply to their role only")
+
+	// whitelist the token with a value cap, other targets are denied
+	rules.UpsertAccessRule("SUB1", "ROLE1", token, FunctionSelector{}, true, big.NewInt(100))
+	assert.Equal(ErrAccessRuleDenied, rules.check(orgs, "ROLE1", other, big.NewInt(0), transfer[:], true))
+	assert.NoError(rules.check(orgs, "ROLE1", token, big.NewInt(100), transfer[:], true))
+	assert.Equal(ErrAccessRuleValueCapped, rules.check(orgs, "ROLE1", token, big.NewInt(101), transfer[:], true))
+	assert.Equal(ErrAccessRuleDenied, rules.check(orgs, "ROLE1", token, big.NewInt(0), approve[:], true), "Expected the more specific deny rule to win")
+
+	// a function allowed on any target is less specific than a rule on a target
+	rules.UpsertAccessRule(ORGADMIN, "ROLE1", common.Address{}, transfer, true, nil)
+	assert.NoError(rules.check(orgs, "ROLE1", other, big.NewInt(1000), transfer[:], true))
+	assert.Equal(ErrAccessRuleValueCapped, rules.check(orgs, "ROLE1", token, big.NewInt(101), transfer[:], true))
+	assert.Equal(ErrAccessRuleDenied, rules.check(orgs, "ROLE1", other, big.NewInt(0), nil, true))
+
+	rules.RemoveAccessRule(ORGADMIN, "ROLE1", token, approve)
+	rules.RemoveAccessRule(ORGADMIN, "ROLE1", common.Address{}, transfer)
+	assert.NoError(rules.check(orgs, "ROLE1", token, big.NewInt(0), approve[:], true))
+	assert.Len(rules.GetAccessRuleList(), 1)
+}
+
+func TestCheckAccessRules(t *testing.T) {
+	assert := testifyassert.New(t)
+
+	SetDefaults(NETWORKADMIN, ORGADMIN, true)
+	SetQIP714BlockReached()
+	OrgInfoMap = NewOrgCache(params.DEFAULT_ORGCACHE_SIZE)
+	RoleInfoMap = NewRoleCache(params.DEFAULT_ROLECACHE_SIZE)
+	AcctInfoMap = NewAcctCache(params.DEFAULT_ACCOUNTCACHE_SIZE)
+	AccessRuleMap = NewAccessRuleCache()
+	defer func() { AccessRuleMap = nil }()
+
+	target := common.BytesToAddress([]byte("target"))
+	OrgInfoMap.UpsertOrg(ORGADMIN, "", ORGADMIN, big.NewInt(1), OrgApproved)
+	RoleInfoMap.UpsertRole(ORGADMIN, ORGADMIN, true, true, FullAccess, true)
+	RoleInfoMap.UpsertRole(ORGADMIN, "ROLE1", false, false, Transact, true)
+	AcctInfoMap.UpsertAccount(ORGADMIN, ORGADMIN, Acct1, true, AcctActive)
+	AcctInfoMap.UpsertAccount(ORGADMIN, "ROLE1", Acct2, false, AcctActive)
+	AccessRuleMap.UpsertAccessRule(ORGADMIN, ORGADMIN, target, FunctionSelector{}, false, nil)
+	AccessRuleMap.UpsertAccessRule(ORGADMIN, "ROLE1", target, FunctionSelector{}, false, nil)
+
+	assert.NoError(CheckAccessRules(Acct1, target, big.NewInt(0), []byte{1, 2, 3, 4}, ContractCallTxn, false), "Expected admin accounts to bypass access rules")
+	assert.Equal(ErrAccessRuleDenied, CheckAccessRules(Acct2, target, big.NewInt(0), []byte{1, 2, 3, 4}, ContractCallTxn, false))
+	assert.NoError(CheckAccessRules(Acct2, common.Address{}, big.NewInt(0), []byte{1, 2, 3, 4}, ContractDeployTxn, false), "Expected contract deployments to bypass access rules")
+}
+
+func TestCheckTransactionAccessRules_whenPrivate(t *testing.T) {
+	assert := testifyassert.New(t)
+
+	SetDefaults(NETWORKADMIN, ORGADMIN, true)
+	SetQIP714BlockReached()
+	OrgInfoMap = NewOrgCache(params.DEFAULT_ORGCACHE_SIZE)
+	RoleInfoMap = NewRoleCache(params.DEFAULT_ROLECACHE_SIZE)
+	AcctInfoMap = NewAcctCache(params.DEFAULT_ACCOUNTCACHE_SIZE)
+	AccessRuleMap = NewAccessRuleCache()
+	defer func() { AccessRuleMap = nil }()
+
+	token := common.BytesToAddress([]byte("token"))
+	other := common.BytesToAddress([]byte("other"))
+	transfer := FunctionSelector{0xa9, 0x05, 0x9c, 0xbb}
+	approve := FunctionSelector{0x09, 0x5e, 0xa7, 0xb3}
+	OrgInfoMap.UpsertOrg(ORGADMIN, "", ORGADMIN, big.NewInt(1), OrgApproved)
+	RoleInfoMap.UpsertRole(ORGADMIN, "ROLE1", false, false, Transact, true)
+	AcctInfoMap.UpsertAccount(ORGADMIN, "ROLE1", Acct2, false, AcctActive)
+	// whitelist a function of the token and deny another one
+	AccessRuleMap.UpsertAccessRule(ORGADMIN, "ROLE1", token, transfer, true, nil)
+	AccessRuleMap.UpsertAccessRule(ORGADMIN, "ROLE1", token, approve, false, nil)
+
+	// the payload of a private transaction is the hash of the encrypted payload
+	payloadHash := common.BytesToEncryptedPayloadHash([]byte("encrypted payload")).Bytes()
+	assert.Equal(ErrAccessRuleDenied, CheckTransactionAccessRules(Acct2, &token, big.NewInt(0), payloadHash, false))
+	assert.Equal(ErrAccessRuleDenied, CheckTransactionAccessRules(Acct2, &token, big.NewInt(0), payloadHash, true), "Expected a role only allowing functions to deny private transactions")
+	assert.Equal(ErrAccessRuleDenied, CheckTransactionAccessRules(Acct2, &token, big.NewInt(0), append(transfer[:], payloadHash...), true), "Expected rules on functions to be skipped for private transactions")
+	assert.Equal(ErrAccessRuleDenied, CheckTransactionAccessRules(Acct2, &other, big.NewInt(0), payloadHash, true))
+
+	// rules on the target apply to private transactions
+	AccessRuleMap.UpsertAccessRule(ORGADMIN, "ROLE1", token, FunctionSelector{}, true, nil)
+	assert.NoError(CheckTransactionAccessRules(Acct2, &token, big.NewInt(0), payloadHash, true))
+	assert.Equal(ErrAccessRuleDenied, CheckTransactionAccessRules(Acct2, &other, big.NewInt(0), payloadHash, true))
+}
@@ -0,0 +1,148 @@
+package core
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Inconsistency is a cache entry which differs from the state of the
+// permission contracts. Cache or Chain is nil if the entry is missing there.
+type Inconsistency struct {
+	Kind  string      `json:"kind"`
+	Key   string      `json:"key"`
+	Cache interface{} `json:"cache"`
+	Chain interface{} `json:"chain"`
+}
+
+// Compare returns the differences between the caches and the permissions read
+// from the contracts. Entries missing from a cache which evicted entries are
+// not reported, those are fetched from the contracts on access. The access
+// rules are not compared if rules is nil.
+func (c *Caches) Compare(orgs []OrgInfo, nodes []NodeInfo, roles []RoleInfo, accounts []AccountInfo, rules []AccessRule) []Inconsistency {
+	var diffs []Inconsistency
+	report := func(kind, key string, cache, chain interface{}) {
+		diffs = append(diffs, Inconsistency{Kind: kind, Key: key, Cache: cache, Chain: chain})
+	}
+
+	seenOrgs := make(map[OrgKey]bool)
+	for i := range orgs {
+		o := &orgs[i]
+		key := OrgKey{OrgId: o.FullOrgId}
+		seenOrgs[key] = true
+		ent, ok := c.Orgs.c.Peek(key)
+		switch {
+		case !ok && !c.Orgs.evicted:
+			report("org", o.FullOrgId, nil, o)
+		case ok:
+			co := ent.(*OrgInfo)
+			if co.Status != o.Status || co.ParentOrgId != o.ParentOrgId || co.UltimateParent != o.UltimateParent || !equalBig(co.Level, o.Level) {
+				report("org", o.FullOrgId, co, o)
+			}
+		}
+	}
+	for _, k := range c.Orgs.c.Keys() {
+		if key := k.(OrgKey); !seenOrgs[key] {
+			ent, _ := c.Orgs.c.Peek(key)
+			report("org", key.OrgId, ent, nil)
+		}
+	}
+
+	seenNodes := make(map[NodeKey]bool)
+	for i := range nodes {
+		n := &nodes[i]
+		key := NodeKey{OrgId: n.OrgId, Url: n.Url}
+		seenNodes[key] = true
+		ent, ok := c.Nodes.c.Peek(key)
+		switch {
+		case !ok && !c.Nodes.evicted:
+			report("node", n.Url, nil, n)
+		case ok && ent.(*NodeInfo).Status != n.Status:
+			report("node", n.Url, ent, n)
+		}
+	}
+	for _, k := range c.Nodes.c.Keys() {
+		if key := k.(NodeKey); !seenNodes[key] {
+			ent, _ := c.Nodes.c.Peek(key)
+			report("node", key.Url, ent, nil)
+		}
+	}
+
+	seenRoles := make(map[RoleKey]bool)
+	for i := range roles {
+		r := &roles[i]
+		key := RoleKey{OrgId: r.OrgId, RoleId: r.RoleId}
+		seenRoles[key] = true
+		ent, ok := c.Roles.c.Peek(key)
+		switch {
+		case !ok && !c.Roles.evicted:
+			report("role", r.OrgId+"/"+r.RoleId, nil, r)
+		case ok && *ent.(*RoleInfo) != *r:
+			report("role", r.OrgId+"/"+r.RoleId, ent, r)
+		}
+	}
+	for _, k := range c.Roles.c.Keys() {
+		if key := k.(RoleKey); !seenRoles[key] {
+			ent, _ := c.Roles.c.Peek(key)
+			report("role", key.OrgId+"/"+key.RoleId, ent, nil)
+		}
+	}
+
+	seenAccounts := make(map[AccountKey]bool)
+	for i := range accounts {
+		a := &accounts[i]
+		key := AccountKey{AcctId: a.AcctId}
+		seenAccounts[key] = true
+		ent, ok := c.Accounts.c.Peek(key)
+		switch {
+		case !ok && !c.Accounts.evicted:
+			report("account", a.AcctId.Hex(), nil, a)
+		case ok && *ent.(*AccountInfo) != *a:
+			report("account", a.AcctId.Hex(), ent, a)
+		}
+	}
+	for _, k := range c.Accounts.c.Keys() {
+		if key := k.(AccountKey); !seenAccounts[key] {
+			ent, _ := c.Accounts.c.Peek(key)
+			report("account", key.AcctId.Hex(), ent, nil)
+		}
+	}
+
+	if rules == nil || c.AccessRules == nil {
+		return diffs
+	}
+	ruleKey := func(r *AccessRule) string {
+		return fmt.Sprintf("%s/%s/%s/%x", r.OrgId, r.RoleId, r.Target.Hex(), r.Selector)
+	}
+	cached := make(map[string]*AccessRule)
+	for _, r := range c.AccessRules.GetAccessRuleList() {
+		r := r
+		cached[ruleKey(&r)] = &r
+	}
+	for i := range rules {
+		r := &rules[i]
+		key := ruleKey(r)
+		cr, ok := cached[key]
+		delete(cached, key)
+		switch {
+		case !ok:
+			report("accessRule", key, nil, r)
+		case cr.Allowed != r.Allowed || !equalBig(cr.ValueCap, r.ValueCap):
+			report("accessRule", key, cr, r)
+		}
+	}
+	for key, cr := range cached {
+		report("accessRule", key, cr, nil)
+	}
+	return diffs
+}
+
+// equalBig compares two numbers, treating nil as zero
+func equalBig(a, b *big.Int) bool {
+	if a == nil {
+		a = new(big.Int)
+	}
+	if b == nil {
+		b = new(big.Int)
+	}
+	return a.Cmp(b) == 0
+}
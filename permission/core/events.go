@@ -0,0 +1,306 @@
+package core
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Event is a decoded event of the permission contracts. The leading
+// underscore of the argument names is trimmed, e.g. _orgId becomes orgId.
+type Event struct {
+	Log  types.Log
+	Name string
+	Args map[string]interface{}
+}
+
+// EventDecoder decodes the logs of the permission contracts. The v1 and v2
+// contracts emit the same events, so the ABIs of either model can be used.
+type EventDecoder struct {
+	contracts map[common.Address]abi.ABI
+}
+
+func NewEventDecoder(contracts map[common.Address]abi.ABI) *EventDecoder {
+	return &EventDecoder{contracts: contracts}
+}
+
+// Addresses returns the addresses of the contracts known to the decoder
+func (d *EventDecoder) Addresses() []common.Address {
+	addresses := make([]common.Address, 0, len(d.contracts))
+	for address := range d.contracts {
+		addresses = append(addresses, address)
+	}
+	return addresses
+}
+
+func (d *EventDecoder) Decode(l types.Log) (*Event, error) {
+	contract, ok := d.contracts[l.Address]
+	if !ok || len(l.Topics) == 0 {
+		return nil, fmt.Errorf("unknown permission event at block %d", l.BlockNumber)
+	}
+	event, err := contract.EventByID(l.Topics[0])
+	if err != nil {
+		return nil, err
+	}
+	raw := make(map[string]interface{})
+	if err := contract.UnpackIntoMap(raw, event.Name, l.Data); err != nil {
+		return nil, err
+	}
+	args := make(map[string]interface{}, len(raw))
+	for name, value := range raw {
+		args[strings.TrimPrefix(name, "_")] = value
+	}
+	return &Event{Log: l, Name: event.Name, Args: args}, nil
+}
+
+func (e *Event) str(name string) string {
+	s, _ := e.Args[name].(string)
+	return s
+}
+
+func (e *Event) num(name string) *big.Int {
+	if n, ok := e.Args[name].(*big.Int); ok {
+		return n
+	}
+	return new(big.Int)
+}
+
+func (e *Event) flag(name string) bool {
+	b, _ := e.Args[name].(bool)
+	return b
+}
+
+func (e *Event) address(name string) common.Address {
+	a, _ := e.Args[name].(common.Address)
+	return a
+}
+
+// nodeUrl returns the url of the node of a node event. The v1 events carry
+// the full enode url, the v2 events its parts.
+func (e *Event) nodeUrl(isRaft bool) string {
+	ip, ok := e.Args["ip"].(string)
+	if !ok {
+		return e.str("enodeId")
+	}
+	port, _ := e.Args["port"].(uint16)
+	raftPort, _ := e.Args["raftport"].(uint16)
+	return GetNodeUrl(e.str("enodeId"), ip, port, raftPort, isRaft)
+}
+
+// NodeChange is a change of the status of a node. A zero status means the
+// node is not known.
+type NodeChange struct {
+	Url  string
+	From NodeStatus
+	To   NodeStatus
+}
+
+// Caches groups the permission caches updated by the events
+type Caches struct {
+	Orgs        *OrgCache
+	Nodes       *NodeCache
+	Roles       *RoleCache
+	Accounts    *AcctCache
+	AccessRules *AccessRuleCache // nil if access rules are not enabled
+}
+
+// GlobalCaches returns the caches used to enforce the permissions
+func GlobalCaches() *Caches {
+	return &Caches{
+		Orgs:        OrgInfoMap,
+		Nodes:       NodeInfoMap,
+		Roles:       RoleInfoMap,
+		Accounts:    AcctInfoMap,
+		AccessRules: AccessRuleMap,
+	}
+}
+
+// Apply updates the caches with a permission event
+func (c *Caches) Apply(e *Event, isRaft bool) {
+	c.apply(e, isRaft)
+}
+
+// apply updates the caches with a permission event. It returns a function
+// reverting the update and the change of node status caused by the event, if
+// any.
+func (c *Caches) apply(e *Event, isRaft bool) (func(), *NodeChange) {
+	switch e.Name {
+	case "OrgPendingApproval", "OrgApproved", "OrgSuspensionRevoked", "OrgSuspended":
+		status := OrgApproved
+		switch e.Name {
+		case "OrgPendingApproval":
+			status = OrgStatus(e.num("status").Uint64())
+		case "OrgSuspended":
+			status = OrgSuspended
+		}
+		orgId, parentId := e.str("orgId"), e.str("porgId")
+		fullOrgId := orgId
+		if parentId != "" {
+			fullOrgId = parentId + "." + orgId
+		}
+		undoOrg, undoParent := c.Orgs.snapshot(fullOrgId), c.Orgs.snapshot(parentId)
+		c.Orgs.UpsertOrg(orgId, parentId, e.str("ultParent"), e.num("level"), status)
+		return func() { undoOrg(); undoParent() }, nil
+
+	case "NodeProposed", "NodeApproved", "NodeActivated", "NodeRecoveryCompleted", "NodeDeactivated", "NodeBlacklisted", "NodeRecoveryInitiated":
+		status := NodeApproved
+		switch e.Name {
+		case "NodeProposed":
+			status = NodePendingApproval
+		case "NodeDeactivated":
+			status = NodeDeactivated
+		case "NodeBlacklisted":
+			status = NodeBlackListed
+		case "NodeRecoveryInitiated":
+			status = NodeRecoveryInitiated
+		}
+		orgId, url := e.str("orgId"), e.nodeUrl(isRaft)
+		undo, from := c.Nodes.snapshot(orgId, url)
+		c.Nodes.UpsertNode(orgId, url, status)
+		return undo, &NodeChange{Url: url, From: from, To: status}
+
+	case "RoleCreated":
+		undo := c.Roles.snapshot(e.str("orgId"), e.str("roleId"))
+		c.Roles.UpsertRole(e.str("orgId"), e.str("roleId"), e.flag("isVoter"), e.flag("isAdmin"), AccessType(e.num("baseAccess").Uint64()), true)
+		return undo, nil
+
+	case "RoleRevoked":
+		undo := c.Roles.snapshot(e.str("orgId"), e.str("roleId"))
+		if r, _ := c.Roles.GetRole(e.str("orgId"), e.str("roleId")); r != nil {
+			c.Roles.UpsertRole(r.OrgId, r.RoleId, r.IsVoter, r.IsAdmin, r.Access, false)
+		}
+		return undo, nil
+
+	case "AccountAccessModified", "AccountAccessRevoked":
+		status := AcctActive
+		if e.Name == "AccountAccessModified" {
+			status = AcctStatus(e.num("status").Uint64())
+		}
+		account := e.address("account")
+		undo := c.Accounts.snapshot(account)
+		c.Accounts.UpsertAccount(e.str("orgId"), e.str("roleId"), account, e.flag("orgAdmin"), status)
+		return undo, nil
+
+	case "AccountStatusChanged":
+		account := e.address("account")
+		undo := c.Accounts.snapshot(account)
+		if ac, _ := c.Accounts.GetAccount(account); ac != nil {
+			c.Accounts.UpsertAccount(e.str("orgId"), ac.RoleId, account, ac.IsOrgAdmin, AcctStatus(e.num("status").Uint64()))
+		}
+		return undo, nil
+
+	case "AccessRuleSet", "AccessRuleRemoved":
+		if c.AccessRules == nil {
+			return func() {}, nil
+		}
+		target := e.address("target")
+		selector, _ := e.Args["selector"].([4]byte)
+		undo := c.AccessRules.snapshot(e.str("orgId"), e.str("roleId"), target, selector)
+		if e.Name == "AccessRuleSet" {
+			c.AccessRules.UpsertAccessRule(e.str("orgId"), e.str("roleId"), target, selector, e.flag("allowed"), e.num("valueCap"))
+		} else {
+			c.AccessRules.RemoveAccessRule(e.str("orgId"), e.str("roleId"), target, selector)
+		}
+		return undo, nil
+	}
+	// events not affecting the caches, e.g. voter events
+	return func() {}, nil
+}
+
+// the snapshot functions below capture a cache entry and return a function
+// restoring it, removing the entry if it did not exist
+
+func (o *OrgCache) snapshot(orgId string) func() {
+	if orgId == "" {
+		return func() {}
+	}
+	key := OrgKey{OrgId: orgId}
+	o.mux.Lock()
+	defer o.mux.Unlock()
+	ent, ok := o.c.Peek(key)
+	var prev OrgInfo
+	if ok {
+		prev = *ent.(*OrgInfo)
+		prev.SubOrgList = append([]string(nil), prev.SubOrgList...)
+	}
+	return func() {
+		o.mux.Lock()
+		defer o.mux.Unlock()
+		if !ok {
+			evicted := o.evicted
+			o.c.Remove(key)
+			o.evicted = evicted
+			return
+		}
+		rec := prev
+		o.c.Add(key, &rec)
+	}
+}
+
+func (n *NodeCache) snapshot(orgId, url string) (func(), NodeStatus) {
+	key := NodeKey{OrgId: orgId, Url: url}
+	ent, ok := n.c.Peek(key)
+	if !ok {
+		return func() {
+			evicted := n.evicted
+			n.c.Remove(key)
+			n.evicted = evicted
+		}, 0
+	}
+	prev := ent.(*NodeInfo)
+	return func() {
+		n.c.Add(key, &NodeInfo{OrgId: prev.OrgId, Url: prev.Url, Status: prev.Status})
+	}, prev.Status
+}
+
+func (r *RoleCache) snapshot(orgId, roleId string) func() {
+	key := RoleKey{OrgId: orgId, RoleId: roleId}
+	ent, ok := r.c.Peek(key)
+	if !ok {
+		return func() {
+			evicted := r.evicted
+			r.c.Remove(key)
+			r.evicted = evicted
+		}
+	}
+	prev := *ent.(*RoleInfo)
+	return func() {
+		rec := prev
+		r.c.Add(key, &rec)
+	}
+}
+
+func (a *AcctCache) snapshot(account common.Address) func() {
+	key := AccountKey{AcctId: account}
+	ent, ok := a.c.Peek(key)
+	if !ok {
+		return func() {
+			evicted := a.evicted
+			a.c.Remove(key)
+			a.evicted = evicted
+		}
+	}
+	prev := *ent.(*AccountInfo)
+	return func() {
+		rec := prev
+		a.c.Add(key, &rec)
+	}
+}
+
+func (a *AccessRuleCache) snapshot(orgId, roleId string, target common.Address, selector FunctionSelector) func() {
+	roleKey, ruleKey := RoleKey{OrgId: orgId, RoleId: roleId}, accessRuleKey{Target: target, Selector: selector}
+	a.mux.RLock()
+	prev, ok := a.rules[roleKey][ruleKey]
+	a.mux.RUnlock()
+	return func() {
+		if ok {
+			a.UpsertAccessRule(prev.OrgId, prev.RoleId, prev.Target, prev.Selector, prev.Allowed, prev.ValueCap)
+		} else {
+			a.RemoveAccessRule(orgId, roleId, target, selector)
+		}
+	}
+}
@@ -0,0 +1,297 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+)
+
+// nodeFileDebounce delays the reload after a file change so that the events
+// of a single update cause a single reload
+const nodeFileDebounce = 500 * time.Millisecond
+
+// nodeFileResolveInterval is how often the hostname entries of the node files
+// are resolved again, so that a host moving to another IP is followed
+var nodeFileResolveInterval = time.Minute
+
+// NodeFilesReloaded is sent on NodeFilesFeed after the node files of a data
+// directory were reloaded following a change
+type NodeFilesReloaded struct {
+	DataDir        string
+	PermissionFile string
+}
+
+// NodeFilesFeed notifies the reloads of the node files, so that connected
+// peers which are no longer permissioned can be disconnected
+var NodeFilesFeed event.Feed
+
+var (
+	nodeFileReloadCounter = metrics.NewRegisteredCounter("permission/nodefile/reloads", nil)
+	nodeFileErrorCounter  = metrics.NewRegisteredCounter("permission/nodefile/errors", nil)
+	permissionedGauge     = metrics.NewRegisteredGauge("permission/nodefile/permissioned", nil)
+	disallowedGauge       = metrics.NewRegisteredGauge("permission/nodefile/disallowed", nil)
+)
+
+// lookupHost resolves the hostname entries of the node files
+var lookupHost = net.LookupHost
+
+// nodeList is a parsed node file. An entry is an enode url, or id, matching
+// the node id, a CIDR block matching the node IP, or an IP or hostname
+// matching the node IP. Hostnames are resolved when the file is loaded and
+// every nodeFileResolveInterval after.
+type nodeList struct {
+	ids   map[enode.ID]bool
+	nets  []*net.IPNet
+	ips   map[string]bool
+	hosts map[string]map[string]bool // resolved IPs by hostname
+	all   bool                       // matches any node, used for a disallowed file which can not be read
+}
+
+func newNodeList() *nodeList {
+	return &nodeList{ids: make(map[enode.ID]bool), ips: make(map[string]bool), hosts: make(map[string]map[string]bool)}
+}
+
+func parseNodeList(blob []byte) (*nodeList, error) {
+	var entries []string
+	if err := json.Unmarshal(blob, &entries); err != nil {
+		return nil, err
+	}
+	l := newNodeList()
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		switch {
+		case entry == "":
+			log.Error("parseNodeList: Node URL blank")
+		case strings.HasPrefix(entry, "enode://") || len(entry) == 128:
+			node, err := enode.ParseV4(entry)
+			if err != nil {
+				log.Error("parseNodeList: Node URL", "url", entry, "err", err)
+				continue
+			}
+			l.ids[node.ID()] = true
+		case strings.Contains(entry, "/"):
+			_, ipNet, err := net.ParseCIDR(entry)
+			if err != nil {
+				log.Error("parseNodeList: CIDR", "cidr", entry, "err", err)
+				continue
+			}
+			l.nets = append(l.nets, ipNet)
+		case net.ParseIP(entry) != nil:
+			l.ips[net.ParseIP(entry).String()] = true
+		default:
+			l.hosts[entry] = resolveHost(entry, nil)
+		}
+	}
+	return l, nil
+}
+
+// resolveHost returns the IPs of the host, or the given previous ones if it
+// can not be resolved
+func resolveHost(host string, prev map[string]bool) map[string]bool {
+	addrs, err := lookupHost(host)
+	if err != nil {
+		log.Error("parseNodeList: Hostname", "host", host, "err", err)
+		return prev
+	}
+	ips := make(map[string]bool)
+	for _, addr := range addrs {
+		if ip := net.ParseIP(addr); ip != nil {
+			ips[ip.String()] = true
+		}
+	}
+	return ips
+}
+
+// resolveHosts returns a copy of the list with its hostnames resolved again,
+// and whether any of them changed
+func (l *nodeList) resolveHosts() (*nodeList, bool) {
+	if len(l.hosts) == 0 {
+		return l, false
+	}
+	next := *l
+	next.hosts = make(map[string]map[string]bool, len(l.hosts))
+	changed := false
+	for host, prev := range l.hosts {
+		ips := resolveHost(host, prev)
+		next.hosts[host] = ips
+		changed = changed || !sameIPs(prev, ips)
+	}
+	return &next, changed
+}
+
+func sameIPs(a, b map[string]bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for ip := range a {
+		if !b[ip] {
+			return false
+		}
+	}
+	return true
+}
+
+// contains checks if the list matches the node, ip is nil if not known
+func (l *nodeList) contains(id enode.ID, ip net.IP) bool {
+	if l.all || l.ids[id] {
+		return true
+	}
+	if ip == nil {
+		return false
+	}
+	if l.ips[ip.String()] {
+		return true
+	}
+	for _, ips := range l.hosts {
+		if ips[ip.String()] {
+			return true
+		}
+	}
+	for _, ipNet := range l.nets {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *nodeList) size() int {
+	return len(l.ids) + len(l.nets) + len(l.ips) + len(l.hosts)
+}
+
+// nodeFiles holds the parsed node files of a data directory. It is replaced
+// as a whole on reload.
+type nodeFiles struct {
+	permissioned *nodeList
+	disallowed   *nodeList
+}
+
+// nodeFileWatch loads the node files of a data directory and reloads them
+// when they change
+type nodeFileWatch struct {
+	fbp     *FileBasedPermissioning
+	dataDir string
+	files   atomic.Value // *nodeFiles
+	quit    chan struct{}
+}
+
+// nodeFiles returns the parsed node files of the data directory, loading them
+// and starting to watch them on first use. The files are loaded without holding
+// the lock, as resolving their hostnames may take a while, so that the checks of
+// the nodes of other data directories are not held up.
+func (fbp *FileBasedPermissioning) nodeFiles(dataDir string) *nodeFiles {
+	fbp.mu.Lock()
+	w, ok := fbp.watches[dataDir]
+	fbp.mu.Unlock()
+	if ok {
+		return w.files.Load().(*nodeFiles)
+	}
+	w = &nodeFileWatch{fbp: fbp, dataDir: dataDir, quit: make(chan struct{})}
+	w.reload()
+
+	fbp.mu.Lock()
+	defer fbp.mu.Unlock()
+	// another check may have loaded the files in the meantime
+	if loaded, ok := fbp.watches[dataDir]; ok {
+		return loaded.files.Load().(*nodeFiles)
+	}
+	if fbp.watches == nil {
+		fbp.watches = make(map[string]*nodeFileWatch)
+	}
+	fbp.watches[dataDir] = w
+	go w.loop()
+	return w.files.Load().(*nodeFiles)
+}
+
+// reload parses the node files and replaces the loaded ones. A file which can
+// not be read or parsed keeps its previous content. If there is none, the
+// permissioned file permissions no node and the disallowed file disallows all
+// nodes, failing closed.
+func (w *nodeFileWatch) reload() {
+	prev, _ := w.files.Load().(*nodeFiles)
+	next := &nodeFiles{}
+
+	permissioned, err := w.readFile(w.fbp.PermissionFile)
+	switch {
+	case os.IsNotExist(err):
+		log.Error("Read Error for permissioned-nodes file. This is because 'permissioned' flag is specified but no permissioned-nodes file is present.", "fileName", w.fbp.PermissionFile, "err", err)
+		next.permissioned = newNodeList()
+	case err != nil:
+		log.Error("Failed to load permissioned-nodes file", "fileName", w.fbp.PermissionFile, "err", err)
+		nodeFileErrorCounter.Inc(1)
+		next.permissioned = newNodeList()
+		if prev != nil {
+			next.permissioned = prev.permissioned
+		}
+	default:
+		next.permissioned = permissioned
+	}
+
+	disallowed, err := w.readFile(w.fbp.DisallowedFile)
+	switch {
+	case os.IsNotExist(err):
+		log.Debug("Read Error for disallowed-nodes file. disallowed-nodes file is not present.", "fileName", w.fbp.DisallowedFile, "err", err)
+		next.disallowed = newNodeList()
+	case err != nil:
+		log.Error("Failed to load disallowed-nodes file", "fileName", w.fbp.DisallowedFile, "err", err)
+		nodeFileErrorCounter.Inc(1)
+		next.disallowed = &nodeList{all: true}
+		if prev != nil {
+			next.disallowed = prev.disallowed
+		}
+	default:
+		next.disallowed = disallowed
+	}
+
+	w.files.Store(next)
+	permissionedGauge.Update(int64(next.permissioned.size()))
+	disallowedGauge.Update(int64(next.disallowed.size()))
+	if prev != nil {
+		nodeFileReloadCounter.Inc(1)
+		log.Info("Reloaded node permission files", "dataDir", w.dataDir, "permissioned", next.permissioned.size(), "disallowed", next.disallowed.size())
+		NodeFilesFeed.Send(NodeFilesReloaded{DataDir: w.dataDir, PermissionFile: w.fbp.PermissionFile})
+	}
+}
+
+// resolve resolves the hostnames of the loaded node files again. The peers
+// are checked again if any of them moved.
+func (w *nodeFileWatch) resolve() {
+	prev := w.files.Load().(*nodeFiles)
+	permissioned, permissionedChanged := prev.permissioned.resolveHosts()
+	disallowed, disallowedChanged := prev.disallowed.resolveHosts()
+	if !permissionedChanged && !disallowedChanged {
+		return
+	}
+	w.files.Store(&nodeFiles{permissioned: permissioned, disallowed: disallowed})
+	log.Info("Node permission file hostnames resolved to new IPs", "dataDir", w.dataDir)
+	NodeFilesFeed.Send(NodeFilesReloaded{DataDir: w.dataDir, PermissionFile: w.fbp.PermissionFile})
+}
+
+func (w *nodeFileWatch) readFile(fileName string) (*nodeList, error) {
+	blob, err := ioutil.ReadFile(filepath.Join(w.dataDir, fileName))
+	if err != nil {
+		return nil, err
+	}
+	l, err := parseNodeList(blob)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", fileName, err)
+	}
+	return l, nil
+}
+
+// isNodeFile checks if the path is one of the watched node files
+func (w *nodeFileWatch) isNodeFile(path string) bool {
+	name := filepath.Base(path)
+	return name == w.fbp.PermissionFile || name == w.fbp.DisallowedFile
+}
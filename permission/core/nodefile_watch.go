@@ -0,0 +1,55 @@
+//go:build (darwin && !ios && cgo) || freebsd || (linux && !arm64) || netbsd || solaris
+// +build darwin,!ios,cgo freebsd linux,!arm64 netbsd solaris
+
+package core
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/rjeczalik/notify"
+)
+
+// loop reloads the node files when the file system reports a change of
+// either of them, and resolves their hostnames periodically. The data
+// directory is watched, not the files, to catch files replaced by a rename.
+func (w *nodeFileWatch) loop() {
+	logger := log.New("path", w.dataDir)
+
+	ev := make(chan notify.EventInfo, 10)
+	if err := notify.Watch(w.dataDir, ev, notify.All); err != nil {
+		logger.Warn("Failed to watch node permission files, changes need a restart", "err", err)
+		return
+	}
+	defer notify.Stop(ev)
+	logger.Trace("Started watching node permission files")
+	defer logger.Trace("Stopped watching node permission files")
+
+	var (
+		reloadTriggered = false
+		debounce        = time.NewTimer(0)
+		resolve         = time.NewTicker(nodeFileResolveInterval)
+	)
+	defer resolve.Stop()
+	// Ignore initial trigger
+	if !debounce.Stop() {
+		<-debounce.C
+	}
+	defer debounce.Stop()
+	for {
+		select {
+		case <-w.quit:
+			return
+		case e := <-ev:
+			if !reloadTriggered && w.isNodeFile(e.Path()) {
+				debounce.Reset(nodeFileDebounce)
+				reloadTriggered = true
+			}
+		case <-debounce.C:
+			w.reload()
+			reloadTriggered = false
+		case <-resolve.C:
+			w.resolve()
+		}
+	}
+}
@@ -0,0 +1,45 @@
+//go:build (darwin && !cgo) || ios || (linux && arm64) || windows || (!darwin && !freebsd && !linux && !netbsd && !solaris)
+// +build darwin,!cgo ios linux,arm64 windows !darwin,!freebsd,!linux,!netbsd,!solaris
+
+// This is the fallback implementation of node file watching, polling the
+// modification time of the files. It is used on unsupported platforms.
+
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const nodeFilePollInterval = 5 * time.Second
+
+func (w *nodeFileWatch) loop() {
+	modTimes := func() [2]time.Time {
+		var times [2]time.Time
+		for i, name := range []string{w.fbp.PermissionFile, w.fbp.DisallowedFile} {
+			if fi, err := os.Stat(filepath.Join(w.dataDir, name)); err == nil {
+				times[i] = fi.ModTime()
+			}
+		}
+		return times
+	}
+	last := modTimes()
+	ticker := time.NewTicker(nodeFilePollInterval)
+	defer ticker.Stop()
+	resolve := time.NewTicker(nodeFileResolveInterval)
+	defer resolve.Stop()
+	for {
+		select {
+		case <-w.quit:
+			return
+		case <-ticker.C:
+			if current := modTimes(); current != last {
+				last = current
+				w.reload()
+			}
+		case <-resolve.C:
+			w.resolve()
+		}
+	}
+}
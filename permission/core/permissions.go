@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"math/big"
+	"net"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
@@ -13,18 +15,25 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 )
 
+// FileBasedPermissioning permissions the nodes listed in the permissioned
+// nodes file of the data directory, unless listed in the disallowed nodes
+// file. The files of a data directory are parsed on the first check and
+// reloaded when they change.
 type FileBasedPermissioning struct {
 	PermissionFile string
 	DisallowedFile string
+
+	mu      sync.Mutex
+	watches map[string]*nodeFileWatch // by data directory
 }
 
-var defaultFileBasedPermissioning = FileBasedPermissioning{
+var defaultFileBasedPermissioning = &FileBasedPermissioning{
 	PermissionFile: params.PERMISSIONED_CONFIG,
 	DisallowedFile: params.DISALLOWED_CONFIG,
 }
 
-func NewFileBasedPermissoningWithPrefix(prefix string) FileBasedPermissioning {
-	return FileBasedPermissioning{
+func NewFileBasedPermissoningWithPrefix(prefix string) *FileBasedPermissioning {
+	return &FileBasedPermissioning{
 		PermissionFile: prefix + "-" + params.PERMISSIONED_CONFIG,
 		DisallowedFile: prefix + "-" + params.DISALLOWED_CONFIG,
 	}
@@ -34,34 +43,67 @@ func IsNodePermissioned(nodename string, currentNode string, datadir string, dir
 	return defaultFileBasedPermissioning.IsNodePermissioned(nodename, currentNode, datadir, direction)
 }
 
+// IsNodePermissionedEnode checks the node against the default node files,
+// matching CIDR and hostname entries against the IP of the node
+func IsNodePermissionedEnode(node *enode.Node, nodename string, currentNode string, datadir string, direction string) bool {
+	return defaultFileBasedPermissioning.IsNodePermissionedEnode(node, nodename, currentNode, datadir, direction)
+}
+
 func isNodeDisallowed(nodeName, dataDir string) bool {
 	return defaultFileBasedPermissioning.isNodeDisallowed(nodeName, dataDir)
 }
 
 func (fbp *FileBasedPermissioning) IsNodePermissionedEnode(node *enode.Node, nodename string, currentNode string, datadir string, direction string) bool {
-	return fbp.IsNodePermissioned(nodename, currentNode, datadir, direction)
+	return fbp.isPermissioned(node.ID(), node.IP(), nodename, currentNode, datadir, direction)
 }
 
-// check if a given node is permissioned to connect to the change
+// check if a given node is permissioned to connect to the change. Only the
+// node id is known, so CIDR and hostname entries do not match.
 func (fbp *FileBasedPermissioning) IsNodePermissioned(nodename string, currentNode string, datadir string, direction string) bool {
-	var permissionedList []string
-	nodes := fbp.ParsePermissionedNodes(datadir)
-	for _, v := range nodes {
-		permissionedList = append(permissionedList, v.ID().String())
+	id, err := enode.ParseID(nodename)
+	if err != nil {
+		log.Debug("IsNodePermissioned", "nodename", nodename, "err", err)
+		return false
 	}
+	return fbp.isPermissioned(id, nil, nodename, currentNode, datadir, direction)
+}
 
-	log.Debug("IsNodePermissioned", "permissionedList", permissionedList)
-	for _, v := range permissionedList {
-		if v == nodename {
-			log.Debug("IsNodePermissioned", "connection", direction, "nodename", nodename[:params.NODE_NAME_LENGTH], "ALLOWED-BY", currentNode[:params.NODE_NAME_LENGTH])
-			// check if the node is disallowed
-			return !fbp.isNodeDisallowed(nodename, datadir)
-		}
+func (fbp *FileBasedPermissioning) isPermissioned(id enode.ID, ip net.IP, nodename string, currentNode string, datadir string, direction string) bool {
+	files := fbp.nodeFiles(datadir)
+	if files.permissioned.contains(id, ip) {
+		log.Debug("IsNodePermissioned", "connection", direction, "nodename", nodename[:params.NODE_NAME_LENGTH], "ALLOWED-BY", currentNode[:params.NODE_NAME_LENGTH])
+		// check if the node is disallowed
+		return !files.disallowed.contains(id, ip)
 	}
 	log.Debug("IsNodePermissioned", "connection", direction, "nodename", nodename[:params.NODE_NAME_LENGTH], "DENIED-BY", currentNode[:params.NODE_NAME_LENGTH])
 	return false
 }
 
+// StopWatchingNodeFiles stops watching the default node files of the data
+// directory. The files are loaded again if a node is checked after.
+func StopWatchingNodeFiles(datadir string) {
+	defaultFileBasedPermissioning.stopWatching(datadir)
+}
+
+// Close stops watching the node files
+func (fbp *FileBasedPermissioning) Close() {
+	fbp.mu.Lock()
+	defer fbp.mu.Unlock()
+	for dataDir, w := range fbp.watches {
+		close(w.quit)
+		delete(fbp.watches, dataDir)
+	}
+}
+
+func (fbp *FileBasedPermissioning) stopWatching(dataDir string) {
+	fbp.mu.Lock()
+	defer fbp.mu.Unlock()
+	if w, ok := fbp.watches[dataDir]; ok {
+		close(w.quit)
+		delete(fbp.watches, dataDir)
+	}
+}
+
 //this is a shameless copy from the config.go. It is a duplication of the code
 //for the timebeing to allow reload of the permissioned nodes while the server is running
 
@@ -104,33 +146,11 @@ func (fbp *FileBasedPermissioning) ParsePermissionedNodes(DataDir string) []*eno
 
 // This function checks if the node is disallowed
 func (fbp *FileBasedPermissioning) isNodeDisallowed(nodeName, dataDir string) bool {
-	log.Debug("isNodeDisallowed", "DataDir", dataDir, "file", fbp.DisallowedFile)
-
-	path := filepath.Join(dataDir, fbp.DisallowedFile)
-	if _, err := os.Stat(path); err != nil {
-		log.Debug("Read Error for disallowed-nodes file. disallowed-nodes file is not present.", "fileName", fbp.DisallowedFile, "err", err)
-		return false
-	}
-	// Load the nodes from the config file
-	blob, err := ioutil.ReadFile(path)
+	id, err := enode.ParseID(nodeName)
 	if err != nil {
-		log.Debug("isNodeDisallowed: Failed to access nodes", "err", err)
 		return true
 	}
-
-	nodelist := []string{}
-	if err := json.Unmarshal(blob, &nodelist); err != nil {
-		log.Debug("parsePermissionedNodes: Failed to load nodes", "err", err)
-		return true
-	}
-
-	for _, v := range nodelist {
-		n, _ := enode.ParseV4(v)
-		if nodeName == n.ID().String() {
-			return true
-		}
-	}
-	return false
+	return fbp.nodeFiles(dataDir).disallowed.contains(id, nil)
 }
 
 // function checks for account access to execute the transaction
@@ -153,3 +173,17 @@ func CheckAccountPermission(from common.Address, to *common.Address, value *big.
 
 	return IsTransactionAllowed(from, toAcct, value, gasPrice, big.NewInt(int64(gas)), data, transactionType)
 }
+
+// function checks the contract and function level access rules of the sender
+// account. Access rules are part of block validation once the chain config names
+// the contract holding them, otherwise they only filter the transaction pool
+func CheckTransactionAccessRules(from common.Address, to *common.Address, value *big.Int, data []byte, private bool) error {
+	if to == nil {
+		return CheckAccessRules(from, common.Address{}, value, data, ContractDeployTxn, private)
+	}
+	transactionType := ValueTransferTxn
+	if data != nil {
+		transactionType = ContractCallTxn
+	}
+	return CheckAccessRules(from, *to, value, data, transactionType, private)
+}
@@ -2,10 +2,13 @@ package core
 
 import (
 	"encoding/json"
+	"errors"
 	"io/ioutil"
+	"net"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/params"
@@ -102,6 +105,197 @@ func Test_isNodeBlackListed(t *testing.T) {
 	}
 }
 
+func TestFileBasedPermissioning_Patterns(t *testing.T) {
+	d, _ := ioutil.TempDir("", "qdata")
+	defer os.RemoveAll(d)
+	defer func(f func(string) ([]string, error)) { lookupHost = f }(lookupHost)
+	lookupHost = func(host string) ([]string, error) {
+		if host == "node.example.com" {
+			return []string{"10.1.0.7"}, nil
+		}
+		return nil, errors.New("no such host")
+	}
+	writeNodeToFile(d, params.PERMISSIONED_CONFIG, "10.0.0.0/16")
+	writeNodeToFile(d, params.PERMISSIONED_CONFIG, "node.example.com")
+	writeNodeToFile(d, params.PERMISSIONED_CONFIG, "unknown.example.com")
+	writeNodeToFile(d, params.DISALLOWED_CONFIG, "10.0.0.9")
+
+	fbp := NewFileBasedPermissoningWithPrefix("test")
+	fbp.PermissionFile, fbp.DisallowedFile = params.PERMISSIONED_CONFIG, params.DISALLOWED_CONFIG
+	defer fbp.Close()
+
+	n1, _ := enode.ParseV4(node1)
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{"10.0.3.4", true},
+		{"10.1.0.7", true},
+		{"10.1.0.8", false},
+		{"10.0.0.9", false},
+	}
+	for _, tt := range tests {
+		node := enode.NewV4(n1.Pubkey(), net.ParseIP(tt.ip), 21000, 0)
+		if got := fbp.IsNodePermissionedEnode(node, n1.ID().String(), n1.ID().String(), d, "INWARD"); got != tt.want {
+			t.Errorf("IsNodePermissionedEnode(%s) = %v, want %v", tt.ip, got, tt.want)
+		}
+	}
+	// patterns need the IP of the node
+	if fbp.IsNodePermissioned(n1.ID().String(), n1.ID().String(), d, "INWARD") {
+		t.Error("node permissioned without IP")
+	}
+
+	// hostnames follow the host to its new IP, and keep the last one if
+	// the host can not be resolved
+	reloads := make(chan NodeFilesReloaded, 10)
+	sub := NodeFilesFeed.Subscribe(reloads)
+	defer sub.Unsubscribe()
+	fbp.mu.Lock()
+	w := fbp.watches[d]
+	fbp.mu.Unlock()
+	lookupHost = func(host string) ([]string, error) {
+		if host == "node.example.com" {
+			return []string{"10.1.0.8"}, nil
+		}
+		return nil, errors.New("no such host")
+	}
+	w.resolve()
+	select {
+	case <-reloads:
+	case <-time.After(time.Second):
+		t.Fatal("no reload event after the host moved")
+	}
+	lookupHost = func(string) ([]string, error) { return nil, errors.New("no such host") }
+	w.resolve()
+	for ip, want := range map[string]bool{"10.1.0.7": false, "10.1.0.8": true} {
+		node := enode.NewV4(n1.Pubkey(), net.ParseIP(ip), 21000, 0)
+		if got := fbp.IsNodePermissionedEnode(node, n1.ID().String(), n1.ID().String(), d, "INWARD"); got != want {
+			t.Errorf("IsNodePermissionedEnode(%s) = %v after resolve, want %v", ip, got, want)
+		}
+	}
+	select {
+	case <-reloads:
+		t.Error("unexpected reload event for unchanged hosts")
+	default:
+	}
+}
+
+func TestFileBasedPermissioning_slowLookup(t *testing.T) {
+	slow, _ := ioutil.TempDir("", "qdata")
+	defer os.RemoveAll(slow)
+	d, _ := ioutil.TempDir("", "qdata")
+	defer os.RemoveAll(d)
+	writeNodeToFile(slow, params.PERMISSIONED_CONFIG, "node.example.com")
+	writeNodeToFile(d, params.PERMISSIONED_CONFIG, node1)
+
+	defer func(f func(string) ([]string, error)) { lookupHost = f }(lookupHost)
+	lookups, release := make(chan struct{}, 1), make(chan struct{})
+	lookupHost = func(host string) ([]string, error) {
+		select {
+		case lookups <- struct{}{}:
+		default:
+		}
+		<-release
+		return []string{"10.1.0.7"}, nil
+	}
+	fbp := NewFileBasedPermissoningWithPrefix("test")
+	fbp.PermissionFile, fbp.DisallowedFile = params.PERMISSIONED_CONFIG, params.DISALLOWED_CONFIG
+	defer fbp.Close()
+
+	n1, _ := enode.ParseV4(node1)
+	done := make(chan bool)
+	go func() {
+		node := enode.NewV4(n1.Pubkey(), net.ParseIP("10.1.0.7"), 21000, 0)
+		done <- fbp.IsNodePermissionedEnode(node, n1.ID().String(), n1.ID().String(), slow, "INWARD")
+	}()
+	<-lookups
+
+	// the checks of the other data directories go on while the host is resolved
+	if !fbp.IsNodePermissioned(n1.ID().String(), n1.ID().String(), d, "INWARD") {
+		t.Error("node not permissioned")
+	}
+	close(release)
+	if !<-done {
+		t.Error("node of the resolved host not permissioned")
+	}
+}
+
+func TestStopWatchingNodeFiles(t *testing.T) {
+	d, _ := ioutil.TempDir("", "qdata")
+	defer os.RemoveAll(d)
+	writeNodeToFile(d, params.PERMISSIONED_CONFIG, node1)
+
+	n1, _ := enode.ParseV4(node1)
+	if !IsNodePermissioned(n1.ID().String(), n1.ID().String(), d, "INWARD") {
+		t.Fatal("node not permissioned")
+	}
+	defaultFileBasedPermissioning.mu.Lock()
+	w := defaultFileBasedPermissioning.watches[d]
+	defaultFileBasedPermissioning.mu.Unlock()
+	if w == nil {
+		t.Fatal("node files not watched")
+	}
+
+	StopWatchingNodeFiles(d)
+	select {
+	case <-w.quit:
+	default:
+		t.Error("watch not stopped")
+	}
+	defaultFileBasedPermissioning.mu.Lock()
+	defer defaultFileBasedPermissioning.mu.Unlock()
+	if _, ok := defaultFileBasedPermissioning.watches[d]; ok {
+		t.Error("watch not removed")
+	}
+}
+
+func TestFileBasedPermissioning_Reload(t *testing.T) {
+	d, _ := ioutil.TempDir("", "qdata")
+	defer os.RemoveAll(d)
+	writeNodeToFile(d, params.PERMISSIONED_CONFIG, node1)
+
+	fbp := NewFileBasedPermissoningWithPrefix("test")
+	fbp.PermissionFile, fbp.DisallowedFile = params.PERMISSIONED_CONFIG, params.DISALLOWED_CONFIG
+	defer fbp.Close()
+
+	reloads := make(chan NodeFilesReloaded, 10)
+	sub := NodeFilesFeed.Subscribe(reloads)
+	defer sub.Unsubscribe()
+
+	n1, _ := enode.ParseV4(node1)
+	if !fbp.IsNodePermissioned(n1.ID().String(), n1.ID().String(), d, "INWARD") {
+		t.Fatal("node not permissioned")
+	}
+
+	// a file which can not be parsed keeps the loaded nodes
+	path := filepath.Join(d, params.PERMISSIONED_CONFIG)
+	_ = ioutil.WriteFile(path, []byte("[\"enode://"), 0644)
+	reload := func() {
+		fbp.mu.Lock()
+		w := fbp.watches[d]
+		fbp.mu.Unlock()
+		w.reload()
+		select {
+		case ev := <-reloads:
+			if ev.DataDir != d {
+				t.Errorf("reload event data dir = %s, want %s", ev.DataDir, d)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("no reload event")
+		}
+	}
+	reload()
+	if !fbp.IsNodePermissioned(n1.ID().String(), n1.ID().String(), d, "INWARD") {
+		t.Error("node not permissioned after failed reload")
+	}
+
+	_ = ioutil.WriteFile(path, []byte("[]"), 0644)
+	reload()
+	if fbp.IsNodePermissioned(n1.ID().String(), n1.ID().String(), d, "INWARD") {
+		t.Error("node permissioned after removal")
+	}
+}
+
 func writeNodeToFile(dataDir, fileName, url string) {
 	fileExists := true
 	path := filepath.Join(dataDir, fileName)
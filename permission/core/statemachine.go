@@ -0,0 +1,173 @@
+package core
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// maxReorgDepth is the number of blocks for which applied events are kept
+// so that they can be reverted on a chain reorg
+const maxReorgDepth = 128
+
+type journalEntry struct {
+	log    types.Log
+	undo   func()
+	change *NodeChange
+}
+
+// StateMachine updates the permission caches from the logs of the permission
+// contracts. An event is applied once its block has the configured number of
+// confirmations. Logs removed by a chain reorg are dropped if not applied yet,
+// otherwise the cache updates of the removed log and of all later logs are
+// reverted, in reverse order. Later logs of the new chain are delivered again.
+type StateMachine struct {
+	mu            sync.Mutex
+	caches        *Caches
+	decoder       *EventDecoder
+	isRaft        bool
+	confirmations uint64
+	head          uint64
+
+	pending []*Event
+	journal []journalEntry
+
+	// called on every change of node status, including reverted ones
+	onNodeChange func(NodeChange)
+}
+
+func NewStateMachine(caches *Caches, decoder *EventDecoder, confirmations uint64, isRaft bool, onNodeChange func(NodeChange)) *StateMachine {
+	return &StateMachine{
+		caches:        caches,
+		decoder:       decoder,
+		isRaft:        isRaft,
+		confirmations: confirmations,
+		onNodeChange:  onNodeChange,
+	}
+}
+
+// AddLog queues a log of the permission contracts, or reverts it if the log
+// was removed by a reorg
+func (s *StateMachine) AddLog(l types.Log) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if l.Removed {
+		s.remove(l)
+		return
+	}
+	// logs delivered both by the catch up and by the subscription
+	if s.known(&l) {
+		return
+	}
+	e, err := s.decoder.Decode(l)
+	if err != nil {
+		log.Error("failed to decode permission event", "block", l.BlockNumber, "tx", l.TxHash, "err", err)
+		return
+	}
+	s.pending = append(s.pending, e)
+	s.applyConfirmed()
+}
+
+// SetHead sets the current chain head and applies the events it confirms
+func (s *StateMachine) SetHead(number uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.head = number
+	s.applyConfirmed()
+
+	// applied events deeper than the reorg depth can no longer be reverted
+	prune := 0
+	for prune < len(s.journal) && s.journal[prune].log.BlockNumber+maxReorgDepth < number {
+		prune++
+	}
+	s.journal = append(s.journal[:0:0], s.journal[prune:]...)
+}
+
+// ReplayFrom returns the first block from which the logs must be delivered
+// again after some may have been missed. The logs of the later blocks already
+// delivered are recognized as long as the head does not move on
+func (s *StateMachine) ReplayFrom() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.head < maxReorgDepth {
+		return 0
+	}
+	return s.head - maxReorgDepth
+}
+
+// Pending returns the number of events waiting for confirmations
+func (s *StateMachine) Pending() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.pending)
+}
+
+func (s *StateMachine) applyConfirmed() {
+	applied := 0
+	for _, e := range s.pending {
+		if e.Log.BlockNumber+s.confirmations > s.head {
+			break
+		}
+		undo, change := s.caches.apply(e, s.isRaft)
+		s.journal = append(s.journal, journalEntry{log: e.Log, undo: undo, change: change})
+		if change != nil {
+			s.notify(*change)
+		}
+		applied++
+	}
+	s.pending = s.pending[applied:]
+}
+
+func (s *StateMachine) remove(l types.Log) {
+	for i, e := range s.pending {
+		if sameLog(&e.Log, &l) {
+			s.pending = append(s.pending[:i], s.pending[i+1:]...)
+			return
+		}
+	}
+	for i := len(s.journal) - 1; i >= 0; i-- {
+		if !sameLog(&s.journal[i].log, &l) {
+			continue
+		}
+		log.Info("reverting permission events removed by chain reorg", "block", l.BlockNumber, "count", len(s.journal)-i)
+		for j := len(s.journal) - 1; j >= i; j-- {
+			entry := s.journal[j]
+			entry.undo()
+			if entry.change != nil {
+				s.notify(NodeChange{Url: entry.change.Url, From: entry.change.To, To: entry.change.From})
+			}
+		}
+		s.journal = s.journal[:i]
+		return
+	}
+	// already reverted along with an earlier log, or beyond the reorg depth
+	log.Debug("removed permission event not found", "block", l.BlockNumber, "tx", l.TxHash)
+}
+
+func (s *StateMachine) known(l *types.Log) bool {
+	for _, e := range s.pending {
+		if sameLog(&e.Log, l) {
+			return true
+		}
+	}
+	for i := range s.journal {
+		if sameLog(&s.journal[i].log, l) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *StateMachine) notify(change NodeChange) {
+	if s.onNodeChange != nil && change.From != change.To {
+		s.onNodeChange(change)
+	}
+}
+
+func sameLog(a, b *types.Log) bool {
+	return a.BlockHash == b.BlockHash && a.TxHash == b.TxHash && a.Index == b.Index
+}
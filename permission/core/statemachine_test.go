@@ -0,0 +1,165 @@
+package core
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/permission/internal/testutils"
+	testifyassert "github.com/stretchr/testify/assert"
+)
+
+var (
+	orgContract  = common.HexToAddress("0x01")
+	nodeContract = common.HexToAddress("0x02")
+	roleContract = common.HexToAddress("0x03")
+
+	// the events of the v1 contracts used by the tests, the bind packages
+	// can not be imported here
+	orgEvents = parseABI(`[
+		{"type":"event","name":"OrgPendingApproval","inputs":[{"name":"_orgId","type":"string"},{"name":"_porgId","type":"string"},{"name":"_ultParent","type":"string"},{"name":"_level","type":"uint256"},{"name":"_status","type":"uint256"}]},
+		{"type":"event","name":"OrgApproved","inputs":[{"name":"_orgId","type":"string"},{"name":"_porgId","type":"string"},{"name":"_ultParent","type":"string"},{"name":"_level","type":"uint256"},{"name":"_status","type":"uint256"}]}]`)
+	nodeEvents = parseABI(`[
+		{"type":"event","name":"NodeProposed","inputs":[{"name":"_enodeId","type":"string"},{"name":"_orgId","type":"string"}]},
+		{"type":"event","name":"NodeApproved","inputs":[{"name":"_enodeId","type":"string"},{"name":"_orgId","type":"string"}]}]`)
+	roleEvents = parseABI(`[
+		{"type":"event","name":"RoleCreated","inputs":[{"name":"_roleId","type":"string"},{"name":"_orgId","type":"string"},{"name":"_baseAccess","type":"uint256"},{"name":"_isVoter","type":"bool"},{"name":"_isAdmin","type":"bool"}]}]`)
+)
+
+func parseABI(definition string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(definition))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+func newTestCaches() *Caches {
+	return &Caches{
+		Orgs:     NewOrgCache(10),
+		Nodes:    NewNodeCache(10),
+		Roles:    NewRoleCache(10),
+		Accounts: NewAcctCache(10),
+	}
+}
+
+func TestStateMachine(t *testing.T) {
+	assert := testifyassert.New(t)
+
+	caches := newTestCaches()
+	decoder := NewEventDecoder(map[common.Address]abi.ABI{
+		orgContract:  orgEvents,
+		nodeContract: nodeEvents,
+		roleContract: roleEvents,
+	})
+	var changes []NodeChange
+	sm := NewStateMachine(caches, decoder, 2, false, func(c NodeChange) { changes = append(changes, c) })
+
+	orgPending := testutils.EventLog(t, orgContract, orgEvents, "OrgPendingApproval", 10, 0, "ORG1", "", "ORG1", big.NewInt(1), big.NewInt(int64(OrgPendingApproval)))
+	orgApproved := testutils.EventLog(t, orgContract, orgEvents, "OrgApproved", 11, 0, "ORG1", "", "ORG1", big.NewInt(1), big.NewInt(int64(OrgApproved)))
+	nodeProposed := testutils.EventLog(t, nodeContract, nodeEvents, "NodeProposed", 11, 1, NODE1, "ORG1")
+	nodeApproved := testutils.EventLog(t, nodeContract, nodeEvents, "NodeApproved", 12, 0, NODE1, "ORG1")
+
+	// events are applied once confirmed
+	sm.AddLog(orgPending)
+	sm.SetHead(10)
+	assert.Equal(1, sm.Pending())
+	assert.Len(caches.Orgs.GetOrgList(), 0)
+	sm.SetHead(12)
+	assert.Equal(0, sm.Pending())
+	if o, err := caches.Orgs.GetOrg("ORG1"); assert.NoError(err) {
+		assert.Equal(OrgPendingApproval, o.Status)
+	}
+
+	for _, l := range []types.Log{orgApproved, nodeProposed, nodeApproved, nodeApproved} {
+		sm.AddLog(l)
+	}
+	sm.SetHead(14)
+	assert.Equal(0, sm.Pending(), "duplicate log must be ignored")
+	if o, err := caches.Orgs.GetOrg("ORG1"); assert.NoError(err) {
+		assert.Equal(OrgApproved, o.Status)
+	}
+	assert.Equal([]NodeChange{{NODE1, 0, NodePendingApproval}, {NODE1, NodePendingApproval, NodeApproved}}, changes)
+
+	// a reorg removing block 11 reverts it and the later blocks
+	changes = nil
+	removed := orgApproved
+	removed.Removed = true
+	sm.AddLog(removed)
+	if o, err := caches.Orgs.GetOrg("ORG1"); assert.NoError(err) {
+		assert.Equal(OrgPendingApproval, o.Status)
+	}
+	assert.Len(caches.Nodes.GetNodeList(), 0)
+	assert.Equal([]NodeChange{{NODE1, NodeApproved, NodePendingApproval}, {NODE1, NodePendingApproval, 0}}, changes)
+
+	// removed logs of later blocks were reverted already
+	for _, l := range []types.Log{nodeProposed, nodeApproved} {
+		l.Removed = true
+		sm.AddLog(l)
+	}
+	assert.Len(changes, 2)
+
+	// unconfirmed events removed by a reorg are dropped
+	sm.AddLog(testutils.EventLog(t, roleContract, roleEvents, "RoleCreated", 14, 0, "ROLE1", "ORG1", big.NewInt(1), false, false))
+	assert.Equal(1, sm.Pending())
+	removed = testutils.EventLog(t, roleContract, roleEvents, "RoleCreated", 14, 0, "ROLE1", "ORG1", big.NewInt(1), false, false)
+	removed.Removed = true
+	sm.AddLog(removed)
+	assert.Equal(0, sm.Pending())
+	sm.SetHead(20)
+	assert.Len(caches.Roles.GetRoleList(), 0)
+}
+
+func TestStateMachine_ReplayFrom(t *testing.T) {
+	assert := testifyassert.New(t)
+
+	caches := newTestCaches()
+	decoder := NewEventDecoder(map[common.Address]abi.ABI{orgContract: orgEvents})
+	sm := NewStateMachine(caches, decoder, 0, false, nil)
+	assert.Equal(uint64(0), sm.ReplayFrom())
+
+	orgPending := testutils.EventLog(t, orgContract, orgEvents, "OrgPendingApproval", 100, 0, "ORG1", "", "ORG1", big.NewInt(1), big.NewInt(int64(OrgPendingApproval)))
+	orgApproved := testutils.EventLog(t, orgContract, orgEvents, "OrgApproved", 101, 0, "ORG1", "", "ORG1", big.NewInt(1), big.NewInt(int64(OrgApproved)))
+	sm.AddLog(orgPending)
+	sm.AddLog(orgApproved)
+	sm.SetHead(100 + maxReorgDepth)
+
+	// the events of the replayed blocks already applied are recognized
+	assert.Equal(uint64(100), sm.ReplayFrom())
+	sm.AddLog(orgPending)
+	assert.Equal(0, sm.Pending())
+	if o, err := caches.Orgs.GetOrg("ORG1"); assert.NoError(err) {
+		assert.Equal(OrgApproved, o.Status)
+	}
+}
+
+func TestCaches_Compare(t *testing.T) {
+	assert := testifyassert.New(t)
+
+	caches := newTestCaches()
+	caches.Orgs.UpsertOrg("ORG1", "", "ORG1", big.NewInt(1), OrgApproved)
+	caches.Nodes.UpsertNode("ORG1", NODE1, NodeApproved)
+	caches.Roles.UpsertRole("ORG1", "ROLE1", false, false, ReadOnly, true)
+	caches.Accounts.UpsertAccount("ORG1", "ROLE1", Acct1, false, AcctActive)
+
+	orgs := []OrgInfo{{OrgId: "ORG1", FullOrgId: "ORG1", UltimateParent: "ORG1", Level: big.NewInt(1), Status: OrgApproved}}
+	nodes := []NodeInfo{{OrgId: "ORG1", Url: NODE1, Status: NodeApproved}}
+	roles := []RoleInfo{{OrgId: "ORG1", RoleId: "ROLE1", Access: ReadOnly, Active: true}}
+	accounts := []AccountInfo{{OrgId: "ORG1", RoleId: "ROLE1", AcctId: Acct1, Status: AcctActive}}
+	assert.Empty(caches.Compare(orgs, nodes, roles, accounts, nil))
+
+	nodes = []NodeInfo{{OrgId: "ORG1", Url: NODE1, Status: NodeDeactivated}, {OrgId: "ORG1", Url: NODE2, Status: NodeApproved}}
+	accounts = nil
+	diffs := caches.Compare(orgs, nodes, roles, accounts, nil)
+	if assert.Len(diffs, 3) {
+		assert.Equal("node", diffs[0].Kind)
+		assert.NotNil(diffs[0].Cache)
+		assert.Equal(NODE2, diffs[1].Key)
+		assert.Nil(diffs[1].Cache)
+		assert.Equal("account", diffs[2].Kind)
+		assert.Nil(diffs[2].Chain)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/event"
@@ -30,18 +31,20 @@ const (
 
 // permission config for bootstrapping
 type PermissionConfig struct {
-	PermissionsModel string         `json:"permissionModel"`
-	UpgrdAddress     common.Address `json:"upgrdableAddress"`
-	InterfAddress    common.Address `json:"interfaceAddress"`
-	ImplAddress      common.Address `json:"implAddress"`
-	NodeAddress      common.Address `json:"nodeMgrAddress"`
-	AccountAddress   common.Address `json:"accountMgrAddress"`
-	RoleAddress      common.Address `json:"roleMgrAddress"`
-	VoterAddress     common.Address `json:"voterMgrAddress"`
-	OrgAddress       common.Address `json:"orgMgrAddress"`
-	NwAdminOrg       string         `json:"nwAdminOrg"`
-	NwAdminRole      string         `json:"nwAdminRole"`
-	OrgAdminRole     string         `json:"orgAdminRole"`
+	PermissionsModel  string         `json:"permissionModel"`
+	UpgrdAddress      common.Address `json:"upgrdableAddress"`
+	InterfAddress     common.Address `json:"interfaceAddress"`
+	ImplAddress       common.Address `json:"implAddress"`
+	NodeAddress       common.Address `json:"nodeMgrAddress"`
+	AccountAddress    common.Address `json:"accountMgrAddress"`
+	RoleAddress       common.Address `json:"roleMgrAddress"`
+	VoterAddress      common.Address `json:"voterMgrAddress"`
+	OrgAddress        common.Address `json:"orgMgrAddress"`
+	AccessRuleAddress common.Address `json:"accessRuleMgrAddress"` // optional, v2 only
+	ActionAddress     common.Address `json:"actionMgrAddress"`     // optional, v2 only, enables the multi-signature approvals
+	NwAdminOrg        string         `json:"nwAdminOrg"`
+	NwAdminRole       string         `json:"nwAdminRole"`
+	OrgAdminRole      string         `json:"orgAdminRole"`
 
 	Accounts      []common.Address `json:"accounts"` //initial list of account that need full access
 	SubOrgDepth   *big.Int         `json:"subOrgDepth"`
@@ -82,6 +85,8 @@ var (
 	ErrNotMasterOrg         = errors.New("Org is not a master org")
 	ErrHostNameNotSupported = errors.New("Hostname not supported in the network")
 	ErrNoPermissionForTxn   = errors.New("account does not have permission for the transaction")
+	ErrNoAccessRuleContract = errors.New("Access rules not enabled. accessRuleMgrAddress missing in permission config")
+	ErrNoActionContract     = errors.New("Multi-signature approvals not enabled. actionMgrAddress missing in permission config")
 )
 
 // backend struct for interfaces
@@ -139,15 +144,13 @@ type Backend interface {
 	GetAuditService(auditBackend ContractBackend) (AuditService, error)
 	// control service for account management service
 	GetControlService(controlBackend ContractBackend) (ControlService, error)
-	// Monitors account access related events and updates the cache accordingly
-	ManageAccountPermissions() error
-	// Monitors Node management events and updates cache accordingly
-	ManageNodePermissions() error
-	// monitors org management related events happening via smart contracts
-	// and updates cache accordingly
-	ManageOrgPermissions() error
-	// monitors role management related events and updated cache
-	ManageRolePermissions() error
+	// access rule service for contract and function level access management
+	GetAccessRuleService(transactOpts *bind.TransactOpts, accessRuleBackend ContractBackend) (AccessRuleService, error)
+	// action service for multi-signature approval of network admin actions
+	GetActionService(transactOpts *bind.TransactOpts, actionBackend ContractBackend) (ActionService, error)
+	// ABIs of the contracts emitting the events which update the permission
+	// caches, by contract address
+	EventContracts() map[common.Address]abi.ABI
 
 	// monitors for network boot up complete event
 	MonitorNetworkBootUp() error
@@ -228,7 +231,10 @@ func DisconnectNode(node *node.Node, enodeId string, isRaft bool) error {
 			//get the raftId for the given enodeId
 			raftId, err := raftApi.GetRaftId(enodeId)
 			if err == nil {
-				raftApi.RemovePeer(raftId)
+				// a node deactivated by the network governance must leave the cluster
+				// even if that costs fault tolerance
+				force := true
+				raftApi.RemovePeer(raftId, &force)
 			} else {
 				return err
 			}
@@ -23,6 +23,14 @@ type TxArgs struct {
 	AcctId     common.Address
 	AccessType uint8
 	Action     uint8
+	Target     common.Address
+	Selector   core.FunctionSelector
+	Allowed    bool
+	ValueCap   *big.Int
+	ActionType uint8
+	ActionId   uint64
+	Threshold  uint64
+	Duration   uint64
 	Txa        ethapi.SendTxArgs
 }
 
@@ -67,6 +75,19 @@ type AccountService interface {
 	ApproveBlacklistedAccountRecovery(_args TxArgs) (*types.Transaction, error)
 }
 
+// Access rule services
+type AccessRuleService interface {
+	SetAccessRule(_args TxArgs) (*types.Transaction, error)
+	RemoveAccessRule(_args TxArgs) (*types.Transaction, error)
+}
+
+// Multi-signature approval services
+type ActionService interface {
+	ProposeAction(_args TxArgs) (*types.Transaction, error)
+	VoteAction(_args TxArgs) (*types.Transaction, error)
+	CancelAction(_args TxArgs) (*types.Transaction, error)
+}
+
 // Control services
 type ControlService interface {
 	ConnectionAllowed(_enodeId, _ip string, _port, _raftPort uint16) (bool, error)
@@ -129,3 +150,83 @@ func BindContract(contractInstance interface{}, bindFunc func() (interface{}, er
 	element.Set(reflect.ValueOf(instance))
 	return nil
 }
+
+// ReadOrgs returns the orgs of the permission contracts, with their sub orgs
+func ReadOrgs(s InitService) ([]core.OrgInfo, error) {
+	count, err := s.GetNumberOfOrgs()
+	if err != nil {
+		return nil, err
+	}
+	list := make([]core.OrgInfo, 0, count.Uint64())
+	for k := int64(0); k < count.Int64(); k++ {
+		orgId, parentId, ultParent, level, status, err := s.GetOrgInfo(big.NewInt(k))
+		if err != nil {
+			return nil, err
+		}
+		fullOrgId := orgId
+		if parentId != "" {
+			fullOrgId = parentId + "." + orgId
+		}
+		list = append(list, core.OrgInfo{OrgId: orgId, FullOrgId: fullOrgId, ParentOrgId: parentId, UltimateParent: ultParent, Level: level, Status: core.OrgStatus(status.Int64())})
+	}
+	// link the sub orgs now that all orgs are known
+	for i := range list {
+		for _, o := range list {
+			if o.ParentOrgId == list[i].FullOrgId {
+				list[i].SubOrgList = append(list[i].SubOrgList, o.FullOrgId)
+			}
+		}
+	}
+	return list, nil
+}
+
+// ReadRoles returns the roles of the permission contracts
+func ReadRoles(s InitService) ([]core.RoleInfo, error) {
+	count, err := s.GetNumberOfRoles()
+	if err != nil {
+		return nil, err
+	}
+	list := make([]core.RoleInfo, 0, count.Uint64())
+	for k := int64(0); k < count.Int64(); k++ {
+		role, err := s.GetRoleDetailsFromIndex(big.NewInt(k))
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, core.RoleInfo{OrgId: role.OrgId, RoleId: role.RoleId, IsVoter: role.Voter, IsAdmin: role.Admin, Access: core.AccessType(role.AccessType.Int64()), Active: role.Active})
+	}
+	return list, nil
+}
+
+// ReadNodes returns the nodes of the permission contracts
+func ReadNodes(s InitService) ([]core.NodeInfo, error) {
+	count, err := s.GetNumberOfNodes()
+	if err != nil {
+		return nil, err
+	}
+	list := make([]core.NodeInfo, count.Uint64())
+	for k := int64(0); k < count.Int64(); k++ {
+		orgId, url, status, err := s.GetNodeDetailsFromIndex(big.NewInt(k))
+		if err != nil {
+			return nil, err
+		}
+		list[k].OrgId, list[k].Url, list[k].Status = orgId, url, core.NodeStatus(status.Int64())
+	}
+	return list, nil
+}
+
+// ReadAccounts returns the accounts of the permission contracts
+func ReadAccounts(s InitService) ([]core.AccountInfo, error) {
+	count, err := s.GetNumberOfAccounts()
+	if err != nil {
+		return nil, err
+	}
+	list := make([]core.AccountInfo, 0, count.Uint64())
+	for k := int64(0); k < count.Int64(); k++ {
+		acct, orgId, roleId, status, orgAdmin, err := s.GetAccountDetailsFromIndex(big.NewInt(k))
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, core.AccountInfo{OrgId: orgId, RoleId: roleId, AcctId: acct, IsOrgAdmin: orgAdmin, Status: core.AcctStatus(status.Int64())})
+	}
+	return list, nil
+}
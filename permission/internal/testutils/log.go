@@ -0,0 +1,30 @@
+package testutils
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// EventLog returns the log of the event of a permission contract emitted with
+// the given arguments. The block and transaction hashes are derived from the
+// block number.
+func EventLog(t *testing.T, contract common.Address, parsed abi.ABI, name string, blockNumber uint64, index uint, args ...interface{}) types.Log {
+	event := parsed.Events[name]
+	data, err := event.Inputs.Pack(args...)
+	if err != nil {
+		t.Fatalf("failed to pack %s: %v", name, err)
+	}
+	return types.Log{
+		Address:     contract,
+		Topics:      []common.Hash{event.ID},
+		Data:        data,
+		BlockNumber: blockNumber,
+		BlockHash:   common.BigToHash(new(big.Int).SetUint64(blockNumber)),
+		TxHash:      common.BigToHash(new(big.Int).SetUint64(blockNumber)),
+		Index:       index,
+	}
+}
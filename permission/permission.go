@@ -1,13 +1,16 @@
 package permission
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sync"
 	"time"
 
+	goethereum "github.com/ethereum/go-ethereum" // the tests declare an ethereum variable
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
@@ -15,6 +18,7 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 	pcore "github.com/ethereum/go-ethereum/permission/core"
 	ptype "github.com/ethereum/go-ethereum/permission/core/types"
+	v2 "github.com/ethereum/go-ethereum/permission/v2"
 )
 
 // This is to make sure all contract instances are ready and initialized
@@ -26,10 +30,17 @@ func (p *PermissionCtrl) AfterStart() error {
 	if err != nil {
 		return err
 	}
+	if err = p.useChainAccessRuleManager(); err != nil {
+		return err
+	}
 	if err = p.contract.BindContracts(); err != nil {
 		return fmt.Errorf("populateInitPermissions failed to bind contracts: %v", err)
 	}
 
+	// the caches hold the state of the contracts as of this block, later events
+	// are applied by the state machine
+	startBlock := p.eth.BlockChain().CurrentBlock().NumberU64()
+
 	// populate the initial list of permissioned nodes and account accesses
 	if err := p.populateInitPermissions(params.DEFAULT_ORGCACHE_SIZE, params.DEFAULT_ROLECACHE_SIZE,
 		params.DEFAULT_NODECACHE_SIZE, params.DEFAULT_ACCOUNTCACHE_SIZE); err != nil {
@@ -42,14 +53,17 @@ func (p *PermissionCtrl) AfterStart() error {
 
 	// set the default access to ReadOnly
 	pcore.SetDefaults(p.permConfig.NwAdminRole, p.permConfig.OrgAdminRole, p.IsV2Permission())
-	for _, f := range []func() error{
-		p.monitorQIP714Block,               // monitor block number to activate new permissions controls
-		p.backend.ManageOrgPermissions,     // monitor org management related events
-		p.backend.ManageNodePermissions,    // monitor org  level Node management events
-		p.backend.ManageRolePermissions,    // monitor org level role management events
-		p.backend.ManageAccountPermissions, // monitor org level account management events
-	} {
-		if err := f(); err != nil {
+	// monitor block number to activate new permissions controls
+	if err := p.monitorQIP714Block(); err != nil {
+		return err
+	}
+	// apply the events of the permission contracts to the caches
+	if err := p.manageCaches(startBlock + 1); err != nil {
+		return err
+	}
+
+	if p.IsV2Permission() {
+		if err := p.startHistory(); err != nil {
 			return err
 		}
 	}
@@ -59,6 +73,74 @@ func (p *PermissionCtrl) AfterStart() error {
 	return nil
 }
 
+// useChainAccessRuleManager reads the access rules from the contract named in
+// the chain config, where the rules are part of block validation, so that all
+// the nodes enforce the same rules
+func (p *PermissionCtrl) useChainAccessRuleManager() error {
+	var address common.Address
+	for _, transition := range p.eth.BlockChain().Config().Transitions {
+		if transition.AccessRuleManagerAddress != nil {
+			address = *transition.AccessRuleManagerAddress
+		}
+	}
+	if address == (common.Address{}) {
+		return nil
+	}
+	if !p.IsV2Permission() {
+		return fmt.Errorf("access rule manager %x of the chain config requires the v2 permissions model", address)
+	}
+	if p.permConfig.AccessRuleAddress != (common.Address{}) && p.permConfig.AccessRuleAddress != address {
+		return fmt.Errorf("accessRuleMgrAddress %x does not match the access rule manager %x of the chain config", p.permConfig.AccessRuleAddress, address)
+	}
+	p.permConfig.AccessRuleAddress = address
+	return nil
+}
+
+// starts indexing the permission contract events for audit queries
+func (p *PermissionCtrl) startHistory() error {
+	db, err := p.node.OpenDatabase("permission-history", 0, 0, "", false)
+	if err != nil {
+		return fmt.Errorf("failed to open permission history database: %v", err)
+	}
+	p.history = v2.NewHistory(db, chainHistoryBackend{p.eth}, p.ethClnt, p.permConfig, p.isRaft)
+	return p.history.Start()
+}
+
+// chainHistoryBackend reads the local chain for the permission history
+type chainHistoryBackend struct {
+	eth *eth.Ethereum
+}
+
+func (b chainHistoryBackend) CurrentBlock() uint64 {
+	return b.eth.BlockChain().CurrentBlock().NumberU64()
+}
+
+// TxSender recovers the sender from the block body rather than from the tx
+// lookup index, which is pruned past the txlookuplimit. The receipts locate
+// the transaction when the log does not carry its index
+func (b chainHistoryBackend) TxSender(l types.Log) (common.Address, error) {
+	chain := b.eth.BlockChain()
+	block := chain.GetBlock(l.BlockHash, l.BlockNumber)
+	if block == nil {
+		return common.Address{}, fmt.Errorf("block %x not found", l.BlockHash)
+	}
+	txs := block.Transactions()
+	index := int(l.TxIndex)
+	if index >= len(txs) || txs[index].Hash() != l.TxHash {
+		index = -1
+		for i, receipt := range chain.GetReceiptsByHash(l.BlockHash) {
+			if receipt.TxHash == l.TxHash {
+				index = i
+				break
+			}
+		}
+		if index < 0 || index >= len(txs) {
+			return common.Address{}, fmt.Errorf("transaction %x not found in block %x", l.TxHash, l.BlockHash)
+		}
+	}
+	return types.Sender(types.MakeSigner(chain.Config(), block.Number()), txs[index])
+}
+
 // start service asynchronously due to dependencies
 func (p *PermissionCtrl) asyncStart() {
 	var ethereum *eth.Ethereum
@@ -139,6 +221,127 @@ func (p *PermissionCtrl) monitorQIP714Block() error {
 	return nil
 }
 
+const (
+	eventResubscribeDelay    = time.Second // first wait before subscribing again to the permission events
+	eventMaxResubscribeDelay = time.Minute // longest wait before subscribing again to the permission events
+)
+
+// manageCaches feeds the events of the permission contracts, from the given
+// block on, and the chain head to the state machine updating the caches
+func (p *PermissionCtrl) manageCaches(fromBlock uint64) error {
+	decoder := pcore.NewEventDecoder(p.backend.EventContracts())
+	// the permission caches decide the validity of blocks, so all the nodes must
+	// apply the events after the same number of confirmations
+	confirmations := p.eth.BlockChain().Config().PermissionConfirmations
+	p.events = pcore.NewStateMachine(pcore.GlobalCaches(), decoder, confirmations, p.isRaft, p.updateNodeFiles)
+
+	query := goethereum.FilterQuery{Addresses: decoder.Addresses()}
+	chLogs := make(chan types.Log, 16)
+	chainHeadCh := make(chan core.ChainHeadEvent, 16)
+	headSub := p.eth.BlockChain().SubscribeChainHeadEvent(chainHeadCh)
+	logSub, err := p.subscribeEvents(query, fromBlock, chLogs)
+	if err != nil {
+		headSub.Unsubscribe()
+		return err
+	}
+
+	go func() {
+		stopChan, stopSubscription := ptype.SubscribeStopEvent()
+		defer stopSubscription.Unsubscribe()
+		defer func() { logSub.Unsubscribe() }()
+		defer headSub.Unsubscribe()
+
+		var (
+			subErr     = logSub.Err()
+			retry      <-chan time.Time
+			delay      = eventResubscribeDelay
+			replayFrom uint64
+		)
+		for {
+			select {
+			case l := <-chLogs:
+				p.events.AddLog(l)
+			case head := <-chainHeadCh:
+				// while the events are missed, the head must not move on so
+				// that the replayed events already known are recognized
+				if retry == nil {
+					p.events.SetHead(head.Block.NumberU64())
+				}
+			case err := <-subErr:
+				log.Error("permission event subscription failed, resubscribing", "delay", delay, "err", err)
+				logSub.Unsubscribe()
+				subErr, retry, replayFrom = nil, time.After(delay), p.events.ReplayFrom()
+			case <-retry:
+				sub, err := p.subscribeEvents(query, replayFrom, chLogs)
+				if err != nil {
+					if delay *= 2; delay > eventMaxResubscribeDelay {
+						delay = eventMaxResubscribeDelay
+					}
+					log.Error("failed to resubscribe to permission events", "delay", delay, "err", err)
+					retry = time.After(delay)
+					continue
+				}
+				logSub, subErr, retry, delay = sub, sub.Err(), nil, eventResubscribeDelay
+			case <-stopChan:
+				log.Info("quit permission event watch")
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// subscribeEvents subscribes to the events of the permission contracts and
+// feeds the events emitted from the given block to the current head to the
+// state machine. The subscription delivers the logs removed by a reorg as well
+func (p *PermissionCtrl) subscribeEvents(query goethereum.FilterQuery, fromBlock uint64, chLogs chan types.Log) (goethereum.Subscription, error) {
+	// subscribe before catching up so that no block falls in between
+	logSub, err := p.ethClnt.SubscribeFilterLogs(context.Background(), query, chLogs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to permission events: %v", err)
+	}
+	head := p.eth.BlockChain().CurrentBlock().NumberU64()
+	if fromBlock <= head {
+		query.FromBlock, query.ToBlock = new(big.Int).SetUint64(fromBlock), new(big.Int).SetUint64(head)
+		logs, err := p.ethClnt.FilterLogs(context.Background(), query)
+		if err != nil {
+			logSub.Unsubscribe()
+			return nil, fmt.Errorf("failed to read permission events: %v", err)
+		}
+		for _, l := range logs {
+			p.events.AddLog(l)
+		}
+	}
+	p.events.SetHead(head)
+	return logSub, nil
+}
+
+// updateNodeFiles keeps permissioned-nodes.json and disallowed-nodes.json in
+// line with a change of node status, including changes reverted by a reorg
+func (p *PermissionCtrl) updateNodeFiles(change pcore.NodeChange) {
+	disallowed := func(status pcore.NodeStatus) bool {
+		return status == pcore.NodeBlackListed || status == pcore.NodeRecoveryInitiated
+	}
+	if was, is := disallowed(change.From), disallowed(change.To); was != is {
+		op := ptype.NodeAdd
+		if !is {
+			op = ptype.NodeDelete
+		}
+		if err := ptype.UpdateDisallowedNodes(p.dataDir, change.Url, op); err != nil {
+			log.Error("error updating disallowed-nodes.json", "err", err)
+		}
+	}
+	if was, is := change.From == pcore.NodeApproved, change.To == pcore.NodeApproved; was != is {
+		op := ptype.NodeAdd
+		if !is {
+			op = ptype.NodeDelete
+		}
+		if err := ptype.UpdatePermissionedNodes(p.node, p.dataDir, change.Url, op, p.isRaft); err != nil {
+			log.Error("error updating permissioned-nodes.json", "err", err)
+		}
+	}
+}
+
 func (p *PermissionCtrl) instantiateCache(orgCacheSize, roleCacheSize, nodeCacheSize, accountCacheSize int) {
 	// instantiate the cache objects for permissions
 	pcore.OrgInfoMap = pcore.NewOrgCache(orgCacheSize)
@@ -153,6 +356,10 @@ func (p *PermissionCtrl) instantiateCache(orgCacheSize, roleCacheSize, nodeCache
 
 	pcore.AcctInfoMap = pcore.NewAcctCache(accountCacheSize)
 	pcore.AcctInfoMap.PopulateCacheFunc(p.populateAccountToCache)
+
+	if p.IsV2Permission() && p.permConfig.AccessRuleAddress != (common.Address{}) {
+		pcore.AccessRuleMap = pcore.NewAccessRuleCache()
+	}
 }
 
 // Thus function checks if the initial network boot up status and if no
@@ -178,6 +385,7 @@ func (p *PermissionCtrl) populateInitPermissions(orgCacheSize, roleCacheSize, no
 			p.populateNodesFromContract,
 			p.populateRolesFromContract,
 			p.populateAccountsFromContract,
+			p.populateAccessRulesFromContract,
 		} {
 			if err := f(); err != nil {
 				return err
@@ -278,6 +486,21 @@ func (p *PermissionCtrl) populateOrgsFromContract() error {
 	return nil
 }
 
+// populates the access rules from contract into cache
+func (p *PermissionCtrl) populateAccessRulesFromContract() error {
+	if pcore.AccessRuleMap == nil {
+		return nil
+	}
+	rules, err := p.contract.(*v2.Init).GetAccessRules()
+	if err != nil {
+		return err
+	}
+	for _, r := range rules {
+		pcore.AccessRuleMap.UpsertAccessRule(r.OrgId, r.RoleId, r.Target, r.Selector, r.Allowed, r.ValueCap)
+	}
+	return nil
+}
+
 // Reads the node list from static-nodes.json and populates into the contract
 func (p *PermissionCtrl) populateStaticNodesToContract() error {
 	nodes := p.node.Server().Config.StaticNodes
@@ -480,6 +480,21 @@ func TestQuorumControlsAPI_NodeAPIs(t *testing.T) {
 	assert.Equal(t, pcore.NodeApproved, nodeInfo.Status)
 }
 
+func TestQuorumControlsAPI_ActionAPIs(t *testing.T) {
+	testObject := typicalQuorumControlsAPI(t)
+	txa := ethapi.SendTxArgs{From: guardianAddress}
+
+	// multi-signature approvals need the v2 model and an action manager
+	_, err := testObject.PendingActions()
+	assert.Equal(t, ptype.ErrNoActionContract, err)
+	_, err = testObject.ProposeAction(v2.ActionApproveOrg, arbitraryOrgToAdd, arbitraryNode1, guardianAddress, 0, 10, txa)
+	assert.Error(t, err)
+	_, err = testObject.VoteAction(0, txa)
+	assert.Error(t, err)
+	_, err = testObject.CancelAction(0, txa)
+	assert.Error(t, err)
+}
+
 func testTransactionAllowed(t *testing.T, q *QuorumControlsAPI, txa ethapi.SendTxArgs, expected bool) {
 	actAllowed := q.TransactionAllowed(txa)
 	assert.Equal(t, expected, actAllowed)
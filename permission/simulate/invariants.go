@@ -0,0 +1,115 @@
+package simulate
+
+import (
+	"fmt"
+
+	pcore "github.com/ethereum/go-ethereum/permission/core"
+	ptype "github.com/ethereum/go-ethereum/permission/core/types"
+)
+
+// invariants checked on the permission graph
+const (
+	NoNetworkAdmin     = "no-network-admin"
+	NetworkAdminOrg    = "network-admin-org-not-approved"
+	OrphanedSubOrg     = "orphaned-sub-org"
+	OrgWithoutAdmin    = "org-without-admin"
+	OrgWithoutNode     = "org-without-node"
+	AccountInvalidRole = "account-invalid-role"
+)
+
+// Violation is a broken permission invariant
+type Violation struct {
+	Invariant string `json:"invariant"`
+	Subject   string `json:"subject,omitempty"`
+	Detail    string `json:"detail"`
+}
+
+func (v Violation) String() string {
+	if v.Subject == "" {
+		return fmt.Sprintf("%s: %s", v.Invariant, v.Detail)
+	}
+	return fmt.Sprintf("%s: %s: %s", v.Invariant, v.Subject, v.Detail)
+}
+
+// Check returns the invariants violated by the permission graph of the
+// report:
+//   - the network admin org must be approved and have an active network admin
+//   - the parent of every sub org must exist and be approved
+//   - every approved master org must have an active org admin and an
+//     approved node
+//   - every active account must be linked to an active role of its org or
+//     of its ultimate parent, or to one of the admin roles
+func Check(config *ptype.PermissionConfig, r *Report) []Violation {
+	violations := []Violation{}
+	orgs := make(map[string]*pcore.OrgInfo, len(r.OrgList))
+	for i := range r.OrgList {
+		orgs[r.OrgList[i].FullOrgId] = &r.OrgList[i]
+	}
+
+	if o, ok := orgs[config.NwAdminOrg]; !ok || o.Status != pcore.OrgApproved {
+		violations = append(violations, Violation{Invariant: NetworkAdminOrg, Subject: config.NwAdminOrg, Detail: "network admin org is missing or not approved"})
+	}
+	nwAdmin := false
+	for _, a := range r.AcctList {
+		if a.OrgId == config.NwAdminOrg && a.RoleId == config.NwAdminRole && a.Status == pcore.AcctActive {
+			nwAdmin = true
+			break
+		}
+	}
+	if !nwAdmin {
+		violations = append(violations, Violation{Invariant: NoNetworkAdmin, Detail: "no active network admin account left"})
+	}
+
+	for _, o := range r.OrgList {
+		if o.ParentOrgId != "" {
+			if p, ok := orgs[o.ParentOrgId]; !ok {
+				violations = append(violations, Violation{Invariant: OrphanedSubOrg, Subject: o.FullOrgId, Detail: "parent org " + o.ParentOrgId + " does not exist"})
+			} else if p.Status != pcore.OrgApproved && p.Status != pcore.OrgPendingSuspension {
+				violations = append(violations, Violation{Invariant: OrphanedSubOrg, Subject: o.FullOrgId, Detail: "parent org " + o.ParentOrgId + " is not approved"})
+			}
+			continue
+		}
+		if o.Status != pcore.OrgApproved || o.FullOrgId == config.NwAdminOrg {
+			continue
+		}
+		admin := false
+		for _, a := range r.AcctList {
+			if a.OrgId == o.FullOrgId && a.IsOrgAdmin && a.Status == pcore.AcctActive {
+				admin = true
+				break
+			}
+		}
+		if !admin {
+			violations = append(violations, Violation{Invariant: OrgWithoutAdmin, Subject: o.FullOrgId, Detail: "approved org has no active org admin"})
+		}
+		node := false
+		for _, n := range r.NodeList {
+			if n.OrgId == o.FullOrgId && n.Status == pcore.NodeApproved {
+				node = true
+				break
+			}
+		}
+		if !node {
+			violations = append(violations, Violation{Invariant: OrgWithoutNode, Subject: o.FullOrgId, Detail: "approved org has no approved node"})
+		}
+	}
+
+	roles := make(map[pcore.RoleKey]bool, len(r.RoleList))
+	for _, role := range r.RoleList {
+		roles[pcore.RoleKey{OrgId: role.OrgId, RoleId: role.RoleId}] = role.Active
+	}
+	for _, a := range r.AcctList {
+		if a.Status != pcore.AcctActive || a.RoleId == config.NwAdminRole || a.RoleId == config.OrgAdminRole {
+			continue
+		}
+		// roles of the ultimate parent are available to the whole org tree
+		ultParent := a.OrgId
+		if o, ok := orgs[a.OrgId]; ok {
+			ultParent = o.UltimateParent
+		}
+		if !roles[pcore.RoleKey{OrgId: a.OrgId, RoleId: a.RoleId}] && !roles[pcore.RoleKey{OrgId: ultParent, RoleId: a.RoleId}] {
+			violations = append(violations, Violation{Invariant: AccountInvalidRole, Subject: a.AcctId.Hex(), Detail: "account linked to missing or inactive role " + a.RoleId})
+		}
+	}
+	return violations
+}
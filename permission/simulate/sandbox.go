@@ -0,0 +1,213 @@
+package simulate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// gas limit used for all calls and transactions executed in the sandbox
+const sandboxGasLimit = 47000000
+
+var (
+	errUnknownSender = errors.New("transaction was not created by a sandbox transactor")
+	errNotSupported  = errors.New("not supported by the permission sandbox")
+)
+
+// sandbox is a contract backend executing calls and transactions directly on
+// an in-memory state. Nothing is ever committed back to the chain database,
+// transactions do not need to be signed and gas is free.
+type sandbox struct {
+	mu      sync.Mutex
+	state   *state.StateDB
+	header  *types.Header
+	config  *params.ChainConfig
+	senders map[common.Hash]common.Address
+}
+
+func newSandbox(statedb *state.StateDB, header *types.Header, config *params.ChainConfig) *sandbox {
+	// execute on top of the given block, with no limit on the block gas
+	head := &types.Header{
+		ParentHash: header.Hash(),
+		Number:     new(big.Int).Add(header.Number, common.Big1),
+		Time:       header.Time + 1,
+		Difficulty: new(big.Int).Set(header.Difficulty),
+		GasLimit:   math.MaxUint64,
+	}
+	return &sandbox{
+		state:   statedb,
+		header:  head,
+		config:  config,
+		senders: make(map[common.Hash]common.Address),
+	}
+}
+
+// transactor returns transact options sending transactions from the given
+// account. The transactions are recorded rather than signed, so any account
+// can be impersonated.
+func (s *sandbox) transactor(from common.Address) *bind.TransactOpts {
+	return &bind.TransactOpts{
+		From: from,
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.senders[tx.Hash()] = address
+			return tx, nil
+		},
+		GasLimit: sandboxGasLimit,
+		GasPrice: big.NewInt(0),
+	}
+}
+
+// execute runs the message against the given state and returns the
+// execution result. A reverted execution is reported as an error carrying the
+// revert reason when there is one.
+func (s *sandbox) execute(statedb *state.StateDB, msg callMsg) (*core.ExecutionResult, error) {
+	coinbase := common.Address{}
+	blockContext := core.NewEVMBlockContext(s.header, nil, &coinbase)
+	vmEnv := vm.NewEVM(blockContext, core.NewEVMTxContext(msg), statedb, statedb, s.config, vm.Config{})
+	gasPool := new(core.GasPool).AddGas(math.MaxUint64)
+
+	result, err := core.NewStateTransition(vmEnv, msg, gasPool).TransitionDb()
+	if err != nil {
+		return nil, err
+	}
+	if result.Failed() {
+		if reason, errUnpack := abi.UnpackRevert(result.Revert()); errUnpack == nil {
+			return nil, fmt.Errorf("execution reverted: %v", reason)
+		}
+		return nil, result.Err
+	}
+	return result, nil
+}
+
+func (s *sandbox) call(call ethereum.CallMsg) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if call.Gas == 0 {
+		call.Gas = sandboxGasLimit
+	}
+	result, err := s.execute(s.state.Copy(), callMsg{call})
+	if err != nil {
+		return nil, err
+	}
+	return result.Return(), nil
+}
+
+func (s *sandbox) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return s.PendingCodeAt(ctx, contract)
+}
+
+func (s *sandbox) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return s.call(call)
+}
+
+func (s *sandbox) PendingCodeAt(ctx context.Context, contract common.Address) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.state.GetCode(contract), nil
+}
+
+func (s *sandbox) PendingCallContract(ctx context.Context, call ethereum.CallMsg) ([]byte, error) {
+	return s.call(call)
+}
+
+func (s *sandbox) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.state.GetNonce(account), nil
+}
+
+func (s *sandbox) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return big.NewInt(0), nil
+}
+
+func (s *sandbox) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return sandboxGasLimit, nil
+}
+
+// SendTransaction applies the transaction to the sandbox state. Reverted
+// transactions leave the state untouched and are reported as errors.
+func (s *sandbox) SendTransaction(ctx context.Context, tx *types.Transaction, args bind.PrivateTxArgs) error {
+	if args.PrivateFor != nil {
+		return errNotSupported
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	from, ok := s.senders[tx.Hash()]
+	if !ok {
+		return errUnknownSender
+	}
+	delete(s.senders, tx.Hash())
+
+	snapshot := s.state.Snapshot()
+	if _, err := s.execute(s.state, callMsg{ethereum.CallMsg{
+		From:     from,
+		To:       tx.To(),
+		Gas:      tx.Gas(),
+		GasPrice: tx.GasPrice(),
+		Value:    tx.Value(),
+		Data:     tx.Data(),
+	}}); err != nil {
+		s.state.RevertToSnapshot(snapshot)
+		return err
+	}
+	s.state.Finalise(true)
+	return nil
+}
+
+func (s *sandbox) PreparePrivateTransaction(data []byte, privateFrom string) (common.EncryptedPayloadHash, error) {
+	return common.EncryptedPayloadHash{}, errNotSupported
+}
+
+func (s *sandbox) DistributeTransaction(ctx context.Context, tx *types.Transaction, args bind.PrivateTxArgs) (string, error) {
+	return "", errNotSupported
+}
+
+func (s *sandbox) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	return nil, errNotSupported
+}
+
+func (s *sandbox) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return nil, errNotSupported
+}
+
+// callMsg implements core.Message to allow passing it as a transaction simulator.
+type callMsg struct {
+	ethereum.CallMsg
+}
+
+func (m callMsg) From() common.Address { return m.CallMsg.From }
+func (m callMsg) Nonce() uint64        { return 0 }
+func (m callMsg) CheckNonce() bool     { return false }
+func (m callMsg) To() *common.Address  { return m.CallMsg.To }
+func (m callMsg) GasPrice() *big.Int {
+	if m.CallMsg.GasPrice == nil {
+		return new(big.Int)
+	}
+	return m.CallMsg.GasPrice
+}
+func (m callMsg) Gas() uint64 { return m.CallMsg.Gas }
+func (m callMsg) Value() *big.Int {
+	if m.CallMsg.Value == nil {
+		return new(big.Int)
+	}
+	return m.CallMsg.Value
+}
+func (m callMsg) Data() []byte                 { return m.CallMsg.Data }
+func (m callMsg) AccessList() types.AccessList { return m.CallMsg.AccessList }
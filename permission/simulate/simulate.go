@@ -0,0 +1,392 @@
+// Package simulate validates permission configurations and simulates
+// permission actions offline. The v2 permission contracts are loaded from a
+// chain database or a genesis state into an in-memory EVM, the proposed
+// QuorumControlsAPI actions are applied there and the resulting org, role,
+// node and account graph is checked for invariant violations.
+package simulate
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/params"
+	pcore "github.com/ethereum/go-ethereum/permission/core"
+	ptype "github.com/ethereum/go-ethereum/permission/core/types"
+	v2 "github.com/ethereum/go-ethereum/permission/v2"
+	binding "github.com/ethereum/go-ethereum/permission/v2/bind"
+)
+
+var (
+	ErrUnsupportedModel  = errors.New("only the v2 permission model can be simulated")
+	ErrNoPermContracts   = errors.New("permission contracts not deployed at the configured addresses")
+	ErrNetworkNotBooted  = errors.New("permission network not booted")
+	ErrUnknownAction     = errors.New("unknown permission action")
+	ErrInvalidSelector   = errors.New("function selector must be empty or 4 bytes")
+	errNoHeadBlock       = errors.New("head block not found in chain database")
+	errNoChainConfig     = errors.New("chain config not found in chain database")
+	errNoGenesisConfig   = errors.New("genesis has no chain config")
+	errAccessRulesConfig = errors.New("access rule manager not configured")
+)
+
+// Action is a single QuorumControlsAPI call to simulate. Method is the
+// name of the quorumPermission console function, e.g. addOrg, and From the
+// account sending the transaction. Only the fields used by the method need
+// to be set.
+type Action struct {
+	Method      string         `json:"method"`
+	From        common.Address `json:"from"`
+	OrgId       string         `json:"orgId,omitempty"`
+	ParentOrgId string         `json:"parentOrgId,omitempty"`
+	Url         string         `json:"url,omitempty"`
+	RoleId      string         `json:"roleId,omitempty"`
+	Account     common.Address `json:"account,omitempty"`
+	Access      uint8          `json:"access,omitempty"`
+	IsVoter     bool           `json:"isVoter,omitempty"`
+	IsAdmin     bool           `json:"isAdmin,omitempty"`
+	Status      uint8          `json:"status,omitempty"`
+	Target      common.Address `json:"target,omitempty"`
+	Selector    hexutil.Bytes  `json:"selector,omitempty"`
+	Allowed     bool           `json:"allowed,omitempty"`
+	ValueCap    *hexutil.Big   `json:"valueCap,omitempty"`
+}
+
+// ActionResult is the outcome of a simulated action. Error is empty if the
+// action was applied.
+type ActionResult struct {
+	Index  int    `json:"index"`
+	Method string `json:"method"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Report is the permission graph after the simulation along with the
+// outcome of every action and the invariant violations found.
+type Report struct {
+	Actions     []ActionResult      `json:"actions,omitempty"`
+	OrgList     []pcore.OrgInfo     `json:"orgList"`
+	RoleList    []pcore.RoleInfo    `json:"roleList"`
+	NodeList    []pcore.NodeInfo    `json:"nodeList"`
+	AcctList    []pcore.AccountInfo `json:"acctList"`
+	AccessRules []pcore.AccessRule  `json:"accessRules,omitempty"`
+	Violations  []Violation         `json:"violations"`
+}
+
+// Failed returns true if any action failed or any invariant is violated.
+func (r *Report) Failed() bool {
+	if len(r.Violations) > 0 {
+		return true
+	}
+	for _, a := range r.Actions {
+		if a.Error != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// actionServices holds the permission services bound to the sender of an
+// action.
+type actionServices struct {
+	org        *v2.Org
+	role       *v2.Role
+	node       *v2.Node
+	account    *v2.Account
+	accessRule *v2.AccessRule
+}
+
+type actionFunc func(s *actionServices, args ptype.TxArgs) (*types.Transaction, error)
+
+// actions maps the quorumPermission console functions onto the v2 services
+var actions = map[string]actionFunc{
+	"addOrg":           func(s *actionServices, a ptype.TxArgs) (*types.Transaction, error) { return s.org.AddOrg(a) },
+	"approveOrg":       func(s *actionServices, a ptype.TxArgs) (*types.Transaction, error) { return s.org.ApproveOrg(a) },
+	"addSubOrg":        func(s *actionServices, a ptype.TxArgs) (*types.Transaction, error) { return s.org.AddSubOrg(a) },
+	"updateOrgStatus":  func(s *actionServices, a ptype.TxArgs) (*types.Transaction, error) { return s.org.UpdateOrgStatus(a) },
+	"approveOrgStatus": func(s *actionServices, a ptype.TxArgs) (*types.Transaction, error) { return s.org.ApproveOrgStatus(a) },
+	"addNode":          func(s *actionServices, a ptype.TxArgs) (*types.Transaction, error) { return s.node.AddNode(a) },
+	"updateNodeStatus": func(s *actionServices, a ptype.TxArgs) (*types.Transaction, error) { return s.node.UpdateNodeStatus(a) },
+	"recoverBlackListedNode": func(s *actionServices, a ptype.TxArgs) (*types.Transaction, error) {
+		return s.node.StartBlacklistedNodeRecovery(a)
+	},
+	"approveBlackListedNodeRecovery": func(s *actionServices, a ptype.TxArgs) (*types.Transaction, error) {
+		return s.node.ApproveBlacklistedNodeRecovery(a)
+	},
+	"addNewRole": func(s *actionServices, a ptype.TxArgs) (*types.Transaction, error) { return s.role.AddNewRole(a) },
+	"removeRole": func(s *actionServices, a ptype.TxArgs) (*types.Transaction, error) { return s.role.RemoveRole(a) },
+	"assignAdminRole": func(s *actionServices, a ptype.TxArgs) (*types.Transaction, error) {
+		return s.account.AssignAdminRole(a)
+	},
+	"approveAdminRole": func(s *actionServices, a ptype.TxArgs) (*types.Transaction, error) {
+		return s.account.ApproveAdminRole(a)
+	},
+	"addAccountToOrg": func(s *actionServices, a ptype.TxArgs) (*types.Transaction, error) {
+		return s.account.AssignAccountRole(a)
+	},
+	"changeAccountRole": func(s *actionServices, a ptype.TxArgs) (*types.Transaction, error) {
+		return s.account.AssignAccountRole(a)
+	},
+	"updateAccountStatus": func(s *actionServices, a ptype.TxArgs) (*types.Transaction, error) {
+		return s.account.UpdateAccountStatus(a)
+	},
+	"recoverBlackListedAccount": func(s *actionServices, a ptype.TxArgs) (*types.Transaction, error) {
+		return s.account.StartBlacklistedAccountRecovery(a)
+	},
+	"approveBlackListedAccountRecovery": func(s *actionServices, a ptype.TxArgs) (*types.Transaction, error) {
+		return s.account.ApproveBlacklistedAccountRecovery(a)
+	},
+	"setAccessRule": func(s *actionServices, a ptype.TxArgs) (*types.Transaction, error) {
+		if s.accessRule == nil {
+			return nil, errAccessRulesConfig
+		}
+		return s.accessRule.SetAccessRule(a)
+	},
+	"removeAccessRule": func(s *actionServices, a ptype.TxArgs) (*types.Transaction, error) {
+		if s.accessRule == nil {
+			return nil, errAccessRulesConfig
+		}
+		return s.accessRule.RemoveAccessRule(a)
+	},
+}
+
+// Simulator applies permission actions to the permission contracts held in
+// a sandboxed copy of a chain state.
+type Simulator struct {
+	config   *ptype.PermissionConfig
+	sandbox  *sandbox
+	backend  ptype.ContractBackend
+	contract *v2.Init
+}
+
+// LoadChainState returns the state, head header and chain config of the
+// head block in the given chain database.
+func LoadChainState(db ethdb.Database) (*state.StateDB, *types.Header, *params.ChainConfig, error) {
+	hash := rawdb.ReadHeadBlockHash(db)
+	number := rawdb.ReadHeaderNumber(db, hash)
+	if number == nil {
+		return nil, nil, nil, errNoHeadBlock
+	}
+	header := rawdb.ReadHeader(db, hash, *number)
+	if header == nil {
+		return nil, nil, nil, errNoHeadBlock
+	}
+	config := rawdb.ReadChainConfig(db, rawdb.ReadCanonicalHash(db, 0))
+	if config == nil {
+		return nil, nil, nil, errNoChainConfig
+	}
+	statedb, err := state.New(header.Root, state.NewDatabase(db), nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return statedb, header, config, nil
+}
+
+// LoadGenesisState returns the state, header and chain config of the
+// genesis block described by the given genesis spec.
+func LoadGenesisState(genesis *core.Genesis) (*state.StateDB, *types.Header, *params.ChainConfig, error) {
+	if genesis.Config == nil {
+		return nil, nil, nil, errNoGenesisConfig
+	}
+	db := rawdb.NewMemoryDatabase()
+	block := genesis.ToBlock(db)
+	statedb, err := state.New(block.Root(), state.NewDatabase(db), nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return statedb, block.Header(), genesis.Config, nil
+}
+
+// New returns a simulator for the permission contracts described by config.
+// The given state is copied, it is never modified by the simulation.
+func New(statedb *state.StateDB, header *types.Header, chainConfig *params.ChainConfig, config *ptype.PermissionConfig, isRaft bool) (*Simulator, error) {
+	if config.PermissionsModel != ptype.PERMISSION_V2 {
+		return nil, ErrUnsupportedModel
+	}
+	if len(statedb.GetCode(config.InterfAddress)) == 0 || len(statedb.GetCode(config.UpgrdAddress)) == 0 {
+		return nil, ErrNoPermContracts
+	}
+	// the key is only needed to satisfy the v2 bindings, the sandbox
+	// transactors replace it before anything is sent
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	sb := newSandbox(statedb.Copy(), header, chainConfig)
+	backend := ptype.ContractBackend{
+		EthClnt:    sb,
+		Key:        key,
+		PermConfig: config,
+		IsRaft:     isRaft,
+		ChainID:    chainConfig.ChainID,
+	}
+	if backend.ChainID == nil {
+		backend.ChainID = new(big.Int)
+	}
+	contract := &v2.Init{Backend: backend}
+	if err := contract.BindContracts(); err != nil {
+		return nil, err
+	}
+	contract.PermInterfSession.TransactOpts = *sb.transactor(crypto.PubkeyToAddress(key.PublicKey))
+
+	return &Simulator{
+		config:   config,
+		sandbox:  sb,
+		backend:  backend,
+		contract: contract,
+	}, nil
+}
+
+// Booted returns true if the network boot up has been completed in the
+// permission contracts.
+func (s *Simulator) Booted() (bool, error) {
+	return s.contract.GetNetworkBootStatus()
+}
+
+// Boot initialises the permission contracts from the permission config as a
+// node does on first start, with the given static nodes as the nodes of
+// the network admin org. It does nothing if the network is already booted.
+func (s *Simulator) Boot(staticNodes []*enode.Node) error {
+	if booted, err := s.Booted(); err != nil || booted {
+		return err
+	}
+	if _, err := s.contract.SetPolicy(s.config.NwAdminOrg, s.config.NwAdminRole, s.config.OrgAdminRole); err != nil {
+		return fmt.Errorf("set policy: %v", err)
+	}
+	if _, err := s.contract.Init(s.config.SubOrgBreadth, s.config.SubOrgDepth); err != nil {
+		return fmt.Errorf("init: %v", err)
+	}
+	for _, node := range staticNodes {
+		url := pcore.GetNodeUrl(node.EnodeID(), node.IP().String(), uint16(node.TCP()), uint16(node.RaftPort()), s.backend.IsRaft)
+		if _, err := s.contract.AddAdminNode(url); err != nil {
+			return fmt.Errorf("add admin node %s: %v", node.EnodeID(), err)
+		}
+	}
+	for _, a := range s.config.Accounts {
+		if _, err := s.contract.AddAdminAccount(a); err != nil {
+			return fmt.Errorf("add admin account %s: %v", a.Hex(), err)
+		}
+	}
+	if _, err := s.contract.UpdateNetworkBootStatus(); err != nil {
+		return fmt.Errorf("update network boot status: %v", err)
+	}
+	return nil
+}
+
+// services returns the permission services sending transactions from the
+// given account
+func (s *Simulator) services(from common.Address) *actionServices {
+	opts := s.sandbox.transactor(from)
+	model := &v2.PermissionModelV2{
+		ContractBackend: s.backend,
+		PermInterf:      s.contract.PermInterf,
+		PermInterfSession: &binding.PermInterfaceSession{
+			Contract:     s.contract.PermInterf,
+			CallOpts:     bind.CallOpts{Pending: true},
+			TransactOpts: *opts,
+		},
+	}
+	services := &actionServices{
+		org:     &v2.Org{Backend: model},
+		role:    &v2.Role{Backend: model},
+		node:    &v2.Node{Backend: model},
+		account: &v2.Account{Backend: model},
+	}
+	if s.contract.PermAccessRule != nil {
+		services.accessRule = &v2.AccessRule{Session: &binding.AccessRuleManagerSession{
+			Contract:     s.contract.PermAccessRule,
+			CallOpts:     bind.CallOpts{Pending: true},
+			TransactOpts: *opts,
+		}}
+	}
+	return services
+}
+
+// txArgs converts an action into the arguments of the permission services
+func (a *Action) txArgs() (ptype.TxArgs, error) {
+	args := ptype.TxArgs{
+		OrgId:      a.OrgId,
+		POrgId:     a.ParentOrgId,
+		Url:        a.Url,
+		RoleId:     a.RoleId,
+		IsVoter:    a.IsVoter,
+		IsAdmin:    a.IsAdmin,
+		AcctId:     a.Account,
+		AccessType: a.Access,
+		Action:     a.Status,
+		Target:     a.Target,
+		Allowed:    a.Allowed,
+		ValueCap:   (*big.Int)(a.ValueCap),
+	}
+	if len(a.Selector) != 0 && len(a.Selector) != len(args.Selector) {
+		return args, ErrInvalidSelector
+	}
+	copy(args.Selector[:], a.Selector)
+	return args, nil
+}
+
+// Apply executes the actions in order. A failed action leaves the
+// permission contracts untouched and the simulation carries on with the
+// next one.
+func (s *Simulator) Apply(list []Action) []ActionResult {
+	results := make([]ActionResult, 0, len(list))
+	for i, a := range list {
+		result := ActionResult{Index: i, Method: a.Method}
+		if err := s.apply(&a); err != nil {
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+func (s *Simulator) apply(a *Action) error {
+	f, ok := actions[a.Method]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownAction, a.Method)
+	}
+	args, err := a.txArgs()
+	if err != nil {
+		return err
+	}
+	_, err = f(s.services(a.From), args)
+	return err
+}
+
+// Report reads the permission graph from the contracts and checks it for
+// invariant violations.
+func (s *Simulator) Report() (*Report, error) {
+	booted, err := s.Booted()
+	if err != nil {
+		return nil, err
+	}
+	if !booted {
+		return nil, ErrNetworkNotBooted
+	}
+	report := &Report{}
+	if report.OrgList, err = ptype.ReadOrgs(s.contract); err != nil {
+		return nil, err
+	}
+	if report.RoleList, err = ptype.ReadRoles(s.contract); err != nil {
+		return nil, err
+	}
+	if report.NodeList, err = ptype.ReadNodes(s.contract); err != nil {
+		return nil, err
+	}
+	if report.AcctList, err = ptype.ReadAccounts(s.contract); err != nil {
+		return nil, err
+	}
+	if report.AccessRules, err = s.contract.GetAccessRules(); err != nil {
+		return nil, err
+	}
+	report.Violations = Check(s.config, report)
+	return report, nil
+}
@@ -0,0 +1,343 @@
+package simulate
+
+import (
+	"math/big"
+	"net"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/params"
+	pcore "github.com/ethereum/go-ethereum/permission/core"
+	ptype "github.com/ethereum/go-ethereum/permission/core/types"
+	v2 "github.com/ethereum/go-ethereum/permission/v2"
+	binding "github.com/ethereum/go-ethereum/permission/v2/bind"
+)
+
+var (
+	guardian = common.HexToAddress("0x9a")
+	nwAdmin  = common.HexToAddress("0xad")
+	orgAdmin = common.HexToAddress("0xa1")
+	member   = common.HexToAddress("0xa2")
+	outsider = common.HexToAddress("0xee")
+
+	// the permission implementation exceeds the default code size limit
+	testChainConfig = func() *params.ChainConfig {
+		config := *params.QuorumTestChainConfig
+		config.MaxCodeSize = 128
+		return &config
+	}()
+)
+
+func testNode(t *testing.T, port int) *enode.Node {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return enode.NewV4(&key.PublicKey, net.ParseIP("127.0.0.1"), port, port)
+}
+
+// deploys the v2 permission contracts into an empty state
+func deployContracts(t *testing.T) (*state.StateDB, *types.Header, *ptype.PermissionConfig) {
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	header := &types.Header{Number: big.NewInt(0), Difficulty: big.NewInt(1)}
+	sb := newSandbox(statedb, header, testChainConfig)
+	auth := sb.transactor(guardian)
+
+	config := &ptype.PermissionConfig{
+		PermissionsModel: ptype.PERMISSION_V2,
+		NwAdminOrg:       "NWADMIN",
+		NwAdminRole:      "NWADMIN",
+		OrgAdminRole:     "ORGADMIN",
+		Accounts:         []common.Address{nwAdmin},
+		SubOrgDepth:      big.NewInt(4),
+		SubOrgBreadth:    big.NewInt(4),
+	}
+	var (
+		upgr *binding.PermUpgr
+		err  error
+	)
+	if config.UpgrdAddress, _, upgr, err = binding.DeployPermUpgr(auth, sb, guardian); err != nil {
+		t.Fatalf("failed to deploy upgradable: %v", err)
+	}
+	if config.InterfAddress, _, _, err = binding.DeployPermInterface(auth, sb, config.UpgrdAddress); err != nil {
+		t.Fatalf("failed to deploy interface: %v", err)
+	}
+	if config.NodeAddress, _, _, err = binding.DeployNodeManager(auth, sb, config.UpgrdAddress); err != nil {
+		t.Fatalf("failed to deploy node manager: %v", err)
+	}
+	if config.RoleAddress, _, _, err = binding.DeployRoleManager(auth, sb, config.UpgrdAddress); err != nil {
+		t.Fatalf("failed to deploy role manager: %v", err)
+	}
+	if config.AccountAddress, _, _, err = binding.DeployAcctManager(auth, sb, config.UpgrdAddress); err != nil {
+		t.Fatalf("failed to deploy account manager: %v", err)
+	}
+	if config.OrgAddress, _, _, err = binding.DeployOrgManager(auth, sb, config.UpgrdAddress); err != nil {
+		t.Fatalf("failed to deploy org manager: %v", err)
+	}
+	if config.VoterAddress, _, _, err = binding.DeployVoterManager(auth, sb, config.UpgrdAddress); err != nil {
+		t.Fatalf("failed to deploy voter manager: %v", err)
+	}
+	if config.ImplAddress, _, _, err = binding.DeployPermImpl(auth, sb, config.UpgrdAddress, config.OrgAddress, config.RoleAddress, config.AccountAddress, config.VoterAddress, config.NodeAddress); err != nil {
+		t.Fatalf("failed to deploy implementation: %v", err)
+	}
+	if _, err := upgr.Init(auth, config.InterfAddress, config.ImplAddress); err != nil {
+		t.Fatalf("failed to init upgradable: %v", err)
+	}
+	if config.AccessRuleAddress, _, _, err = binding.DeployAccessRuleManager(auth, sb, config.UpgrdAddress); err != nil {
+		t.Fatalf("failed to deploy access rule manager: %v", err)
+	}
+	return sb.state, header, config
+}
+
+func hasViolation(r *Report, invariant, subject string) bool {
+	for _, v := range r.Violations {
+		if v.Invariant == invariant && v.Subject == subject {
+			return true
+		}
+	}
+	return false
+}
+
+func TestSimulator(t *testing.T) {
+	statedb, header, config := deployContracts(t)
+	sim, err := New(statedb, header, testChainConfig, config, false)
+	if err != nil {
+		t.Fatalf("failed to create simulator: %v", err)
+	}
+	if _, err := sim.Report(); err != ErrNetworkNotBooted {
+		t.Fatalf("expected report to fail before boot, got %v", err)
+	}
+	if err := sim.Boot([]*enode.Node{testNode(t, 21000)}); err != nil {
+		t.Fatalf("failed to boot: %v", err)
+	}
+	report, err := sim.Report()
+	if err != nil {
+		t.Fatalf("failed to report: %v", err)
+	}
+	if len(report.Violations) != 0 || len(report.OrgList) != 1 || len(report.NodeList) != 1 || len(report.AcctList) != 1 {
+		t.Fatalf("unexpected report after boot: %+v", report)
+	}
+
+	url := testNode(t, 21001).String()
+	results := sim.Apply([]Action{
+		{Method: "addOrg", From: nwAdmin, OrgId: "ORG1", Url: url, Account: orgAdmin},
+		{Method: "approveOrg", From: nwAdmin, OrgId: "ORG1", Url: url, Account: orgAdmin},
+		{Method: "addNewRole", From: orgAdmin, OrgId: "ORG1", RoleId: "MEMBER", Access: 1},
+		{Method: "addAccountToOrg", From: orgAdmin, OrgId: "ORG1", RoleId: "MEMBER", Account: member},
+		{Method: "addSubOrg", From: orgAdmin, ParentOrgId: "ORG1", OrgId: "SUB1"},
+		{Method: "addOrg", From: outsider, OrgId: "ORG2", Url: testNode(t, 21002).String(), Account: outsider},
+		{Method: "unknown", From: nwAdmin},
+	})
+	for i, failed := range []bool{false, false, false, false, false, true, true} {
+		if (results[i].Error != "") != failed {
+			t.Errorf("action %d (%s): unexpected result %q", i, results[i].Method, results[i].Error)
+		}
+	}
+	if report, err = sim.Report(); err != nil {
+		t.Fatalf("failed to report: %v", err)
+	}
+	if len(report.Violations) != 0 {
+		t.Errorf("unexpected violations: %v", report.Violations)
+	}
+	if len(report.OrgList) != 3 || len(report.AcctList) != 3 || len(report.RoleList) != 3 {
+		t.Errorf("unexpected graph: %+v", report)
+	}
+
+	// dropping the network admin and the role of an active account break
+	// the invariants
+	violations := Check(config, &Report{
+		OrgList:  report.OrgList,
+		RoleList: report.RoleList[:2],
+		NodeList: report.NodeList,
+		AcctList: append(report.AcctList[:0:0], report.AcctList[1:]...),
+	})
+	report = &Report{Violations: violations}
+	if !hasViolation(report, NoNetworkAdmin, "") || !hasViolation(report, AccountInvalidRole, member.Hex()) {
+		t.Errorf("expected violations, got %v", violations)
+	}
+
+	// the original state must be left untouched
+	if booted, err := New(statedb, header, testChainConfig, config, false); err != nil {
+		t.Fatal(err)
+	} else if ok, _ := booted.Booted(); ok {
+		t.Errorf("simulation modified the original state")
+	}
+}
+
+func TestSimulator_whenAccessRuleSet(t *testing.T) {
+	statedb, header, config := deployContracts(t)
+	sim, err := New(statedb, header, testChainConfig, config, false)
+	if err != nil {
+		t.Fatalf("failed to create simulator: %v", err)
+	}
+	if err := sim.Boot([]*enode.Node{testNode(t, 21000)}); err != nil {
+		t.Fatalf("failed to boot: %v", err)
+	}
+	var (
+		url     = testNode(t, 21001).String()
+		denied  = common.HexToAddress("0xd1")
+		allowed = common.HexToAddress("0xd2")
+	)
+	results := sim.Apply([]Action{
+		{Method: "addOrg", From: nwAdmin, OrgId: "ORG1", Url: url, Account: orgAdmin},
+		{Method: "approveOrg", From: nwAdmin, OrgId: "ORG1", Url: url, Account: orgAdmin},
+		{Method: "addNewRole", From: orgAdmin, OrgId: "ORG1", RoleId: "MEMBER", Access: 1},
+		{Method: "addAccountToOrg", From: orgAdmin, OrgId: "ORG1", RoleId: "MEMBER", Account: member},
+		{Method: "setAccessRule", From: orgAdmin, OrgId: "ORG1", RoleId: "MEMBER", Target: denied},
+		{Method: "setAccessRule", From: outsider, OrgId: "ORG1", RoleId: "MEMBER", Target: allowed, Allowed: true},
+	})
+	for i, failed := range []bool{false, false, false, false, false, true} {
+		if (results[i].Error != "") != failed {
+			t.Errorf("action %d (%s): unexpected result %q", i, results[i].Method, results[i].Error)
+		}
+	}
+	report, err := sim.Report()
+	if err != nil {
+		t.Fatalf("failed to report: %v", err)
+	}
+	if len(report.AccessRules) != 1 || report.AccessRules[0].Target != denied || report.AccessRules[0].Allowed {
+		t.Fatalf("unexpected access rules: %+v", report.AccessRules)
+	}
+
+	// replay the contract events into the caches used for enforcement
+	pcore.SetDefaults(config.NwAdminRole, config.OrgAdminRole, true)
+	pcore.SetQIP714BlockReached()
+	pcore.OrgInfoMap = pcore.NewOrgCache(params.DEFAULT_ORGCACHE_SIZE)
+	pcore.RoleInfoMap = pcore.NewRoleCache(params.DEFAULT_ROLECACHE_SIZE)
+	pcore.NodeInfoMap = pcore.NewNodeCache(params.DEFAULT_NODECACHE_SIZE)
+	pcore.AcctInfoMap = pcore.NewAcctCache(params.DEFAULT_ACCOUNTCACHE_SIZE)
+	pcore.AccessRuleMap = pcore.NewAccessRuleCache()
+	defer func() { pcore.AccessRuleMap = nil }()
+
+	decoder := pcore.NewEventDecoder(v2.EventContracts(config))
+	caches := pcore.GlobalCaches()
+	for _, l := range sim.sandbox.state.Logs() {
+		if e, err := decoder.Decode(*l); err == nil {
+			caches.Apply(e, false)
+		}
+	}
+	if err := pcore.CheckAccessRules(member, denied, big.NewInt(0), nil, pcore.ValueTransferTxn, false); err != pcore.ErrAccessRuleDenied {
+		t.Errorf("expected the call to the denied target to fail, got %v", err)
+	}
+	if err := pcore.CheckAccessRules(member, allowed, big.NewInt(0), nil, pcore.ValueTransferTxn, false); err != nil {
+		t.Errorf("expected the call to other targets to pass, got %v", err)
+	}
+	if err := pcore.CheckAccessRules(orgAdmin, denied, big.NewInt(0), nil, pcore.ValueTransferTxn, false); err != nil {
+		t.Errorf("expected admin accounts to bypass access rules, got %v", err)
+	}
+}
+
+func TestSimulator_whenActionApproved(t *testing.T) {
+	statedb, header, config := deployContracts(t)
+	sim, err := New(statedb, header, testChainConfig, config, false)
+	if err != nil {
+		t.Fatalf("failed to create simulator: %v", err)
+	}
+	if err := sim.Boot([]*enode.Node{testNode(t, 21000)}); err != nil {
+		t.Fatalf("failed to boot: %v", err)
+	}
+	if _, err := v2.ActionManagerAddress(sim.backend); err != ptype.ErrNoActionContract {
+		t.Fatalf("expected no action manager before it is configured, got %v", err)
+	}
+
+	// the action manager is set in the permission config, with a threshold of
+	// 2 network admins
+	actionAddress, _, _, err := binding.DeployActionManager(sim.sandbox.transactor(guardian), sim.sandbox, config.UpgrdAddress, big.NewInt(2))
+	if err != nil {
+		t.Fatalf("failed to deploy action manager: %v", err)
+	}
+	config.ActionAddress = actionAddress
+	if address, err := v2.ActionManagerAddress(sim.backend); err != nil || address != actionAddress {
+		t.Fatalf("unexpected action manager %x, %v", address, err)
+	}
+
+	// the action manager must be a network admin to execute the actions
+	nwAdmin2 := common.HexToAddress("0xae")
+	url := testNode(t, 21001).String()
+	results := sim.Apply([]Action{
+		{Method: "assignAdminRole", From: nwAdmin, OrgId: config.NwAdminOrg, RoleId: config.NwAdminRole, Account: nwAdmin2},
+		{Method: "approveAdminRole", From: nwAdmin, OrgId: config.NwAdminOrg, Account: nwAdmin2},
+		{Method: "assignAdminRole", From: nwAdmin, OrgId: config.NwAdminOrg, RoleId: config.NwAdminRole, Account: actionAddress},
+		{Method: "approveAdminRole", From: nwAdmin, OrgId: config.NwAdminOrg, Account: actionAddress},
+		{Method: "approveAdminRole", From: nwAdmin2, OrgId: config.NwAdminOrg, Account: actionAddress},
+		{Method: "addOrg", From: nwAdmin, OrgId: "ORG1", Url: url, Account: orgAdmin},
+		{Method: "approveOrg", From: nwAdmin, OrgId: "ORG1", Url: url, Account: orgAdmin},
+	})
+	for _, r := range results {
+		if r.Error != "" {
+			t.Fatalf("action %d (%s) failed: %s", r.Index, r.Method, r.Error)
+		}
+	}
+
+	service := func(from common.Address) ptype.ActionService {
+		s, err := (&v2.Backend{}).GetActionService(sim.sandbox.transactor(from), sim.backend)
+		if err != nil {
+			t.Fatalf("failed to get action service: %v", err)
+		}
+		return s
+	}
+	orgStatus := func() pcore.OrgStatus {
+		report, err := sim.Report()
+		if err != nil {
+			t.Fatalf("failed to report: %v", err)
+		}
+		for _, o := range report.OrgList {
+			if o.OrgId == "ORG1" {
+				return o.Status
+			}
+		}
+		t.Fatalf("org not found")
+		return 0
+	}
+
+	// the vote of the proposer is not enough
+	if _, err := service(nwAdmin).ProposeAction(ptype.TxArgs{ActionType: v2.ActionApproveOrg, OrgId: "ORG1", Url: url, AcctId: orgAdmin, Duration: 10}); err != nil {
+		t.Fatalf("failed to propose: %v", err)
+	}
+	pending, err := sim.contract.GetPendingActions(header.Number.Uint64())
+	if err != nil || len(pending) != 1 || pending[0].Proposer != nwAdmin || pending[0].VoteCount != 1 || pending[0].OrgId != "ORG1" {
+		t.Fatalf("unexpected pending actions: %+v, %v", pending, err)
+	}
+	if status := orgStatus(); status != pcore.OrgPendingApproval {
+		t.Fatalf("expected the org to be pending approval, got %v", status)
+	}
+	if _, err := service(nwAdmin).VoteAction(ptype.TxArgs{ActionId: 0}); err == nil {
+		t.Errorf("expected a second vote of the proposer to fail")
+	}
+	if _, err := service(outsider).VoteAction(ptype.TxArgs{ActionId: 0}); err == nil {
+		t.Errorf("expected the vote of an outsider to fail")
+	}
+
+	// reaching the threshold executes the action
+	if _, err := service(nwAdmin2).VoteAction(ptype.TxArgs{ActionId: 0}); err != nil {
+		t.Fatalf("failed to vote: %v", err)
+	}
+	if pending, err := sim.contract.GetPendingActions(header.Number.Uint64()); err != nil || len(pending) != 0 {
+		t.Errorf("expected no pending actions, got %+v, %v", pending, err)
+	}
+	if status := orgStatus(); status != pcore.OrgApproved {
+		t.Errorf("expected the org to be approved, got %v", status)
+	}
+	if _, err := service(nwAdmin2).CancelAction(ptype.TxArgs{ActionId: 0}); err == nil {
+		t.Errorf("expected an executed action not to be cancelled")
+	}
+
+	// only the proposer can cancel a pending action
+	if _, err := service(nwAdmin2).ProposeAction(ptype.TxArgs{ActionType: v2.ActionApproveOrg, OrgId: "ORG1", Url: url, AcctId: orgAdmin, Duration: 10}); err != nil {
+		t.Fatalf("failed to propose: %v", err)
+	}
+	if _, err := service(nwAdmin).CancelAction(ptype.TxArgs{ActionId: 1}); err == nil {
+		t.Errorf("expected another network admin not to cancel the action")
+	}
+	if _, err := service(nwAdmin2).CancelAction(ptype.TxArgs{ActionId: 1}); err != nil {
+		t.Errorf("failed to cancel: %v", err)
+	}
+	if pending, err := sim.contract.GetPendingActions(header.Number.Uint64()); err != nil || len(pending) != 0 {
+		t.Errorf("expected no pending actions, got %+v, %v", pending, err)
+	}
+}
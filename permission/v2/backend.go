@@ -1,12 +1,9 @@
 package v2
 
 import (
-	"fmt"
-
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/log"
-	"github.com/ethereum/go-ethereum/permission/core"
 	ptype "github.com/ethereum/go-ethereum/permission/core/types"
 	eb "github.com/ethereum/go-ethereum/permission/v2/bind"
 )
@@ -16,248 +13,26 @@ type Backend struct {
 	Contr *Init
 }
 
-func (b *Backend) ManageAccountPermissions() error {
-	chAccessModified := make(chan *eb.AcctManagerAccountAccessModified)
-	chAccessRevoked := make(chan *eb.AcctManagerAccountAccessRevoked)
-	chStatusChanged := make(chan *eb.AcctManagerAccountStatusChanged)
-
-	opts := &bind.WatchOpts{}
-	var blockNumber uint64 = 1
-	opts.Start = &blockNumber
-
-	if _, err := b.Contr.PermAcct.AcctManagerFilterer.WatchAccountAccessModified(opts, chAccessModified); err != nil {
-		return fmt.Errorf("failed AccountAccessModified: %v", err)
-	}
-
-	if _, err := b.Contr.PermAcct.AcctManagerFilterer.WatchAccountAccessRevoked(opts, chAccessRevoked); err != nil {
-		return fmt.Errorf("failed AccountAccessRevoked: %v", err)
+// EventContracts returns the ABIs of the permission contracts emitting the
+// events which update the permission caches, by contract address
+func EventContracts(config *ptype.PermissionConfig) map[common.Address]abi.ABI {
+	contracts := map[common.Address]abi.ABI{
+		config.OrgAddress:     eb.OrgManagerParsedABI,
+		config.NodeAddress:    eb.NodeManagerParsedABI,
+		config.RoleAddress:    eb.RoleManagerParsedABI,
+		config.AccountAddress: eb.AcctManagerParsedABI,
+		config.VoterAddress:   eb.VoterManagerParsedABI,
 	}
-
-	if _, err := b.Contr.PermAcct.AcctManagerFilterer.WatchAccountStatusChanged(opts, chStatusChanged); err != nil {
-		return fmt.Errorf("failed AccountStatusChanged: %v", err)
+	if config.AccessRuleAddress != (common.Address{}) {
+		contracts[config.AccessRuleAddress] = eb.AccessRuleManagerParsedABI
 	}
-
-	go func() {
-		stopChan, stopSubscription := ptype.SubscribeStopEvent()
-		defer stopSubscription.Unsubscribe()
-		for {
-			select {
-			case evtAccessModified := <-chAccessModified:
-				core.AcctInfoMap.UpsertAccount(evtAccessModified.OrgId, evtAccessModified.RoleId, evtAccessModified.Account, evtAccessModified.OrgAdmin, core.AcctStatus(int(evtAccessModified.Status.Uint64())))
-
-			case evtAccessRevoked := <-chAccessRevoked:
-				core.AcctInfoMap.UpsertAccount(evtAccessRevoked.OrgId, evtAccessRevoked.RoleId, evtAccessRevoked.Account, evtAccessRevoked.OrgAdmin, core.AcctActive)
-
-			case evtStatusChanged := <-chStatusChanged:
-				if ac, err := core.AcctInfoMap.GetAccount(evtStatusChanged.Account); ac != nil {
-					core.AcctInfoMap.UpsertAccount(evtStatusChanged.OrgId, ac.RoleId, evtStatusChanged.Account, ac.IsOrgAdmin, core.AcctStatus(int(evtStatusChanged.Status.Uint64())))
-				} else {
-					log.Info("error fetching account information", "err", err)
-				}
-			case <-stopChan:
-				log.Info("quit account contract watch")
-				return
-			}
-		}
-	}()
-	return nil
+	return contracts
 }
 
-func (b *Backend) ManageRolePermissions() error {
-	chRoleCreated := make(chan *eb.RoleManagerRoleCreated, 1)
-	chRoleRevoked := make(chan *eb.RoleManagerRoleRevoked, 1)
-
-	opts := &bind.WatchOpts{}
-	var blockNumber uint64 = 1
-	opts.Start = &blockNumber
-
-	if _, err := b.Contr.PermRole.RoleManagerFilterer.WatchRoleCreated(opts, chRoleCreated); err != nil {
-		return fmt.Errorf("failed WatchRoleCreated: %v", err)
-	}
-
-	if _, err := b.Contr.PermRole.RoleManagerFilterer.WatchRoleRevoked(opts, chRoleRevoked); err != nil {
-		return fmt.Errorf("failed WatchRoleRevoked: %v", err)
-	}
-
-	go func() {
-		stopChan, stopSubscription := ptype.SubscribeStopEvent()
-		defer stopSubscription.Unsubscribe()
-		for {
-			select {
-			case evtRoleCreated := <-chRoleCreated:
-				core.RoleInfoMap.UpsertRole(evtRoleCreated.OrgId, evtRoleCreated.RoleId, evtRoleCreated.IsVoter, evtRoleCreated.IsAdmin, core.AccessType(int(evtRoleCreated.BaseAccess.Uint64())), true)
-
-			case evtRoleRevoked := <-chRoleRevoked:
-				if r, _ := core.RoleInfoMap.GetRole(evtRoleRevoked.OrgId, evtRoleRevoked.RoleId); r != nil {
-					core.RoleInfoMap.UpsertRole(evtRoleRevoked.OrgId, evtRoleRevoked.RoleId, r.IsVoter, r.IsAdmin, r.Access, false)
-				} else {
-					log.Error("Revoke role - cache is missing role", "org", evtRoleRevoked.OrgId, "role", evtRoleRevoked.RoleId)
-				}
-			case <-stopChan:
-				log.Info("quit role contract watch")
-				return
-			}
-		}
-	}()
-	return nil
+func (b *Backend) EventContracts() map[common.Address]abi.ABI {
+	return EventContracts(b.Contr.Backend.PermConfig)
 }
 
-func (b *Backend) ManageOrgPermissions() error {
-	chPendingApproval := make(chan *eb.OrgManagerOrgPendingApproval, 1)
-	chOrgApproved := make(chan *eb.OrgManagerOrgApproved, 1)
-	chOrgSuspended := make(chan *eb.OrgManagerOrgSuspended, 1)
-	chOrgReactivated := make(chan *eb.OrgManagerOrgSuspensionRevoked, 1)
-
-	opts := &bind.WatchOpts{}
-	var blockNumber uint64 = 1
-	opts.Start = &blockNumber
-
-	if _, err := b.Contr.PermOrg.OrgManagerFilterer.WatchOrgPendingApproval(opts, chPendingApproval); err != nil {
-		return fmt.Errorf("failed WatchOrgPendingApproval: %v", err)
-	}
-
-	if _, err := b.Contr.PermOrg.OrgManagerFilterer.WatchOrgApproved(opts, chOrgApproved); err != nil {
-		return fmt.Errorf("failed WatchOrgApproved: %v", err)
-	}
-
-	if _, err := b.Contr.PermOrg.OrgManagerFilterer.WatchOrgSuspended(opts, chOrgSuspended); err != nil {
-		return fmt.Errorf("failed WatchOrgSuspended: %v", err)
-	}
-
-	if _, err := b.Contr.PermOrg.OrgManagerFilterer.WatchOrgSuspensionRevoked(opts, chOrgReactivated); err != nil {
-		return fmt.Errorf("failed WatchOrgSuspensionRevoked: %v", err)
-	}
-
-	go func() {
-		stopChan, stopSubscription := ptype.SubscribeStopEvent()
-		defer stopSubscription.Unsubscribe()
-		for {
-			select {
-			case evtPendingApproval := <-chPendingApproval:
-				core.OrgInfoMap.UpsertOrg(evtPendingApproval.OrgId, evtPendingApproval.PorgId, evtPendingApproval.UltParent, evtPendingApproval.Level, core.OrgStatus(evtPendingApproval.Status.Uint64()))
-
-			case evtOrgApproved := <-chOrgApproved:
-				core.OrgInfoMap.UpsertOrg(evtOrgApproved.OrgId, evtOrgApproved.PorgId, evtOrgApproved.UltParent, evtOrgApproved.Level, core.OrgApproved)
-
-			case evtOrgSuspended := <-chOrgSuspended:
-				core.OrgInfoMap.UpsertOrg(evtOrgSuspended.OrgId, evtOrgSuspended.PorgId, evtOrgSuspended.UltParent, evtOrgSuspended.Level, core.OrgSuspended)
-
-			case evtOrgReactivated := <-chOrgReactivated:
-				core.OrgInfoMap.UpsertOrg(evtOrgReactivated.OrgId, evtOrgReactivated.PorgId, evtOrgReactivated.UltParent, evtOrgReactivated.Level, core.OrgApproved)
-			case <-stopChan:
-				log.Info("quit org contract watch")
-				return
-			}
-		}
-	}()
-	return nil
-}
-
-func (b *Backend) ManageNodePermissions() error {
-	chNodeApproved := make(chan *eb.NodeManagerNodeApproved, 1)
-	chNodeProposed := make(chan *eb.NodeManagerNodeProposed, 1)
-	chNodeDeactivated := make(chan *eb.NodeManagerNodeDeactivated, 1)
-	chNodeActivated := make(chan *eb.NodeManagerNodeActivated, 1)
-	chNodeBlacklisted := make(chan *eb.NodeManagerNodeBlacklisted)
-	chNodeRecoveryInit := make(chan *eb.NodeManagerNodeRecoveryInitiated, 1)
-	chNodeRecoveryDone := make(chan *eb.NodeManagerNodeRecoveryCompleted, 1)
-
-	opts := &bind.WatchOpts{}
-	var blockNumber uint64 = 1
-	opts.Start = &blockNumber
-
-	if _, err := b.Contr.PermNode.NodeManagerFilterer.WatchNodeApproved(opts, chNodeApproved); err != nil {
-		return fmt.Errorf("failed WatchNodeApproved: %v", err)
-	}
-
-	if _, err := b.Contr.PermNode.NodeManagerFilterer.WatchNodeProposed(opts, chNodeProposed); err != nil {
-		return fmt.Errorf("failed WatchNodeProposed: %v", err)
-	}
-
-	if _, err := b.Contr.PermNode.NodeManagerFilterer.WatchNodeDeactivated(opts, chNodeDeactivated); err != nil {
-		return fmt.Errorf("failed NodeDeactivated: %v", err)
-	}
-	if _, err := b.Contr.PermNode.NodeManagerFilterer.WatchNodeActivated(opts, chNodeActivated); err != nil {
-		return fmt.Errorf("failed WatchNodeActivated: %v", err)
-	}
-
-	if _, err := b.Contr.PermNode.NodeManagerFilterer.WatchNodeBlacklisted(opts, chNodeBlacklisted); err != nil {
-		return fmt.Errorf("failed NodeBlacklisting: %v", err)
-	}
-
-	if _, err := b.Contr.PermNode.NodeManagerFilterer.WatchNodeRecoveryInitiated(opts, chNodeRecoveryInit); err != nil {
-		return fmt.Errorf("failed NodeRecoveryInitiated: %v", err)
-	}
-
-	if _, err := b.Contr.PermNode.NodeManagerFilterer.WatchNodeRecoveryCompleted(opts, chNodeRecoveryDone); err != nil {
-		return fmt.Errorf("failed NodeRecoveryCompleted: %v", err)
-	}
-
-	go func() {
-		stopChan, stopSubscription := ptype.SubscribeStopEvent()
-		defer stopSubscription.Unsubscribe()
-		for {
-			select {
-			case evtNodeApproved := <-chNodeApproved:
-				enodeId := core.GetNodeUrl(evtNodeApproved.EnodeId, evtNodeApproved.Ip[:], evtNodeApproved.Port, evtNodeApproved.Raftport, b.Ib.IsRaft())
-				err := ptype.UpdatePermissionedNodes(b.Ib.Node(), b.Ib.DataDir(), enodeId, ptype.NodeAdd, b.Ib.IsRaft())
-				if err != nil {
-					log.Error("error updating permissioned-nodes.json", "err", err)
-				}
-				core.NodeInfoMap.UpsertNode(evtNodeApproved.OrgId, enodeId, core.NodeApproved)
-
-			case evtNodeProposed := <-chNodeProposed:
-				enodeId := core.GetNodeUrl(evtNodeProposed.EnodeId, evtNodeProposed.Ip[:], evtNodeProposed.Port, evtNodeProposed.Raftport, b.Ib.IsRaft())
-				core.NodeInfoMap.UpsertNode(evtNodeProposed.OrgId, enodeId, core.NodePendingApproval)
-
-			case evtNodeDeactivated := <-chNodeDeactivated:
-				enodeId := core.GetNodeUrl(evtNodeDeactivated.EnodeId, evtNodeDeactivated.Ip[:], evtNodeDeactivated.Port, evtNodeDeactivated.Raftport, b.Ib.IsRaft())
-				err := ptype.UpdatePermissionedNodes(b.Ib.Node(), b.Ib.DataDir(), enodeId, ptype.NodeDelete, b.Ib.IsRaft())
-				if err != nil {
-					log.Error("error updating permissioned-nodes.json", "err", err)
-				}
-				core.NodeInfoMap.UpsertNode(evtNodeDeactivated.OrgId, enodeId, core.NodeDeactivated)
-
-			case evtNodeActivated := <-chNodeActivated:
-				enodeId := core.GetNodeUrl(evtNodeActivated.EnodeId, evtNodeActivated.Ip[:], evtNodeActivated.Port, evtNodeActivated.Raftport, b.Ib.IsRaft())
-				err := ptype.UpdatePermissionedNodes(b.Ib.Node(), b.Ib.DataDir(), enodeId, ptype.NodeAdd, b.Ib.IsRaft())
-				if err != nil {
-					log.Error("error updating permissioned-nodes.json", "err", err)
-				}
-				core.NodeInfoMap.UpsertNode(evtNodeActivated.OrgId, enodeId, core.NodeApproved)
-
-			case evtNodeBlacklisted := <-chNodeBlacklisted:
-				enodeId := core.GetNodeUrl(evtNodeBlacklisted.EnodeId, evtNodeBlacklisted.Ip[:], evtNodeBlacklisted.Port, evtNodeBlacklisted.Raftport, b.Ib.IsRaft())
-				core.NodeInfoMap.UpsertNode(evtNodeBlacklisted.OrgId, enodeId, core.NodeBlackListed)
-				err := ptype.UpdateDisallowedNodes(b.Ib.DataDir(), enodeId, ptype.NodeAdd)
-				log.Error("error updating disallowed-nodes.json", "err", err)
-				err = ptype.UpdatePermissionedNodes(b.Ib.Node(), b.Ib.DataDir(), enodeId, ptype.NodeDelete, b.Ib.IsRaft())
-				if err != nil {
-					log.Error("error updating permissioned-nodes.json", "err", err)
-				}
-
-			case evtNodeRecoveryInit := <-chNodeRecoveryInit:
-				enodeId := core.GetNodeUrl(evtNodeRecoveryInit.EnodeId, evtNodeRecoveryInit.Ip[:], evtNodeRecoveryInit.Port, evtNodeRecoveryInit.Raftport, b.Ib.IsRaft())
-				core.NodeInfoMap.UpsertNode(evtNodeRecoveryInit.OrgId, enodeId, core.NodeRecoveryInitiated)
-
-			case evtNodeRecoveryDone := <-chNodeRecoveryDone:
-				enodeId := core.GetNodeUrl(evtNodeRecoveryDone.EnodeId, evtNodeRecoveryDone.Ip[:], evtNodeRecoveryDone.Port, evtNodeRecoveryDone.Raftport, b.Ib.IsRaft())
-				core.NodeInfoMap.UpsertNode(evtNodeRecoveryDone.OrgId, enodeId, core.NodeApproved)
-				err := ptype.UpdateDisallowedNodes(b.Ib.DataDir(), enodeId, ptype.NodeDelete)
-				log.Error("error updating disallowed-nodes.json", "err", err)
-				err = ptype.UpdatePermissionedNodes(b.Ib.Node(), b.Ib.DataDir(), enodeId, ptype.NodeAdd, b.Ib.IsRaft())
-				if err != nil {
-					log.Error("error updating permissioned-nodes.json", "err", err)
-				}
-
-			case <-stopChan:
-				log.Info("quit Node contract watch")
-				return
-			}
-		}
-	}()
-	return nil
-}
 func (b *Backend) MonitorNetworkBootUp() error {
 	return nil
 }
@@ -341,3 +116,38 @@ func (b *Backend) GetControlService(controlBackend ptype.ContractBackend) (ptype
 	}
 	return &Control{Backend: backEnd}, nil
 }
+
+func (b *Backend) GetAccessRuleService(transactOpts *bind.TransactOpts, accessRuleBackend ptype.ContractBackend) (ptype.AccessRuleService, error) {
+	if accessRuleBackend.PermConfig.AccessRuleAddress == (common.Address{}) {
+		return nil, ptype.ErrNoAccessRuleContract
+	}
+	accessRuleManager, err := eb.NewAccessRuleManager(accessRuleBackend.PermConfig.AccessRuleAddress, accessRuleBackend.EthClnt)
+	if err != nil {
+		return nil, err
+	}
+	return &AccessRule{Session: &eb.AccessRuleManagerSession{
+		Contract: accessRuleManager,
+		CallOpts: bind.CallOpts{
+			Pending: true,
+		},
+		TransactOpts: *transactOpts,
+	}}, nil
+}
+
+func (b *Backend) GetActionService(transactOpts *bind.TransactOpts, actionBackend ptype.ContractBackend) (ptype.ActionService, error) {
+	address, err := ActionManagerAddress(actionBackend)
+	if err != nil {
+		return nil, err
+	}
+	actionManager, err := eb.NewActionManager(address, actionBackend.EthClnt)
+	if err != nil {
+		return nil, err
+	}
+	return &Action{Backend: actionBackend, Session: &eb.ActionManagerSession{
+		Contract: actionManager,
+		CallOpts: bind.CallOpts{
+			Pending: true,
+		},
+		TransactOpts: *transactOpts,
+	}}, nil
+}
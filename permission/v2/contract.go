@@ -45,6 +45,47 @@ type Node struct {
 	Backend *PermissionModelV2
 }
 
+type AccessRule struct {
+	Session *binding.AccessRuleManagerSession
+}
+
+type Action struct {
+	Backend ptype.ContractBackend
+	Session *binding.ActionManagerSession
+}
+
+// action types of the action manager contract
+const (
+	ActionApproveOrg uint8 = iota + 1
+	ActionApproveOrgStatus
+	ActionApproveAdminRole
+	ActionApproveNodeRecovery
+	ActionApproveAccountRecovery
+	ActionChangeThreshold
+)
+
+// proposal status of the action manager contract
+const (
+	ActionPending uint64 = iota + 1
+	ActionExecuted
+	ActionCancelled
+)
+
+// ActionInfo is a proposal of the action manager contract
+type ActionInfo struct {
+	Id         uint64         `json:"id"`
+	ActionType uint8          `json:"actionType"`
+	OrgId      string         `json:"orgId"`
+	Url        string         `json:"url,omitempty"`
+	Account    common.Address `json:"account"`
+	Value      uint64         `json:"value"` // status action or new threshold
+	Proposer   common.Address `json:"proposer"`
+	Deadline   uint64         `json:"deadline"` // last block on which the proposal can be voted on
+	Threshold  uint64         `json:"threshold"`
+	VoteCount  uint64         `json:"voteCount"`
+	Status     uint64         `json:"status"`
+}
+
 type Init struct {
 	Backend ptype.ContractBackend
 	//binding contracts
@@ -54,6 +95,8 @@ type Init struct {
 	PermAcct   *binding.AcctManager
 	PermRole   *binding.RoleManager
 	PermOrg    *binding.OrgManager
+	// access rule manager is optional, nil if not configured
+	PermAccessRule *binding.AccessRuleManager
 	//sessions
 	PermInterfSession *binding.PermInterfaceSession
 	permOrgSession    *binding.OrgManagerSession
@@ -113,6 +156,95 @@ func (i *Init) GetNumberOfOrgs() (*big.Int, error) {
 	return i.permOrgSession.GetNumberOfOrgs()
 }
 
+// GetAccessRules returns the active access rules, nil if access rules are not
+// configured
+func (i *Init) GetAccessRules() ([]core.AccessRule, error) {
+	if i.PermAccessRule == nil {
+		return nil, nil
+	}
+	session := &binding.AccessRuleManagerCallerSession{
+		Contract: &i.PermAccessRule.AccessRuleManagerCaller,
+		CallOpts: bind.CallOpts{Pending: true},
+	}
+	count, err := session.GetNumberOfRules()
+	if err != nil {
+		return nil, err
+	}
+	list := []core.AccessRule{}
+	for k := int64(0); k < count.Int64(); k++ {
+		rule, err := session.GetRuleFromIndex(big.NewInt(k))
+		if err != nil {
+			return nil, err
+		}
+		if rule.Active {
+			list = append(list, core.AccessRule{OrgId: rule.OrgId, RoleId: rule.RoleId, Target: rule.Target, Selector: rule.Selector, Allowed: rule.Allowed, ValueCap: rule.ValueCap})
+		}
+	}
+	return list, nil
+}
+
+// ActionManagerAddress returns the action manager set in the permission
+// config. It returns ErrNoActionContract if there is none
+func ActionManagerAddress(backend ptype.ContractBackend) (common.Address, error) {
+	if backend.PermConfig.ActionAddress == (common.Address{}) {
+		return common.Address{}, ptype.ErrNoActionContract
+	}
+	return backend.PermConfig.ActionAddress, nil
+}
+
+// GetPendingActions returns the proposals which can still be voted on at the
+// given block
+func (i *Init) GetPendingActions(blockNumber uint64) ([]ActionInfo, error) {
+	address, err := ActionManagerAddress(i.Backend)
+	if err != nil {
+		return nil, err
+	}
+	caller, err := binding.NewActionManagerCaller(address, i.Backend.EthClnt)
+	if err != nil {
+		return nil, err
+	}
+	session := &binding.ActionManagerCallerSession{
+		Contract: caller,
+		CallOpts: bind.CallOpts{Pending: true},
+	}
+	count, err := session.GetNumberOfActions()
+	if err != nil {
+		return nil, err
+	}
+	list := []ActionInfo{}
+	for k := int64(0); k < count.Int64(); k++ {
+		id := big.NewInt(k)
+		status, err := session.GetActionStatus(id)
+		if err != nil {
+			return nil, err
+		}
+		if status.Status.Uint64() != ActionPending || status.Deadline.Uint64() < blockNumber {
+			continue
+		}
+		action, err := session.GetAction(id)
+		if err != nil {
+			return nil, err
+		}
+		info := ActionInfo{
+			Id:         uint64(k),
+			ActionType: uint8(action.ActionType.Uint64()),
+			OrgId:      action.OrgId,
+			Account:    action.Account,
+			Value:      action.Value.Uint64(),
+			Proposer:   status.Proposer,
+			Deadline:   status.Deadline.Uint64(),
+			Threshold:  status.Threshold.Uint64(),
+			VoteCount:  status.VoteCount.Uint64(),
+			Status:     status.Status.Uint64(),
+		}
+		if action.EnodeId != "" {
+			info.Url = core.GetNodeUrl(action.EnodeId, action.Ip, action.Port, action.Raftport, i.Backend.IsRaft)
+		}
+		list = append(list, info)
+	}
+	return list, nil
+}
+
 func (i *Init) UpdateNetworkBootStatus() (*types.Transaction, error) {
 	return i.PermInterfSession.UpdateNetworkBootStatus()
 }
@@ -252,6 +384,42 @@ func (r *Role) AddNewRole(_args ptype.TxArgs) (*types.Transaction, error) {
 	return r.Backend.PermInterfSession.AddNewRole(_args.RoleId, _args.OrgId, big.NewInt(int64(_args.AccessType)), _args.IsVoter, _args.IsAdmin)
 }
 
+func (a *AccessRule) SetAccessRule(_args ptype.TxArgs) (*types.Transaction, error) {
+	valueCap := _args.ValueCap
+	if valueCap == nil {
+		valueCap = big.NewInt(0)
+	}
+	return a.Session.SetAccessRule(_args.OrgId, _args.RoleId, _args.Target, _args.Selector, _args.Allowed, valueCap)
+}
+
+func (a *AccessRule) RemoveAccessRule(_args ptype.TxArgs) (*types.Transaction, error) {
+	return a.Session.RemoveAccessRule(_args.OrgId, _args.RoleId, _args.Target, _args.Selector)
+}
+
+func (a *Action) ProposeAction(_args ptype.TxArgs) (*types.Transaction, error) {
+	enodeId, ip, port, raftPort, err := getNodeDetails(_args.Url, a.Backend.IsRaft, a.Backend.UseDns)
+	if err != nil {
+		return nil, err
+	}
+	var value uint64
+	switch _args.ActionType {
+	case ActionApproveOrgStatus:
+		value = uint64(_args.Action)
+	case ActionChangeThreshold:
+		value = _args.Threshold
+	}
+	return a.Session.ProposeAction(big.NewInt(int64(_args.ActionType)), _args.OrgId, enodeId, ip, port, raftPort, _args.AcctId,
+		new(big.Int).SetUint64(value), new(big.Int).SetUint64(_args.Duration))
+}
+
+func (a *Action) VoteAction(_args ptype.TxArgs) (*types.Transaction, error) {
+	return a.Session.VoteAction(new(big.Int).SetUint64(_args.ActionId))
+}
+
+func (a *Action) CancelAction(_args ptype.TxArgs) (*types.Transaction, error) {
+	return a.Session.CancelAction(new(big.Int).SetUint64(_args.ActionId))
+}
+
 func (o *Org) ApproveOrgStatus(_args ptype.TxArgs) (*types.Transaction, error) {
 	return o.Backend.PermInterfSession.ApproveOrgStatus(_args.OrgId, big.NewInt(int64(_args.Action)))
 }
@@ -348,6 +516,13 @@ func (i *Init) bindContract() error {
 	}); err != nil {
 		return err
 	}
+	if i.Backend.PermConfig.AccessRuleAddress != (common.Address{}) {
+		if err := ptype.BindContract(&i.PermAccessRule, func() (interface{}, error) {
+			return binding.NewAccessRuleManager(i.Backend.PermConfig.AccessRuleAddress, i.Backend.EthClnt)
+		}); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,114 @@
+pragma solidity >=0.5.3 <0.9.0;
+
+import "./IPermissions.sol";
+/** @title Access rule manager contract
+  * @notice This contract holds the contract and function level access rules
+    of roles. A rule allows or denies the accounts linked to a role to call
+    a target contract, or one function of it, and can cap the value sent
+    along. Rules are managed directly by the network admin or the org admin
+    of the org owning the role. The rules are not enforced by the contract,
+    quorum keeps them in cache from the events emitted here and applies
+    them while validating transactions
+  */
+contract AccessRuleManager {
+    IPermissionsUpgradable private permUpgradable;
+
+    struct AccessRule {
+        string orgId;
+        string roleId;
+        address target;
+        bytes4 selector;
+        bool allowed;
+        uint256 valueCap;
+        bool active;
+    }
+
+    AccessRule[] private ruleList;
+    mapping(bytes32 => uint256) private ruleIndex;
+    uint256 private numberOfRules;
+
+    event AccessRuleSet(string _orgId, string _roleId, address _target,
+        bytes4 _selector, bool _allowed, uint256 _valueCap);
+    event AccessRuleRemoved(string _orgId, string _roleId, address _target,
+        bytes4 _selector);
+
+    /** @notice confirms that the caller is the network admin or an org admin
+         of the org passed
+      * @param _orgId org id
+     */
+    modifier onlyAdmin(string memory _orgId) {
+        IPermissionsImplementation permImpl = IPermissionsImplementation(permUpgradable.getPermImpl());
+        require(permImpl.isNetworkAdmin(msg.sender) || permImpl.isOrgAdmin(msg.sender, _orgId),
+            "account is not an admin of the org");
+        _;
+    }
+
+    /** @notice constructor. sets the permissions upgradable address
+      */
+    constructor (address _permUpgradable) public {
+        permUpgradable = IPermissionsUpgradable(_permUpgradable);
+    }
+
+    /** @notice function to add or update an access rule of a role
+      * @param _orgId org id to which the role belongs
+      * @param _roleId role the rule applies to
+      * @param _target contract address, address(0) matches any target
+      * @param _selector 4 byte function selector, 0 matches any function
+      * @param _allowed true to allow the matching transactions, false to deny them
+      * @param _valueCap maximum value of an allowed transaction, 0 for no cap
+      */
+    function setAccessRule(string calldata _orgId, string calldata _roleId, address _target,
+        bytes4 _selector, bool _allowed, uint256 _valueCap) external onlyAdmin(_orgId) {
+        bytes32 key = keccak256(abi.encode(_orgId, _roleId, _target, _selector));
+        if (ruleIndex[key] == 0) {
+            numberOfRules ++;
+            ruleIndex[key] = numberOfRules;
+            ruleList.push(AccessRule(_orgId, _roleId, _target, _selector, _allowed, _valueCap, true));
+        } else {
+            AccessRule storage rule = ruleList[ruleIndex[key] - 1];
+            rule.allowed = _allowed;
+            rule.valueCap = _valueCap;
+            rule.active = true;
+        }
+        emit AccessRuleSet(_orgId, _roleId, _target, _selector, _allowed, _valueCap);
+    }
+
+    /** @notice function to remove an access rule of a role
+      * @param _orgId org id to which the role belongs
+      * @param _roleId role the rule applies to
+      * @param _target contract address of the rule
+      * @param _selector function selector of the rule
+      */
+    function removeAccessRule(string calldata _orgId, string calldata _roleId, address _target,
+        bytes4 _selector) external onlyAdmin(_orgId) {
+        bytes32 key = keccak256(abi.encode(_orgId, _roleId, _target, _selector));
+        require(ruleIndex[key] != 0 && ruleList[ruleIndex[key] - 1].active, "access rule does not exist");
+        ruleList[ruleIndex[key] - 1].active = false;
+        emit AccessRuleRemoved(_orgId, _roleId, _target, _selector);
+    }
+
+    /** @notice returns the total number of access rules, including removed ones
+      * @return total number of access rules
+      */
+    function getNumberOfRules() external view returns (uint256) {
+        return numberOfRules;
+    }
+
+    /** @notice returns the access rule at a given index
+      * @param _ruleIndex index of the rule
+      * @return _orgId org id to which the role belongs
+      * @return _roleId role the rule applies to
+      * @return _target contract address of the rule
+      * @return _selector function selector of the rule
+      * @return _allowed whether the matching transactions are allowed
+      * @return _valueCap maximum value of an allowed transaction
+      * @return _active whether the rule is active
+      */
+    function getRuleFromIndex(uint256 _ruleIndex) external view returns (string memory _orgId,
+        string memory _roleId, address _target, bytes4 _selector, bool _allowed,
+        uint256 _valueCap, bool _active) {
+        AccessRule storage rule = ruleList[_ruleIndex];
+        return (rule.orgId, rule.roleId, rule.target, rule.selector, rule.allowed,
+            rule.valueCap, rule.active);
+    }
+}
@@ -0,0 +1,221 @@
+pragma solidity >=0.5.3 <0.9.0;
+
+import "./IPermissions.sol";
+/** @title Action manager contract
+  * @notice This contract holds the multi-signature approval workflow of the
+    permission actions needing network admin approval. A network admin
+    proposes an action, the proposal gets an id, a deadline and the vote
+    threshold in force at the time. Network admins vote on the proposal
+    until the threshold is reached, which executes the action, or the
+    deadline passes. Only the proposer can cancel a pending proposal, so
+    that no single network admin can veto the proposals of the others.
+  * @dev the contract executes the actions on the permissions interface
+    contract, found through the permissions upgradable contract, as a
+    network admin. So the contract address must be assigned the network
+    admin role, and for the threshold to be the only approval path it should
+    be the only voter of the network admin org.
+    The action types are:
+        1 - Approve org (orgId, enode, account)
+        2 - Approve org status update (orgId, value = status action)
+        3 - Approve admin role (orgId, account)
+        4 - Approve blacklisted node recovery (orgId, enode)
+        5 - Approve blacklisted account recovery (orgId, account)
+        6 - Change vote threshold (value = new threshold)
+    The proposal status is 1 - pending, 2 - executed, 3 - cancelled. A
+    pending proposal past its deadline is expired and can not be voted on.
+  */
+contract ActionManager {
+    IPermissionsUpgradable private permUpgradable;
+
+    struct NodeDetails {
+        string enodeId;
+        string ip;
+        uint16 port;
+        uint16 raftport;
+    }
+
+    struct Proposal {
+        uint256 actionType;
+        string orgId;
+        NodeDetails node;
+        address account;
+        uint256 value;
+        address proposer;
+        uint256 deadline;
+        uint256 threshold;
+        uint256 voteCount;
+        uint256 status;
+        mapping(address => bool) voted;
+    }
+
+    // a mapping as arrays of structs with mappings can not be grown in 0.8
+    mapping(uint256 => Proposal) private proposalList;
+    uint256 private numberOfProposals;
+    uint256 private threshold;
+
+    event ActionProposed(uint256 _id, uint256 _actionType, string _orgId, address _proposer,
+        uint256 _deadline, uint256 _threshold);
+    event ActionVoted(uint256 _id, address _voter);
+    event ActionExecuted(uint256 _id);
+    event ActionCancelled(uint256 _id, address _caller);
+    event ThresholdChanged(uint256 _threshold);
+
+    /** @notice confirms that the caller is a network admin
+      */
+    modifier onlyNetworkAdmin() {
+        require(_permImpl().isNetworkAdmin(msg.sender), "account is not a network admin account");
+        _;
+    }
+
+    /** @notice confirms that the proposal exists and can be voted on
+      * @param _id proposal id
+      */
+    modifier pendingProposal(uint256 _id) {
+        require(_id < numberOfProposals, "proposal does not exist");
+        require(proposalList[_id].status == 1, "proposal is not pending");
+        _;
+    }
+
+    /** @notice constructor. sets the permissions upgradable address and the
+        initial vote threshold
+      */
+    constructor (address _permUpgradable, uint256 _threshold) public {
+        require(_threshold > 0, "threshold must be positive");
+        permUpgradable = IPermissionsUpgradable(_permUpgradable);
+        threshold = _threshold;
+        emit ThresholdChanged(_threshold);
+    }
+
+    /** @notice proposes an action, counting the vote of the proposer
+      * @param _actionType action type, see the contract dev notes
+      * @param _orgId org id the action applies to
+      * @param _enodeId enode id of the node, for node related actions
+      * @param _ip IP of the node
+      * @param _port tcp port of the node
+      * @param _raftport raft port of the node
+      * @param _account account the action applies to
+      * @param _value status action or new threshold
+      * @param _duration number of blocks the proposal stays open
+      */
+    function proposeAction(uint256 _actionType, string memory _orgId, string memory _enodeId,
+        string memory _ip, uint16 _port, uint16 _raftport, address _account, uint256 _value,
+        uint256 _duration) public onlyNetworkAdmin returns (uint256) {
+        require(_actionType > 0 && _actionType <= 6, "invalid action type");
+        require(_duration > 0, "duration must be positive");
+        require(_actionType != 6 || _value > 0, "threshold must be positive");
+
+        // filled field by field to keep the stack shallow
+        uint256 id = numberOfProposals++;
+        Proposal storage p = proposalList[id];
+        p.actionType = _actionType;
+        p.orgId = _orgId;
+        p.node = NodeDetails(_enodeId, _ip, _port, _raftport);
+        p.account = _account;
+        p.value = _value;
+        p.proposer = msg.sender;
+        p.deadline = block.number + _duration;
+        p.threshold = threshold;
+        p.status = 1;
+        emit ActionProposed(id, _actionType, _orgId, msg.sender, p.deadline, threshold);
+        _vote(id);
+        return id;
+    }
+
+    /** @notice votes on a pending proposal, executing the action once the
+        threshold is reached
+      * @param _id proposal id
+      */
+    function voteAction(uint256 _id) external onlyNetworkAdmin pendingProposal(_id) {
+        require(block.number <= proposalList[_id].deadline, "proposal expired");
+        require(!proposalList[_id].voted[msg.sender], "account already voted");
+        _vote(_id);
+    }
+
+    /** @notice cancels a pending proposal, only the proposer can cancel it
+      * @param _id proposal id
+      */
+    function cancelAction(uint256 _id) external pendingProposal(_id) {
+        require(msg.sender == proposalList[_id].proposer, "account is not the proposer");
+        proposalList[_id].status = 3;
+        emit ActionCancelled(_id, msg.sender);
+    }
+
+    /** @notice returns the number of proposals
+      */
+    function getNumberOfActions() external view returns (uint256) {
+        return numberOfProposals;
+    }
+
+    /** @notice returns the current vote threshold
+      */
+    function getThreshold() external view returns (uint256) {
+        return threshold;
+    }
+
+    /** @notice returns the action of a proposal
+      * @param _id proposal id
+      */
+    function getAction(uint256 _id) external view returns (uint256 _actionType, string memory _orgId,
+        string memory _enodeId, string memory _ip, uint16 _port, uint16 _raftport, address _account,
+        uint256 _value) {
+        Proposal storage p = proposalList[_id];
+        return (p.actionType, p.orgId, p.node.enodeId, p.node.ip, p.node.port, p.node.raftport,
+            p.account, p.value);
+    }
+
+    /** @notice returns the voting status of a proposal
+      * @param _id proposal id
+      */
+    function getActionStatus(uint256 _id) external view returns (address _proposer, uint256 _deadline,
+        uint256 _threshold, uint256 _voteCount, uint256 _status) {
+        Proposal storage p = proposalList[_id];
+        return (p.proposer, p.deadline, p.threshold, p.voteCount, p.status);
+    }
+
+    /** @notice checks if an account voted on a proposal
+      * @param _id proposal id
+      * @param _account account
+      */
+    function hasVoted(uint256 _id, address _account) external view returns (bool) {
+        return proposalList[_id].voted[_account];
+    }
+
+    /** @notice records the vote of the caller and executes the action once
+        the threshold is reached
+      */
+    function _vote(uint256 _id) private {
+        Proposal storage p = proposalList[_id];
+        p.voted[msg.sender] = true;
+        p.voteCount++;
+        emit ActionVoted(_id, msg.sender);
+        if (p.voteCount >= p.threshold) {
+            p.status = 2;
+            _execute(p);
+            emit ActionExecuted(_id);
+        }
+    }
+
+    /** @notice executes the action of a proposal on the permissions interface
+      */
+    function _execute(Proposal storage p) private {
+        IPermissionsInterface permInterface = IPermissionsInterface(permUpgradable.getPermInterface());
+        if (p.actionType == 1) {
+            permInterface.approveOrg(p.orgId, p.node.enodeId, p.node.ip, p.node.port, p.node.raftport, p.account);
+        } else if (p.actionType == 2) {
+            permInterface.approveOrgStatus(p.orgId, p.value);
+        } else if (p.actionType == 3) {
+            permInterface.approveAdminRole(p.orgId, p.account);
+        } else if (p.actionType == 4) {
+            permInterface.approveBlacklistedNodeRecovery(p.orgId, p.node.enodeId, p.node.ip, p.node.port, p.node.raftport);
+        } else if (p.actionType == 5) {
+            permInterface.approveBlacklistedAccountRecovery(p.orgId, p.account);
+        } else {
+            threshold = p.value;
+            emit ThresholdChanged(p.value);
+        }
+    }
+
+    function _permImpl() private view returns (IPermissionsImplementation) {
+        return IPermissionsImplementation(permUpgradable.getPermImpl());
+    }
+}
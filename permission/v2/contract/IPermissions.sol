@@ -0,0 +1,37 @@
+pragma solidity >=0.5.3 <0.9.0;
+
+/** @title Permissions upgradable interface
+  * @notice Minimal interface of the permissions upgradable contract for the
+    contracts built on top of the permissions contracts. It lets them be
+    compiled and deployed without the code of the permissions contracts
+  */
+interface IPermissionsUpgradable {
+    function getPermImpl() external view returns (address);
+    function getPermInterface() external view returns (address);
+}
+
+/** @title Permissions implementation interface
+  * @notice Minimal interface of the permissions implementation contract
+  */
+interface IPermissionsImplementation {
+    function isNetworkAdmin(address _account) external view returns (bool);
+    function isOrgAdmin(address _account, string calldata _orgId) external view returns (bool);
+    function setMigrationPolicy(string calldata _nwAdminOrg, string calldata _nwAdminRole,
+        string calldata _oAdminRole, bool _networkBootStatus) external;
+    function getPolicyDetails() external view returns (string memory, string memory, string memory, bool);
+}
+
+/** @title Permissions interface interface
+  * @notice Minimal interface of the permissions interface contract, with the
+    network admin approvals
+  */
+interface IPermissionsInterface {
+    function approveOrg(string calldata _orgId, string calldata _enodeId, string calldata _ip, uint16 _port,
+        uint16 _raftport, address _account) external;
+    function approveOrgStatus(string calldata _orgId, uint256 _action) external;
+    function approveAdminRole(string calldata _orgId, address _account) external;
+    function approveBlacklistedNodeRecovery(string calldata _orgId, string calldata _enodeId, string calldata _ip,
+        uint16 _port, uint16 _raftport) external;
+    function approveBlacklistedAccountRecovery(string calldata _orgId, address _account) external;
+    function setPermImplementation(address _permImplementation) external;
+}
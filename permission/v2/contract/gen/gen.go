@@ -4,9 +4,13 @@
 //
 // Require:
 // 1. solc 0.5.4
-// 2. abigen (make all from root)
+// 2. solc 0.8.21 for the contracts built on IPermissions.sol, with --evm-version istanbul
+//    as the EVM does not support PUSH0
+// 3. abigen (make all from root)
 
+//go:generate solc --abi --bin --evm-version istanbul -o . --overwrite ../AccessRuleManager.sol
 //go:generate solc --abi --bin -o . --overwrite ../AccountManager.sol
+//go:generate solc --abi --bin --evm-version istanbul -o . --overwrite ../ActionManager.sol
 //go:generate solc --abi --bin -o . --overwrite ../NodeManager.sol
 //go:generate solc --abi --bin -o . --overwrite ../OrgManager.sol
 //go:generate solc --abi --bin -o . --overwrite ../PermissionsImplementation.sol
@@ -15,7 +19,9 @@
 //go:generate solc --abi --bin -o . --overwrite ../RoleManager.sol
 //go:generate solc --abi --bin -o . --overwrite ../VoterManager.sol
 
+//go:generate abigen -pkg bind -abi  ./AccessRuleManager.abi         -bin  ./AccessRuleManager.bin         -type AccessRuleManager -out ../../bind/access_rules.go
 //go:generate abigen -pkg bind -abi  ./AccountManager.abi            -bin  ./AccountManager.bin            -type AcctManager   -out ../../bind/accounts.go
+//go:generate abigen -pkg bind -abi  ./ActionManager.abi             -bin  ./ActionManager.bin             -type ActionManager -out ../../bind/actions.go
 //go:generate abigen -pkg bind -abi  ./NodeManager.abi               -bin  ./NodeManager.bin               -type NodeManager   -out ../../bind/nodes.go
 //go:generate abigen -pkg bind -abi  ./OrgManager.abi                -bin  ./OrgManager.bin                -type OrgManager    -out ../../bind/org.go
 //go:generate abigen -pkg bind -abi  ./PermissionsImplementation.abi -bin  ./PermissionsImplementation.bin -type PermImpl      -out ../../bind/permission_impl.go
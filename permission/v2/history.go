@@ -0,0 +1,408 @@
+package v2
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/permission/core"
+	ptype "github.com/ethereum/go-ethereum/permission/core/types"
+)
+
+var (
+	historyLogPrefix = []byte("ph-log-") // historyLogPrefix + block number + log index -> indexedLog
+	historyHeadKey   = []byte("ph-head") // last block fully indexed
+
+	ErrHistoryNotSynced = errors.New("permission history is still being indexed")
+)
+
+const (
+	historyBatchSize     = 10000       // blocks filtered at once when catching up
+	historyMaxRetryDelay = time.Minute // longest wait before retrying a failed catch up
+)
+
+var historyRetryDelay = time.Second // first wait before retrying a failed catch up, doubled on every failure
+
+// HistoryBackend gives access to the chain data needed to index the permission events
+type HistoryBackend interface {
+	CurrentBlock() uint64
+	// TxSender returns the sender of the transaction which emitted the log
+	TxSender(l types.Log) (common.Address, error)
+}
+
+// PermissionEvent is an event emitted by one of the permission contracts along
+// with the account that sent the transaction, e.g. the approver of an org
+type PermissionEvent struct {
+	BlockNumber uint64                 `json:"blockNumber"`
+	TxHash      common.Hash            `json:"txHash"`
+	Sender      common.Address         `json:"sender"`
+	Event       string                 `json:"event"`
+	Args        map[string]interface{} `json:"args"`
+}
+
+// PermissionsSnapshot holds the permissions as they were at a given block
+type PermissionsSnapshot struct {
+	BlockNumber    uint64             `json:"blockNumber"`
+	OrgList        []core.OrgInfo     `json:"orgList"`
+	NodeList       []core.NodeInfo    `json:"nodeList"`
+	RoleList       []core.RoleInfo    `json:"roleList"`
+	AcctList       []core.AccountInfo `json:"acctList"`
+	AccessRuleList []core.AccessRule  `json:"accessRuleList,omitempty"`
+}
+
+type indexedLog struct {
+	Log    types.Log      `json:"log"`
+	Sender common.Address `json:"sender"`
+}
+
+// History indexes the events of the permission contracts in a local database so
+// that the past permissions of the network can be audited
+type History struct {
+	db      ethdb.Database
+	backend HistoryBackend
+	client  bind.ContractFilterer
+	decoder *core.EventDecoder
+	isRaft  bool
+	synced  int32 // set once the events of past blocks are indexed
+
+	mu      sync.Mutex
+	syncErr error // last failure of the catch up, retried until it succeeds
+}
+
+func NewHistory(db ethdb.Database, backend HistoryBackend, client bind.ContractFilterer, config *ptype.PermissionConfig, isRaft bool) *History {
+	return &History{db: db, backend: backend, client: client, decoder: core.NewEventDecoder(EventContracts(config)), isRaft: isRaft}
+}
+
+// Start keeps indexing the permission events until the permission service
+// stops. The events emitted since the last run are indexed in the background,
+// queries fail with ErrHistoryNotSynced until they are
+func (h *History) Start() error {
+	addresses := h.decoder.Addresses()
+	// subscribe before catching up so that no block falls in between
+	chLogs := make(chan types.Log, 16)
+	sub, err := h.client.SubscribeFilterLogs(context.Background(), ethereum.FilterQuery{Addresses: addresses}, chLogs)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to permission events: %v", err)
+	}
+
+	from := uint64(1)
+	if head, err := h.db.Get(historyHeadKey); err == nil {
+		from = binary.BigEndian.Uint64(head) + 1
+	}
+	head := h.backend.CurrentBlock()
+
+	stopChan, stopSubscription := ptype.SubscribeStopEvent()
+	quit := make(chan struct{})
+	go func() {
+		defer close(quit)
+		<-stopChan
+	}()
+	go h.catchUp(addresses, from, head, quit)
+	go func() {
+		defer stopSubscription.Unsubscribe()
+		defer func() { sub.Unsubscribe() }()
+
+		var (
+			subErr = sub.Err()
+			retry  <-chan time.Time
+			delay  = historyRetryDelay
+		)
+		for {
+			select {
+			case l := <-chLogs:
+				if err := h.index(l); err != nil {
+					log.Error("failed to index permission event", "block", l.BlockNumber, "tx", l.TxHash, "err", err)
+					continue
+				}
+				// the head is moved by the catch up until it is done, later
+				// logs of the same block may still be in flight
+				if atomic.LoadInt32(&h.synced) == 1 {
+					if err := h.setHead(l.BlockNumber - 1); err != nil {
+						log.Error("failed to update permission history head", "err", err)
+					}
+				}
+			case err := <-subErr:
+				log.Error("permission history subscription failed, resubscribing", "delay", delay, "err", err)
+				sub.Unsubscribe()
+				subErr, retry = nil, time.After(delay)
+			case <-retry:
+				newSub, err := h.resubscribe(addresses, head, chLogs, quit)
+				if err != nil {
+					if delay *= 2; delay > historyMaxRetryDelay {
+						delay = historyMaxRetryDelay
+					}
+					log.Error("failed to resubscribe to permission events", "delay", delay, "err", err)
+					retry = time.After(delay)
+					continue
+				}
+				sub, subErr, retry, delay = newSub, newSub.Err(), nil, historyRetryDelay
+			case <-quit:
+				log.Info("quit permission history indexing")
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// resubscribe subscribes to the permission events again and indexes the events
+// missed while the subscription was down: those after the last block indexed,
+// or those after the blocks of the catch up while it is running. The head is
+// left to the catch up until it is done
+func (h *History) resubscribe(addresses []common.Address, catchUpHead uint64, chLogs chan types.Log, quit chan struct{}) (ethereum.Subscription, error) {
+	sub, err := h.client.SubscribeFilterLogs(context.Background(), ethereum.FilterQuery{Addresses: addresses}, chLogs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to permission events: %v", err)
+	}
+	synced := atomic.LoadInt32(&h.synced) == 1
+	from := catchUpHead + 1
+	if head, err := h.db.Get(historyHeadKey); synced && err == nil {
+		from = binary.BigEndian.Uint64(head) + 1
+	}
+	if _, err := h.indexBlocks(addresses, from, h.backend.CurrentBlock(), synced, quit); err != nil {
+		sub.Unsubscribe()
+		return nil, err
+	}
+	return sub, nil
+}
+
+// catchUp indexes the events of the given blocks and marks the history as
+// synced once done. A failed catch up is retried, with an increasing delay,
+// from the last block indexed
+func (h *History) catchUp(addresses []common.Address, from, head uint64, quit chan struct{}) {
+	next, delay := from, historyRetryDelay
+	for {
+		var err error
+		if next, err = h.indexBlocks(addresses, next, head, true, quit); err == nil {
+			break
+		}
+		h.setSyncErr(err)
+		log.Error("failed to index permission events, retrying", "from", next, "to", head, "delay", delay, "err", err)
+		select {
+		case <-quit:
+			return
+		case <-time.After(delay):
+		}
+		if delay *= 2; delay > historyMaxRetryDelay {
+			delay = historyMaxRetryDelay
+		}
+	}
+	select {
+	case <-quit:
+		return
+	default:
+	}
+	h.setSyncErr(nil)
+	atomic.StoreInt32(&h.synced, 1)
+	log.Info("permission history indexed", "from", from, "to", head)
+}
+
+// indexBlocks indexes the events of the given blocks, historyBatchSize blocks
+// at a time, moving the head along if asked to. It returns the first block
+// left to index when it fails, or when the history is stopped
+func (h *History) indexBlocks(addresses []common.Address, from, head uint64, moveHead bool, quit chan struct{}) (uint64, error) {
+	for start := from; start <= head; start += historyBatchSize {
+		select {
+		case <-quit:
+			return start, nil
+		default:
+		}
+		end := start + historyBatchSize - 1
+		if end > head {
+			end = head
+		}
+		logs, err := h.client.FilterLogs(context.Background(), ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(start),
+			ToBlock:   new(big.Int).SetUint64(end),
+			Addresses: addresses,
+		})
+		if err != nil {
+			return start, fmt.Errorf("failed to filter blocks %d to %d: %v", start, end, err)
+		}
+		for _, l := range logs {
+			if err := h.index(l); err != nil {
+				return start, fmt.Errorf("failed to index event of tx %s in block %d: %v", l.TxHash.Hex(), l.BlockNumber, err)
+			}
+		}
+		if !moveHead {
+			continue
+		}
+		if err := h.setHead(end); err != nil {
+			return start, fmt.Errorf("failed to update head: %v", err)
+		}
+	}
+	return head + 1, nil
+}
+
+func (h *History) setSyncErr(err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.syncErr = err
+}
+
+// notSyncedErr returns ErrHistoryNotSynced along with the last failure of the
+// catch up, if any
+func (h *History) notSyncedErr() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.syncErr != nil {
+		return fmt.Errorf("%w, last attempt failed: %v", ErrHistoryNotSynced, h.syncErr)
+	}
+	return ErrHistoryNotSynced
+}
+
+func historyLogKey(blockNumber uint64, index uint) []byte {
+	key := make([]byte, len(historyLogPrefix)+12)
+	copy(key, historyLogPrefix)
+	binary.BigEndian.PutUint64(key[len(historyLogPrefix):], blockNumber)
+	binary.BigEndian.PutUint32(key[len(historyLogPrefix)+8:], uint32(index))
+	return key
+}
+
+func (h *History) setHead(blockNumber uint64) error {
+	head := make([]byte, 8)
+	binary.BigEndian.PutUint64(head, blockNumber)
+	return h.db.Put(historyHeadKey, head)
+}
+
+func (h *History) index(l types.Log) error {
+	key := historyLogKey(l.BlockNumber, l.Index)
+	if l.Removed {
+		return h.db.Delete(key)
+	}
+	sender, err := h.backend.TxSender(l)
+	if err != nil {
+		return err
+	}
+	blob, err := json.Marshal(indexedLog{Log: l, Sender: sender})
+	if err != nil {
+		return err
+	}
+	return h.db.Put(key, blob)
+}
+
+// iterate calls fn on the indexed logs, in chain order, up to the given block
+func (h *History) iterate(toBlock uint64, fn func(indexedLog) error) error {
+	if atomic.LoadInt32(&h.synced) == 0 {
+		return h.notSyncedErr()
+	}
+	it := h.db.NewIterator(historyLogPrefix, nil)
+	defer it.Release()
+	for it.Next() {
+		var l indexedLog
+		if err := json.Unmarshal(it.Value(), &l); err != nil {
+			return err
+		}
+		if l.Log.BlockNumber > toBlock {
+			break
+		}
+		if err := fn(l); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+// decode unpacks an indexed log into a PermissionEvent
+func (h *History) decode(l indexedLog) (*PermissionEvent, error) {
+	event, err := h.decoder.Decode(l.Log)
+	if err != nil {
+		return nil, err
+	}
+	return &PermissionEvent{
+		BlockNumber: l.Log.BlockNumber,
+		TxHash:      l.Log.TxHash,
+		Sender:      l.Sender,
+		Event:       event.Name,
+		Args:        event.Args,
+	}, nil
+}
+
+// events returns the events accepted by filter, up to the given block
+func (h *History) events(toBlock uint64, filter func(*PermissionEvent) bool) ([]PermissionEvent, error) {
+	var events []PermissionEvent
+	err := h.iterate(toBlock, func(l indexedLog) error {
+		event, err := h.decode(l)
+		if err != nil {
+			return err
+		}
+		if filter(event) {
+			events = append(events, *event)
+		}
+		return nil
+	})
+	return events, err
+}
+
+// AccountHistory returns the events which changed the access of an account
+func (h *History) AccountHistory(account common.Address) ([]PermissionEvent, error) {
+	return h.events(^uint64(0), func(event *PermissionEvent) bool {
+		for _, name := range []string{"account", "vAccount"} {
+			if a, ok := event.Args[name].(common.Address); ok && a == account {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// OrgHistory returns the events related to an org, its nodes, roles and accounts
+func (h *History) OrgHistory(orgId string) ([]PermissionEvent, error) {
+	return h.events(^uint64(0), func(event *PermissionEvent) bool {
+		return event.Args["orgId"] == orgId
+	})
+}
+
+// PermissionsAt replays the indexed events up to the given block and returns
+// the resulting permissions
+func (h *History) PermissionsAt(blockNumber uint64) (*PermissionsSnapshot, error) {
+	if blockNumber > h.backend.CurrentBlock() {
+		return nil, fmt.Errorf("block %d is in the future", blockNumber)
+	}
+	// every event adds at most one record, caches this large never evict and
+	// never fall back to the contracts, which hold the current state
+	size := 1
+	if err := h.iterate(blockNumber, func(indexedLog) error {
+		size++
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	caches := &core.Caches{
+		Orgs:        core.NewOrgCache(size),
+		Nodes:       core.NewNodeCache(size),
+		Roles:       core.NewRoleCache(size),
+		Accounts:    core.NewAcctCache(size),
+		AccessRules: core.NewAccessRuleCache(),
+	}
+	if err := h.iterate(blockNumber, func(l indexedLog) error {
+		event, err := h.decoder.Decode(l.Log)
+		if err != nil {
+			return err
+		}
+		caches.Apply(event, h.isRaft)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &PermissionsSnapshot{
+		BlockNumber:    blockNumber,
+		OrgList:        caches.Orgs.GetOrgList(),
+		NodeList:       caches.Nodes.GetNodeList(),
+		RoleList:       caches.Roles.GetRoleList(),
+		AcctList:       caches.Accounts.GetAcctList(),
+		AccessRuleList: caches.AccessRules.GetAccessRuleList(),
+	}, nil
+}
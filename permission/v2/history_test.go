@@ -0,0 +1,250 @@
+package v2
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/permission/core"
+	ptype "github.com/ethereum/go-ethereum/permission/core/types"
+	"github.com/ethereum/go-ethereum/permission/internal/testutils"
+	eb "github.com/ethereum/go-ethereum/permission/v2/bind"
+)
+
+var (
+	testConfig = &ptype.PermissionConfig{
+		OrgAddress:     common.HexToAddress("0x01"),
+		AccountAddress: common.HexToAddress("0x02"),
+		RoleAddress:    common.HexToAddress("0x03"),
+		NodeAddress:    common.HexToAddress("0x04"),
+		VoterAddress:   common.HexToAddress("0x05"),
+	}
+	admin   = common.HexToAddress("0xad")
+	account = common.HexToAddress("0xac")
+)
+
+type testHistoryBackend struct{}
+
+func (testHistoryBackend) CurrentBlock() uint64 {
+	return 100
+}
+
+func (testHistoryBackend) TxSender(types.Log) (common.Address, error) {
+	return admin, nil
+}
+
+func TestHistory(t *testing.T) {
+	h := NewHistory(rawdb.NewMemoryDatabase(), testHistoryBackend{}, nil, testConfig, false)
+
+	for _, l := range []types.Log{
+		testutils.EventLog(t, testConfig.OrgAddress, eb.OrgManagerParsedABI, "OrgPendingApproval", 10, 0, "ORG1", "", "ORG1", big.NewInt(1), big.NewInt(1)),
+		testutils.EventLog(t, testConfig.OrgAddress, eb.OrgManagerParsedABI, "OrgApproved", 11, 0, "ORG1", "", "ORG1", big.NewInt(1), big.NewInt(2)),
+		testutils.EventLog(t, testConfig.RoleAddress, eb.RoleManagerParsedABI, "RoleCreated", 12, 0, "ROLE1", "ORG1", big.NewInt(1), false, false),
+		testutils.EventLog(t, testConfig.AccountAddress, eb.AcctManagerParsedABI, "AccountAccessModified", 13, 0, account, "ORG1", "ROLE1", false, big.NewInt(2)),
+		testutils.EventLog(t, testConfig.AccountAddress, eb.AcctManagerParsedABI, "AccountStatusChanged", 20, 0, account, "ORG1", big.NewInt(4)),
+	} {
+		if err := h.index(l); err != nil {
+			t.Fatalf("failed to index: %v", err)
+		}
+	}
+
+	if _, err := h.OrgHistory("ORG1"); err != ErrHistoryNotSynced {
+		t.Fatalf("expected queries to fail before the history is synced, got %v", err)
+	}
+	h.synced = 1
+
+	events, err := h.AccountHistory(account)
+	if err != nil {
+		t.Fatalf("failed to get account history: %v", err)
+	}
+	if len(events) != 2 || events[0].Event != "AccountAccessModified" || events[1].Sender != admin || events[1].Args["orgId"] != "ORG1" {
+		t.Errorf("unexpected account history: %+v", events)
+	}
+	if events, err := h.OrgHistory("ORG1"); err != nil || len(events) != 5 {
+		t.Errorf("unexpected org history: %+v, %v", events, err)
+	}
+
+	snapshot, err := h.PermissionsAt(15)
+	if err != nil {
+		t.Fatalf("failed to replay: %v", err)
+	}
+	if len(snapshot.OrgList) != 1 || snapshot.OrgList[0].Status != core.OrgApproved {
+		t.Errorf("unexpected orgs at block 15: %+v", snapshot.OrgList)
+	}
+	if len(snapshot.RoleList) != 1 || snapshot.RoleList[0].Access != core.Transact {
+		t.Errorf("unexpected roles at block 15: %+v", snapshot.RoleList)
+	}
+	if len(snapshot.AcctList) != 1 || snapshot.AcctList[0].Status != core.AcctActive {
+		t.Errorf("unexpected accounts at block 15: %+v", snapshot.AcctList)
+	}
+	if snapshot, err = h.PermissionsAt(20); err != nil || snapshot.AcctList[0].Status != core.AcctSuspended {
+		t.Errorf("unexpected accounts at block 20: %+v, %v", snapshot, err)
+	}
+	if snapshot, err = h.PermissionsAt(10); err != nil || snapshot.OrgList[0].Status != core.OrgPendingApproval || len(snapshot.AcctList) != 0 {
+		t.Errorf("unexpected permissions at block 10: %+v, %v", snapshot, err)
+	}
+	if _, err := h.PermissionsAt(101); err == nil {
+		t.Errorf("expected replay of a future block to fail")
+	}
+}
+
+// testFilterer serves the logs of the blocks in the range of a query, after
+// failing the given number of queries
+type testFilterer struct {
+	logs          []types.Log
+	queries       int
+	failures      int
+	subscriptions int
+}
+
+func (f *testFilterer) FilterLogs(_ context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	f.queries++
+	if f.failures > 0 {
+		f.failures--
+		return nil, errors.New("filter failed")
+	}
+	var logs []types.Log
+	for _, l := range f.logs {
+		if l.BlockNumber >= q.FromBlock.Uint64() && l.BlockNumber <= q.ToBlock.Uint64() {
+			logs = append(logs, l)
+		}
+	}
+	return logs, nil
+}
+
+func (f *testFilterer) SubscribeFilterLogs(context.Context, ethereum.FilterQuery, chan<- types.Log) (ethereum.Subscription, error) {
+	f.subscriptions++
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		<-quit
+		return nil
+	}), nil
+}
+
+func TestHistory_catchUp(t *testing.T) {
+	filterer := &testFilterer{logs: []types.Log{
+		testutils.EventLog(t, testConfig.OrgAddress, eb.OrgManagerParsedABI, "OrgPendingApproval", 10, 0, "ORG1", "", "ORG1", big.NewInt(1), big.NewInt(1)),
+		testutils.EventLog(t, testConfig.OrgAddress, eb.OrgManagerParsedABI, "OrgApproved", historyBatchSize+1, 0, "ORG1", "", "ORG1", big.NewInt(1), big.NewInt(2)),
+	}}
+	h := NewHistory(rawdb.NewMemoryDatabase(), testHistoryBackend{}, filterer, testConfig, false)
+
+	h.catchUp(h.decoder.Addresses(), 1, historyBatchSize+5, make(chan struct{}))
+	if h.synced != 1 || filterer.queries != 2 {
+		t.Fatalf("expected the history to be synced in 2 batches, synced %d after %d", h.synced, filterer.queries)
+	}
+	if head, err := h.db.Get(historyHeadKey); err != nil || binary.BigEndian.Uint64(head) != historyBatchSize+5 {
+		t.Errorf("unexpected head %x, %v", head, err)
+	}
+	if events, err := h.OrgHistory("ORG1"); err != nil || len(events) != 2 {
+		t.Errorf("unexpected org history: %+v, %v", events, err)
+	}
+
+	// a stopped catch up leaves the history unsynced
+	h = NewHistory(rawdb.NewMemoryDatabase(), testHistoryBackend{}, filterer, testConfig, false)
+	quit := make(chan struct{})
+	close(quit)
+	h.catchUp(h.decoder.Addresses(), 1, historyBatchSize+5, quit)
+	if _, err := h.OrgHistory("ORG1"); err != ErrHistoryNotSynced {
+		t.Errorf("expected queries to fail after a stopped catch up, got %v", err)
+	}
+}
+
+func TestHistory_catchUpRetries(t *testing.T) {
+	defer func(delay time.Duration) { historyRetryDelay = delay }(historyRetryDelay)
+	historyRetryDelay = time.Millisecond
+
+	filterer := &testFilterer{failures: 2, logs: []types.Log{
+		testutils.EventLog(t, testConfig.OrgAddress, eb.OrgManagerParsedABI, "OrgPendingApproval", 10, 0, "ORG1", "", "ORG1", big.NewInt(1), big.NewInt(1)),
+		testutils.EventLog(t, testConfig.OrgAddress, eb.OrgManagerParsedABI, "OrgApproved", historyBatchSize+1, 0, "ORG1", "", "ORG1", big.NewInt(1), big.NewInt(2)),
+	}}
+	h := NewHistory(rawdb.NewMemoryDatabase(), testHistoryBackend{}, filterer, testConfig, false)
+
+	h.catchUp(h.decoder.Addresses(), 1, historyBatchSize+5, make(chan struct{}))
+	if h.synced != 1 || filterer.queries != 4 {
+		t.Fatalf("expected the history to be synced after 2 retries, synced %d after %d", h.synced, filterer.queries)
+	}
+	if events, err := h.OrgHistory("ORG1"); err != nil || len(events) != 2 {
+		t.Errorf("unexpected org history: %+v, %v", events, err)
+	}
+
+	// the failure of a catch up is exposed until it succeeds
+	h = NewHistory(rawdb.NewMemoryDatabase(), testHistoryBackend{}, &testFilterer{failures: 1 << 30}, testConfig, false)
+	quit, done := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(done)
+		h.catchUp(h.decoder.Addresses(), 1, historyBatchSize+5, quit)
+	}()
+	defer func() {
+		close(quit)
+		<-done
+	}()
+	var err error
+	for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		if _, err = h.OrgHistory("ORG1"); strings.Contains(err.Error(), "filter failed") {
+			break
+		}
+	}
+	if !errors.Is(err, ErrHistoryNotSynced) || !strings.Contains(err.Error(), "filter failed") {
+		t.Errorf("expected queries to fail with the catch up error, got %v", err)
+	}
+}
+
+func TestHistory_resubscribe(t *testing.T) {
+	filterer := &testFilterer{logs: []types.Log{
+		testutils.EventLog(t, testConfig.OrgAddress, eb.OrgManagerParsedABI, "OrgPendingApproval", 10, 0, "ORG1", "", "ORG1", big.NewInt(1), big.NewInt(1)),
+		testutils.EventLog(t, testConfig.OrgAddress, eb.OrgManagerParsedABI, "OrgApproved", 60, 0, "ORG1", "", "ORG1", big.NewInt(1), big.NewInt(2)),
+	}}
+	h := NewHistory(rawdb.NewMemoryDatabase(), testHistoryBackend{}, filterer, testConfig, false)
+	addresses := h.decoder.Addresses()
+
+	// while the catch up is running, the events after its blocks are indexed
+	// and the head is left to it
+	sub, err := h.resubscribe(addresses, 50, make(chan types.Log), make(chan struct{}))
+	if err != nil {
+		t.Fatalf("failed to resubscribe: %v", err)
+	}
+	sub.Unsubscribe()
+	if _, err := h.db.Get(historyHeadKey); err == nil {
+		t.Errorf("expected the head to be left to the catch up")
+	}
+	h.synced = 1
+	if events, err := h.OrgHistory("ORG1"); err != nil || len(events) != 1 || events[0].BlockNumber != 60 {
+		t.Errorf("unexpected org history: %+v, %v", events, err)
+	}
+
+	// once synced, the events after the last block indexed are indexed
+	if err := h.setHead(5); err != nil {
+		t.Fatalf("failed to set head: %v", err)
+	}
+	sub, err = h.resubscribe(addresses, 50, make(chan types.Log), make(chan struct{}))
+	if err != nil {
+		t.Fatalf("failed to resubscribe: %v", err)
+	}
+	sub.Unsubscribe()
+	if filterer.subscriptions != 2 {
+		t.Errorf("expected 2 subscriptions, got %d", filterer.subscriptions)
+	}
+	if head, err := h.db.Get(historyHeadKey); err != nil || binary.BigEndian.Uint64(head) != 100 {
+		t.Errorf("unexpected head %x, %v", head, err)
+	}
+	if events, err := h.OrgHistory("ORG1"); err != nil || len(events) != 2 {
+		t.Errorf("unexpected org history: %+v, %v", events, err)
+	}
+
+	// a failed resubscription is reported to be retried
+	if err := h.setHead(5); err != nil {
+		t.Fatalf("failed to set head: %v", err)
+	}
+	filterer.failures = 1
+	if _, err := h.resubscribe(addresses, 50, make(chan types.Log), make(chan struct{})); err == nil || !strings.Contains(err.Error(), "filter failed") {
+		t.Errorf("expected the resubscription to fail, got %v", err)
+	}
+}
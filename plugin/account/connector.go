@@ -4,6 +4,7 @@ import (
 	"context"
 
 	iplugin "github.com/ethereum/go-ethereum/internal/plugin"
+	signingproto "github.com/ethereum/go-ethereum/plugin/account/proto"
 	"github.com/hashicorp/go-plugin"
 	"github.com/jpmorganchase/quorum-account-plugin-sdk-go/proto"
 	"google.golang.org/grpc"
@@ -21,6 +22,7 @@ func (*PluginConnector) GRPCServer(_ *plugin.GRPCBroker, _ *grpc.Server) error {
 
 func (*PluginConnector) GRPCClient(_ context.Context, _ *plugin.GRPCBroker, cc *grpc.ClientConn) (interface{}, error) {
 	return &service{
-		client: proto.NewAccountServiceClient(cc),
+		client:        proto.NewAccountServiceClient(cc),
+		signingClient: signingproto.NewAccountSigningServiceClient(cc),
 	}, nil
 }
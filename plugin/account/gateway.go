@@ -5,18 +5,24 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
+	signingproto "github.com/ethereum/go-ethereum/plugin/account/proto"
 	"github.com/jpmorganchase/quorum-account-plugin-sdk-go/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type service struct {
-	client proto.AccountServiceClient
+	client        proto.AccountServiceClient
+	signingClient signingproto.AccountSigningServiceClient // optional, hash signing is used if not implemented by the plugin
 }
 
 func (g *service) Status(ctx context.Context) (string, error) {
@@ -157,6 +163,124 @@ func (g *service) ImportRawKey(ctx context.Context, rawKey string, newAccountCon
 	return acct, nil
 }
 
+func (g *service) SignTransaction(ctx context.Context, account accounts.Account, tx *types.Transaction, chainID *big.Int, info *accounts.PrivateTxInfo, toSign []byte) ([]byte, error) {
+	return g.signTransaction(ctx, account, tx, chainID, info, toSign, false, "")
+}
+
+func (g *service) UnlockAndSignTransaction(ctx context.Context, account accounts.Account, tx *types.Transaction, chainID *big.Int, info *accounts.PrivateTxInfo, toSign []byte, passphrase string) ([]byte, error) {
+	return g.signTransaction(ctx, account, tx, chainID, info, toSign, true, passphrase)
+}
+
+func (g *service) signTransaction(ctx context.Context, account accounts.Account, tx *types.Transaction, chainID *big.Int, info *accounts.PrivateTxInfo, toSign []byte, unlock bool, passphrase string) ([]byte, error) {
+	if g.signingClient == nil {
+		return g.signHash(ctx, account, toSign, unlock, passphrase)
+	}
+	resp, err := g.signingClient.SignTransaction(ctx, &signingproto.SignTransactionRequest{
+		Address:     account.Address.Bytes(),
+		Transaction: asProtoTransaction(tx, chainID, info),
+		Hash:        toSign,
+		Unlock:      unlock,
+		Passphrase:  passphrase,
+	})
+	if status.Code(err) == codes.Unimplemented {
+		return g.signHash(ctx, account, toSign, unlock, passphrase)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, errors.New("empty response from plugin")
+	}
+	return resp.Sig, nil
+}
+
+func (g *service) SignTypedData(ctx context.Context, account accounts.Account, typedData []byte, rawData []byte) ([]byte, error) {
+	return g.signTypedData(ctx, account, typedData, rawData, false, "")
+}
+
+func (g *service) UnlockAndSignTypedData(ctx context.Context, account accounts.Account, typedData []byte, rawData []byte, passphrase string) ([]byte, error) {
+	return g.signTypedData(ctx, account, typedData, rawData, true, passphrase)
+}
+
+func (g *service) signTypedData(ctx context.Context, account accounts.Account, typedData []byte, rawData []byte, unlock bool, passphrase string) ([]byte, error) {
+	toSign := crypto.Keccak256(rawData)
+	if g.signingClient == nil {
+		return g.signHash(ctx, account, toSign, unlock, passphrase)
+	}
+	resp, err := g.signingClient.SignTypedData(ctx, &signingproto.SignTypedDataRequest{
+		Address:    account.Address.Bytes(),
+		TypedData:  typedData,
+		RawData:    rawData,
+		Hash:       toSign,
+		Unlock:     unlock,
+		Passphrase: passphrase,
+	})
+	if status.Code(err) == codes.Unimplemented {
+		return g.signHash(ctx, account, toSign, unlock, passphrase)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, errors.New("empty response from plugin")
+	}
+	return resp.Sig, nil
+}
+
+// signHash is used when the plugin does not implement the account signing service
+func (g *service) signHash(ctx context.Context, account accounts.Account, toSign []byte, unlock bool, passphrase string) ([]byte, error) {
+	if unlock {
+		return g.UnlockAndSign(ctx, account, toSign, passphrase)
+	}
+	return g.Sign(ctx, account, toSign)
+}
+
+func asProtoTransaction(tx *types.Transaction, chainID *big.Int, info *accounts.PrivateTxInfo) *signingproto.Transaction {
+	pTx := &signingproto.Transaction{
+		Type:            uint32(tx.Type()),
+		Nonce:           tx.Nonce(),
+		Gas:             tx.Gas(),
+		Data:            tx.Data(),
+		IsPrivate:       tx.IsPrivate(),
+		IsPrivacyMarker: tx.IsPrivacyMarker(),
+	}
+	if tx.GasPrice() != nil {
+		pTx.GasPrice = tx.GasPrice().Bytes()
+	}
+	if tx.To() != nil {
+		pTx.To = tx.To().Bytes()
+	}
+	if tx.Value() != nil {
+		pTx.Value = tx.Value().Bytes()
+	}
+	// legacy private transactions are signed without chain ID, see types.QuorumPrivateTxSigner
+	if chainID != nil && !(tx.IsPrivate() && tx.Type() == types.LegacyTxType) {
+		pTx.ChainId = chainID.Bytes()
+	}
+	for _, tuple := range tx.AccessList() {
+		pTuple := &signingproto.AccessTuple{
+			Address:     tuple.Address.Bytes(),
+			StorageKeys: make([][]byte, 0, len(tuple.StorageKeys)),
+		}
+		for _, key := range tuple.StorageKeys {
+			pTuple.StorageKeys = append(pTuple.StorageKeys, key.Bytes())
+		}
+		pTx.AccessList = append(pTx.AccessList, pTuple)
+	}
+	if tx.Type() == types.PrivateTxType {
+		pTx.PrivacyFlag = uint64(tx.PrivacyFlag())
+	}
+	if info != nil {
+		pTx.PrivateArgs = &signingproto.PrivateArgs{
+			PrivateFrom:         info.PrivateFrom,
+			PrivateFor:          info.PrivateFor,
+			PrivacyFlag:         uint64(info.PrivacyFlag),
+			MandatoryRecipients: info.MandatoryRecipients,
+		}
+	}
+	return pTx
+}
+
 func asAccounts(pAccts []*proto.Account) []accounts.Account {
 	accts := make([]accounts.Account, 0, len(pAccts))
 
@@ -3,17 +3,24 @@ package account
 import (
 	"context"
 	"encoding/json"
+	"math/big"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/plugin/account/internal/testutils"
+	signingproto "github.com/ethereum/go-ethereum/plugin/account/proto"
+	"github.com/ethereum/go-ethereum/private/engine"
 	"github.com/golang/mock/gomock"
 	"github.com/jpmorganchase/quorum-account-plugin-sdk-go/mock_proto"
 	"github.com/jpmorganchase/quorum-account-plugin-sdk-go/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var (
@@ -193,6 +200,211 @@ func TestPluginGateway_UnlockAndSign(t *testing.T) {
 	assert.Equal(t, want, got)
 }
 
+func TestPluginGateway_SignTransaction_whenPrivacyMarker(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	want := []byte("signed data")
+	tx := types.NewTransaction(1, common.QuorumPrivacyPrecompileContractAddress(), big.NewInt(2), 3, big.NewInt(4), []byte("arbitrary data"))
+	info := &accounts.PrivateTxInfo{
+		PrivateFrom:         "arbitrary from",
+		PrivateFor:          []string{"arbitrary for"},
+		PrivacyFlag:         engine.PrivacyFlagMandatoryRecipients,
+		MandatoryRecipients: []string{"arbitrary for"},
+	}
+	toSign := []byte("to sign")
+	wantReq := &signingproto.SignTransactionRequest{
+		Address: acct1.Address.Bytes(),
+		Transaction: &signingproto.Transaction{
+			Nonce:           1,
+			GasPrice:        big.NewInt(4).Bytes(),
+			Gas:             3,
+			To:              common.QuorumPrivacyPrecompileContractAddress().Bytes(),
+			Value:           big.NewInt(2).Bytes(),
+			Data:            []byte("arbitrary data"),
+			ChainId:         big.NewInt(10).Bytes(),
+			IsPrivacyMarker: true,
+			PrivateArgs: &signingproto.PrivateArgs{
+				PrivateFrom:         "arbitrary from",
+				PrivateFor:          []string{"arbitrary for"},
+				PrivacyFlag:         uint64(engine.PrivacyFlagMandatoryRecipients),
+				MandatoryRecipients: []string{"arbitrary for"},
+			},
+		},
+		Hash:       toSign,
+		Unlock:     true,
+		Passphrase: "pwd",
+	}
+
+	mockSigningClient := signingproto.NewMockAccountSigningServiceClient(ctrl)
+	mockSigningClient.
+		EXPECT().
+		SignTransaction(gomock.Any(), testutils.SignTransactionRequestMatcher{R: wantReq}).
+		Return(&signingproto.SignResponse{Sig: want}, nil)
+
+	g := &service{signingClient: mockSigningClient}
+	got, err := g.UnlockAndSignTransaction(context.Background(), acct1, tx, big.NewInt(10), info, toSign, "pwd")
+
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestPluginGateway_SignTransaction_whenTypedPrivateTransaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	want := []byte("signed data")
+	to := common.HexToAddress("0x1932c48b2bf8102ba33b4a6b545c32236e342f34")
+	storageKey := common.HexToHash("0x01")
+	tx := types.NewTx(&types.PrivateTx{
+		ChainID:     big.NewInt(10),
+		Nonce:       1,
+		GasPrice:    big.NewInt(4),
+		Gas:         3,
+		To:          &to,
+		Value:       big.NewInt(2),
+		Data:        []byte("arbitrary data"),
+		PrivacyFlag: engine.PrivacyFlagStateValidation,
+		AccessList:  types.AccessList{{Address: to, StorageKeys: []common.Hash{storageKey}}},
+	})
+	toSign := []byte("to sign")
+	wantReq := &signingproto.SignTransactionRequest{
+		Address: acct1.Address.Bytes(),
+		Transaction: &signingproto.Transaction{
+			Type:     types.PrivateTxType,
+			Nonce:    1,
+			GasPrice: big.NewInt(4).Bytes(),
+			Gas:      3,
+			To:       to.Bytes(),
+			Value:    big.NewInt(2).Bytes(),
+			Data:     []byte("arbitrary data"),
+			ChainId:  big.NewInt(10).Bytes(),
+			AccessList: []*signingproto.AccessTuple{{
+				Address:     to.Bytes(),
+				StorageKeys: [][]byte{storageKey.Bytes()},
+			}},
+			IsPrivate:   true,
+			PrivacyFlag: uint64(engine.PrivacyFlagStateValidation),
+		},
+		Hash: toSign,
+	}
+
+	mockSigningClient := signingproto.NewMockAccountSigningServiceClient(ctrl)
+	mockSigningClient.
+		EXPECT().
+		SignTransaction(gomock.Any(), testutils.SignTransactionRequestMatcher{R: wantReq}).
+		Return(&signingproto.SignResponse{Sig: want}, nil)
+
+	g := &service{signingClient: mockSigningClient}
+	got, err := g.SignTransaction(context.Background(), acct1, tx, big.NewInt(10), nil, toSign)
+
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestPluginGateway_SignTransaction_whenLegacyPrivateTransaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	want := []byte("signed data")
+	tx := types.NewContractCreation(1, big.NewInt(0), 3, big.NewInt(0), []byte("arbitrary data"))
+	tx.SetPrivate()
+	toSign := []byte("to sign")
+	wantReq := &signingproto.SignTransactionRequest{
+		Address: acct1.Address.Bytes(),
+		Transaction: &signingproto.Transaction{
+			Type:      types.LegacyTxType,
+			Nonce:     1,
+			GasPrice:  big.NewInt(0).Bytes(),
+			Gas:       3,
+			Value:     big.NewInt(0).Bytes(),
+			Data:      []byte("arbitrary data"),
+			IsPrivate: true,
+		},
+		Hash: toSign,
+	}
+
+	mockSigningClient := signingproto.NewMockAccountSigningServiceClient(ctrl)
+	mockSigningClient.
+		EXPECT().
+		SignTransaction(gomock.Any(), testutils.SignTransactionRequestMatcher{R: wantReq}).
+		Return(&signingproto.SignResponse{Sig: want}, nil)
+
+	g := &service{signingClient: mockSigningClient}
+	got, err := g.SignTransaction(context.Background(), acct1, tx, big.NewInt(10), nil, toSign)
+
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestPluginGateway_SignTransaction_whenNotImplementedByPlugin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	want := []byte("signed data")
+	toSign := []byte("to sign")
+
+	mockSigningClient := signingproto.NewMockAccountSigningServiceClient(ctrl)
+	mockSigningClient.
+		EXPECT().
+		SignTransaction(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.Unimplemented, "unknown service"))
+	mockClient := mock_proto.NewMockAccountServiceClient(ctrl)
+	mockClient.
+		EXPECT().
+		Sign(gomock.Any(), testutils.SignRequestMatcher{R: &proto.SignRequest{Address: acct1.Address.Bytes(), ToSign: toSign}}).
+		Return(&proto.SignResponse{Sig: want}, nil)
+
+	g := &service{client: mockClient, signingClient: mockSigningClient}
+	got, err := g.SignTransaction(context.Background(), acct1, types.NewTransaction(1, common.Address{}, nil, 0, nil, nil), nil, nil, toSign)
+
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestPluginGateway_SignTypedData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	want := []byte("signed data")
+	typedData := []byte(`{"primaryType":"Mail"}`)
+	rawData := []byte("\x19\x01arbitrary hashes")
+	wantReq := &signingproto.SignTypedDataRequest{
+		Address:   acct1.Address.Bytes(),
+		TypedData: typedData,
+		RawData:   rawData,
+		Hash:      crypto.Keccak256(rawData),
+	}
+
+	mockSigningClient := signingproto.NewMockAccountSigningServiceClient(ctrl)
+	mockSigningClient.
+		EXPECT().
+		SignTypedData(gomock.Any(), testutils.SignTypedDataRequestMatcher{R: wantReq}).
+		Return(&signingproto.SignResponse{Sig: want}, nil)
+
+	g := &service{signingClient: mockSigningClient}
+	got, err := g.SignTypedData(context.Background(), acct1, typedData, rawData)
+
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestPluginGateway_SignTypedData_whenError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSigningClient := signingproto.NewMockAccountSigningServiceClient(ctrl)
+	mockSigningClient.
+		EXPECT().
+		SignTypedData(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.PermissionDenied, "denied by signing policy"))
+
+	g := &service{signingClient: mockSigningClient}
+	_, err := g.SignTypedData(context.Background(), acct1, []byte("{}"), []byte("arbitrary data"))
+
+	assert.EqualError(t, err, "rpc error: code = PermissionDenied desc = denied by signing policy")
+}
+
 func TestPluginGateway_TimedUnlock(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
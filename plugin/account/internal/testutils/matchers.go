@@ -3,6 +3,7 @@ package testutils
 import (
 	"fmt"
 
+	signingproto "github.com/ethereum/go-ethereum/plugin/account/proto"
 	. "github.com/golang/protobuf/proto"
 	"github.com/jpmorganchase/quorum-account-plugin-sdk-go/proto"
 )
@@ -182,3 +183,35 @@ func (m ImportRawKeyRequestMatcher) Matches(x interface{}) bool {
 func (m ImportRawKeyRequestMatcher) String() string {
 	return fmt.Sprintf("is %v", m.R)
 }
+
+type SignTransactionRequestMatcher struct {
+	R *signingproto.SignTransactionRequest
+}
+
+func (m SignTransactionRequestMatcher) Matches(x interface{}) bool {
+	r, ok := x.(*signingproto.SignTransactionRequest)
+	if !ok {
+		return false
+	}
+	return Equal(m.R, r)
+}
+
+func (m SignTransactionRequestMatcher) String() string {
+	return fmt.Sprintf("is %v", m.R)
+}
+
+type SignTypedDataRequestMatcher struct {
+	R *signingproto.SignTypedDataRequest
+}
+
+func (m SignTypedDataRequestMatcher) Matches(x interface{}) bool {
+	r, ok := x.(*signingproto.SignTypedDataRequest)
+	if !ok {
+		return false
+	}
+	return Equal(m.R, r)
+}
+
+func (m SignTypedDataRequestMatcher) String() string {
+	return fmt.Sprintf("is %v", m.R)
+}
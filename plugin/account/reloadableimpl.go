@@ -2,16 +2,21 @@ package account
 
 import (
 	"context"
+	"math/big"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	iplugin "github.com/ethereum/go-ethereum/internal/plugin"
 	"github.com/ethereum/go-ethereum/log"
 )
 
 type DispenseFunc func() (Service, error)
 
 type ReloadableService struct {
-	DispenseFunc DispenseFunc
+	DispenseFunc  DispenseFunc
+	CallErrorFunc iplugin.CallErrorFunc // optional, translates the errors of calls to the plugin
 }
 
 func (am *ReloadableService) Status(ctx context.Context) (string, error) {
@@ -19,7 +24,8 @@ func (am *ReloadableService) Status(ctx context.Context) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return s.Status(ctx)
+	status, err := s.Status(ctx)
+	return status, am.CallErrorFunc.Apply(err)
 }
 
 func (am *ReloadableService) Open(ctx context.Context, passphrase string) error {
@@ -27,7 +33,7 @@ func (am *ReloadableService) Open(ctx context.Context, passphrase string) error
 	if err != nil {
 		return err
 	}
-	return s.Open(ctx, passphrase)
+	return am.CallErrorFunc.Apply(s.Open(ctx, passphrase))
 }
 
 func (am *ReloadableService) Close(ctx context.Context) error {
@@ -35,7 +41,7 @@ func (am *ReloadableService) Close(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	return s.Close(ctx)
+	return am.CallErrorFunc.Apply(s.Close(ctx))
 }
 
 func (am *ReloadableService) Accounts(ctx context.Context) []accounts.Account {
@@ -61,7 +67,8 @@ func (am *ReloadableService) Sign(ctx context.Context, account accounts.Account,
 	if err != nil {
 		return nil, err
 	}
-	return s.Sign(ctx, account, toSign)
+	sig, err := s.Sign(ctx, account, toSign)
+	return sig, am.CallErrorFunc.Apply(err)
 }
 
 func (am *ReloadableService) UnlockAndSign(ctx context.Context, account accounts.Account, toSign []byte, passphrase string) ([]byte, error) {
@@ -69,7 +76,8 @@ func (am *ReloadableService) UnlockAndSign(ctx context.Context, account accounts
 	if err != nil {
 		return nil, err
 	}
-	return s.UnlockAndSign(ctx, account, toSign, passphrase)
+	sig, err := s.UnlockAndSign(ctx, account, toSign, passphrase)
+	return sig, am.CallErrorFunc.Apply(err)
 }
 
 func (am *ReloadableService) TimedUnlock(ctx context.Context, account accounts.Account, password string, duration time.Duration) error {
@@ -77,7 +85,7 @@ func (am *ReloadableService) TimedUnlock(ctx context.Context, account accounts.A
 	if err != nil {
 		return err
 	}
-	return s.TimedUnlock(ctx, account, password, duration)
+	return am.CallErrorFunc.Apply(s.TimedUnlock(ctx, account, password, duration))
 }
 
 func (am *ReloadableService) Lock(ctx context.Context, account accounts.Account) error {
@@ -85,7 +93,7 @@ func (am *ReloadableService) Lock(ctx context.Context, account accounts.Account)
 	if err != nil {
 		return err
 	}
-	return s.Lock(ctx, account)
+	return am.CallErrorFunc.Apply(s.Lock(ctx, account))
 }
 
 func (am *ReloadableService) NewAccount(ctx context.Context, newAccountConfig interface{}) (accounts.Account, error) {
@@ -93,7 +101,8 @@ func (am *ReloadableService) NewAccount(ctx context.Context, newAccountConfig in
 	if err != nil {
 		return accounts.Account{}, err
 	}
-	return s.NewAccount(ctx, newAccountConfig)
+	acct, err := s.NewAccount(ctx, newAccountConfig)
+	return acct, am.CallErrorFunc.Apply(err)
 }
 
 func (am *ReloadableService) ImportRawKey(ctx context.Context, rawKey string, newAccountConfig interface{}) (accounts.Account, error) {
@@ -101,5 +110,58 @@ func (am *ReloadableService) ImportRawKey(ctx context.Context, rawKey string, ne
 	if err != nil {
 		return accounts.Account{}, err
 	}
-	return s.ImportRawKey(ctx, rawKey, newAccountConfig)
+	acct, err := s.ImportRawKey(ctx, rawKey, newAccountConfig)
+	return acct, am.CallErrorFunc.Apply(err)
+}
+
+func (am *ReloadableService) SignTransaction(ctx context.Context, account accounts.Account, tx *types.Transaction, chainID *big.Int, info *accounts.PrivateTxInfo, toSign []byte) ([]byte, error) {
+	s, err := am.DispenseFunc()
+	if err != nil {
+		return nil, err
+	}
+	if ss, ok := s.(SigningService); ok {
+		sig, err := ss.SignTransaction(ctx, account, tx, chainID, info, toSign)
+		return sig, am.CallErrorFunc.Apply(err)
+	}
+	sig, err := s.Sign(ctx, account, toSign)
+	return sig, am.CallErrorFunc.Apply(err)
+}
+
+func (am *ReloadableService) UnlockAndSignTransaction(ctx context.Context, account accounts.Account, tx *types.Transaction, chainID *big.Int, info *accounts.PrivateTxInfo, toSign []byte, passphrase string) ([]byte, error) {
+	s, err := am.DispenseFunc()
+	if err != nil {
+		return nil, err
+	}
+	if ss, ok := s.(SigningService); ok {
+		sig, err := ss.UnlockAndSignTransaction(ctx, account, tx, chainID, info, toSign, passphrase)
+		return sig, am.CallErrorFunc.Apply(err)
+	}
+	sig, err := s.UnlockAndSign(ctx, account, toSign, passphrase)
+	return sig, am.CallErrorFunc.Apply(err)
+}
+
+func (am *ReloadableService) SignTypedData(ctx context.Context, account accounts.Account, typedData []byte, rawData []byte) ([]byte, error) {
+	s, err := am.DispenseFunc()
+	if err != nil {
+		return nil, err
+	}
+	if ss, ok := s.(SigningService); ok {
+		sig, err := ss.SignTypedData(ctx, account, typedData, rawData)
+		return sig, am.CallErrorFunc.Apply(err)
+	}
+	sig, err := s.Sign(ctx, account, crypto.Keccak256(rawData))
+	return sig, am.CallErrorFunc.Apply(err)
+}
+
+func (am *ReloadableService) UnlockAndSignTypedData(ctx context.Context, account accounts.Account, typedData []byte, rawData []byte, passphrase string) ([]byte, error) {
+	s, err := am.DispenseFunc()
+	if err != nil {
+		return nil, err
+	}
+	if ss, ok := s.(SigningService); ok {
+		sig, err := ss.UnlockAndSignTypedData(ctx, account, typedData, rawData, passphrase)
+		return sig, am.CallErrorFunc.Apply(err)
+	}
+	sig, err := s.UnlockAndSign(ctx, account, crypto.Keccak256(rawData), passphrase)
+	return sig, am.CallErrorFunc.Apply(err)
 }
@@ -2,9 +2,11 @@ package account
 
 import (
 	"context"
+	"math/big"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/core/types"
 )
 
 type Service interface {
@@ -24,3 +26,14 @@ type CreatorService interface {
 	NewAccount(ctx context.Context, newAccountConfig interface{}) (accounts.Account, error)
 	ImportRawKey(ctx context.Context, rawKey string, newAccountConfig interface{}) (accounts.Account, error)
 }
+
+// SigningService is optionally implemented by a Service to give the plugin the content
+// being signed rather than its hash only
+type SigningService interface {
+	// SignTransaction signs toSign, the signer hash of tx. info is nil if tx is public
+	SignTransaction(ctx context.Context, account accounts.Account, tx *types.Transaction, chainID *big.Int, info *accounts.PrivateTxInfo, toSign []byte) ([]byte, error)
+	UnlockAndSignTransaction(ctx context.Context, account accounts.Account, tx *types.Transaction, chainID *big.Int, info *accounts.PrivateTxInfo, toSign []byte, passphrase string) ([]byte, error)
+	// SignTypedData signs keccak256(rawData) of the JSON encoded EIP-712 typedData
+	SignTypedData(ctx context.Context, account accounts.Account, typedData []byte, rawData []byte) ([]byte, error)
+	UnlockAndSignTypedData(ctx context.Context, account accounts.Account, typedData []byte, rawData []byte, passphrase string) ([]byte, error)
+}
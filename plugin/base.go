@@ -10,14 +10,19 @@ import (
 	"path"
 	"path/filepath"
 	"reflect"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	iplugin "github.com/ethereum/go-ethereum/internal/plugin"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
 	"github.com/ethereum/go-ethereum/plugin/initializer"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type managedPlugin interface {
@@ -27,6 +32,22 @@ type managedPlugin interface {
 	Info() (PluginInterfaceName, interface{})
 }
 
+// supervisedPlugin is a managedPlugin whose process health can be checked
+// and which can be restarted by the plugin manager supervisor
+type supervisedPlugin interface {
+	managedPlugin
+
+	// ping returns an error if the plugin process is not responsive
+	ping() error
+	// restart stops and starts the plugin, counting it as a restart
+	restart() error
+}
+
+const (
+	pluginStateDown int32 = iota
+	pluginStateUp
+)
+
 // Plugin-meta.json
 type MetaData struct {
 	Version    string   `json:"version"`
@@ -41,10 +62,16 @@ type basePlugin struct {
 	pluginInterface  PluginInterfaceName // plugin provider name
 	pluginDefinition *PluginDefinition
 	client           *plugin.Client
+	clientMux        sync.RWMutex     // guards client which is replaced when the plugin is restarted or reloaded
 	gateways         plugin.PluginSet // gateways to invoke RPC API implementation of interfaces supported by this plugin
 	pluginWorkspace  string           // plugin workspace
 	commands         []string         // plugin executable commands
 	logger           log.Logger
+	state            int32  // pluginStateUp or pluginStateDown, accessed atomically
+	restarts         uint64 // number of restarts, accessed atomically
+
+	upGauge        metrics.Gauge
+	restartCounter metrics.Counter
 }
 
 var basePluginPointerType = reflect.TypeOf(&basePlugin{})
@@ -59,6 +86,8 @@ func newBasePlugin(pm *PluginManager, pluginInterface PluginInterfaceName, plugi
 		logger:           log.New("provider", pluginInterface, "plugin", pluginDefinition.Name, "version", pluginDefinition.Version),
 		pluginDefinition: &pluginDefinition,
 		gateways:         gateways,
+		upGauge:          metrics.GetOrRegisterGauge(fmt.Sprintf("plugin/%s/up", pluginInterface), nil),
+		restartCounter:   metrics.GetOrRegisterCounter(fmt.Sprintf("plugin/%s/restarts", pluginInterface), nil),
 	}, nil
 }
 
@@ -99,7 +128,7 @@ func (bp *basePlugin) load() error {
 		bp.commands = append([]string{executable}, pluginMeta.Parameters...)
 	}
 	command.Dir = unPackDir
-	bp.client = plugin.NewClient(&plugin.ClientConfig{
+	client := plugin.NewClient(&plugin.ClientConfig{
 		HandshakeConfig:  iplugin.DefaultHandshakeConfig,
 		Plugins:          bp.gateways,
 		AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC},
@@ -107,6 +136,9 @@ func (bp *basePlugin) load() error {
 		AutoMTLS:         true,
 		Logger:           &logDelegate{bp.logger.New("from", "plugin")},
 	})
+	bp.clientMux.Lock()
+	bp.client = client
+	bp.clientMux.Unlock()
 
 	bp.pluginWorkspace = unPackDir
 	return nil
@@ -129,18 +161,20 @@ func (bp *basePlugin) Start() (err error) {
 		return
 	}
 	bp.logger.Debug("Starting plugin: Creating client")
-	_, err = bp.client.Client()
+	_, err = bp.getClient().Client()
 	if err != nil {
 		return
 	}
+	bp.setState(pluginStateUp)
 	bp.logger.Debug("Starting plugin: Initializing")
 	err = bp.init()
 	return
 }
 
 func (bp *basePlugin) Stop() error {
-	if bp.client != nil {
-		bp.client.Kill()
+	bp.setState(pluginStateDown)
+	if client := bp.getClient(); client != nil {
+		client.Kill()
 	}
 	if bp.pluginWorkspace == "" {
 		return nil
@@ -185,13 +219,72 @@ func (bp *basePlugin) init() error {
 }
 
 func (bp *basePlugin) dispense(name string) (interface{}, error) {
-	rpcClient, err := bp.client.Client()
+	if !bp.isUp() {
+		return nil, fmt.Errorf("plugin [%s] is not running", bp.pluginInterface)
+	}
+	client := bp.getClient()
+	if client.Exited() {
+		bp.setState(pluginStateDown)
+		return nil, fmt.Errorf("plugin [%s] has exited unexpectedly", bp.pluginInterface)
+	}
+	rpcClient, err := client.Client()
 	if err != nil {
 		return nil, err
 	}
 	return rpcClient.Dispense(name)
 }
 
+// ping checks that the plugin process is alive and responding on its RPC connection
+func (bp *basePlugin) ping() error {
+	if !bp.isUp() {
+		return fmt.Errorf("plugin [%s] is not running", bp.pluginInterface)
+	}
+	client := bp.getClient()
+	if client.Exited() {
+		return fmt.Errorf("plugin [%s] has exited unexpectedly", bp.pluginInterface)
+	}
+	rpcClient, err := client.Client()
+	if err != nil {
+		return err
+	}
+	return rpcClient.Ping()
+}
+
+// callError reports a call which failed because the connection to the plugin
+// process was lost, e.g. as the process crashed during the call, as the plugin
+// having exited
+func (bp *basePlugin) callError(err error) error {
+	if status.Code(err) != codes.Unavailable {
+		return err
+	}
+	return fmt.Errorf("plugin [%s] has exited unexpectedly: %w", bp.pluginInterface, err)
+}
+
+func (bp *basePlugin) getClient() *plugin.Client {
+	bp.clientMux.RLock()
+	defer bp.clientMux.RUnlock()
+	return bp.client
+}
+
+func (bp *basePlugin) restart() error {
+	_ = bp.Stop()
+	if err := bp.Start(); err != nil {
+		return err
+	}
+	atomic.AddUint64(&bp.restarts, 1)
+	bp.restartCounter.Inc(1)
+	return nil
+}
+
+func (bp *basePlugin) setState(state int32) {
+	atomic.StoreInt32(&bp.state, state)
+	bp.upGauge.Update(int64(state))
+}
+
+func (bp *basePlugin) isUp() bool {
+	return atomic.LoadInt32(&bp.state) == pluginStateUp
+}
+
 func (bp *basePlugin) Config() *PluginDefinition {
 	return bp.pluginDefinition
 }
@@ -206,6 +299,12 @@ func (bp *basePlugin) Info() (PluginInterfaceName, interface{}) {
 	info["version"] = bp.pluginDefinition.Version
 	info["config"] = bp.pluginDefinition.Config
 	info["executable"] = bp.commands
+	if bp.isUp() {
+		info["state"] = "up"
+	} else {
+		info["state"] = "down"
+	}
+	info["restarts"] = atomic.LoadUint64(&bp.restarts)
 	return bp.pluginInterface, info
 }
 
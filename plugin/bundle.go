@@ -0,0 +1,212 @@
+package plugin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/log"
+	"golang.org/x/crypto/openpgp"       // nolint:staticcheck
+	"golang.org/x/crypto/openpgp/armor" // nolint:staticcheck
+)
+
+const (
+	// BundleManifestFile describes the content of a plugin bundle directory
+	BundleManifestFile = "plugin-bundle.json"
+	// BundleSignatureFile is the armored PGP detached signature of the manifest
+	BundleSignatureFile = BundleManifestFile + ".asc"
+)
+
+// A plugin distribution in a plugin bundle
+type BundleEntry struct {
+	Provider      PluginInterfaceName `json:"provider"`
+	Name          string              `json:"name"`
+	Version       Version             `json:"version"`
+	DistFile      string              `json:"distFile"`
+	SignatureFile string              `json:"signatureFile"`
+	Checksum      string              `json:"checksum"` // sha256 of the distribution file
+}
+
+// Content of a plugin bundle directory
+type BundleManifest struct {
+	PublicKeyFile string        `json:"publicKeyFile"`
+	Plugins       []BundleEntry `json:"plugins"`
+}
+
+// CreateBundle downloads the plugin distributions, their signatures and the PGP public key
+// from Plugin Central into dir. Each distribution is verified against its signature before
+// being added to the bundle. The manifest is signed with the private key of signer.
+//
+// The bundle directory can be used as a local Plugin Central mirror,
+// see BundleMirrorCentralConfiguration.
+func CreateBundle(centralClient *CentralClient, providers map[PluginInterfaceName]PluginDefinition, dir string, signer *openpgp.Entity) (*BundleManifest, error) {
+	if signer == nil || signer.PrivateKey == nil {
+		return nil, fmt.Errorf("a private key is required to sign the bundle")
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	pubKey, err := centralClient.PublicKey()
+	if err != nil {
+		return nil, fmt.Errorf("unable to download public key: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, DefaultPublicKeyFile), pubKey, 0644); err != nil {
+		return nil, err
+	}
+	manifest := &BundleManifest{
+		PublicKeyFile: DefaultPublicKeyFile,
+		Plugins:       make([]BundleEntry, 0, len(providers)),
+	}
+	names := make([]string, 0, len(providers))
+	for name := range providers {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		provider := PluginInterfaceName(name)
+		definition := providers[provider]
+		entry, err := bundlePlugin(centralClient, &definition, pubKey, dir)
+		if err != nil {
+			return nil, fmt.Errorf("plugin [%s] %v", provider, err)
+		}
+		entry.Provider = provider
+		manifest.Plugins = append(manifest.Plugins, *entry)
+	}
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	sig := new(bytes.Buffer)
+	if err := openpgp.ArmoredDetachSign(sig, signer, bytes.NewReader(data), nil); err != nil {
+		return nil, fmt.Errorf("unable to sign the bundle manifest: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, BundleManifestFile), data, 0644); err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, BundleSignatureFile), sig.Bytes(), 0644); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// WriteBundlePublicKey writes the armored PGP public key of the signer of a
+// bundle to file. The nodes verify the bundle manifest with it, so it must be
+// distributed separately from the bundle.
+func WriteBundlePublicKey(file string, signer *openpgp.Entity) error {
+	pubKey := new(bytes.Buffer)
+	w, err := armor.Encode(pubKey, openpgp.PublicKeyType, nil)
+	if err != nil {
+		return err
+	}
+	if err := signer.Serialize(w); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, pubKey.Bytes(), 0644)
+}
+
+// BundleMirrorCentralConfiguration returns the Plugin Central configuration
+// resolving plugins from the plugin bundle in dir, its manifest being verified
+// with the armored PGP public key in bundlePublicKeyFile.
+func BundleMirrorCentralConfiguration(dir string, bundlePublicKeyFile string) *PluginCentralConfiguration {
+	config := LocalMirrorCentralConfiguration(dir)
+	config.BundlePublicKeyURI = (&url.URL{Scheme: "file", Path: filepath.ToSlash(bundlePublicKeyFile)}).String()
+	return config
+}
+
+// ReadBundle returns the manifest of the plugin bundle in dir once its signature is verified
+// with the given armored PGP public key, and the checksum of each distribution is verified
+func ReadBundle(dir string, publicKey []byte) (*BundleManifest, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, BundleManifestFile))
+	if err != nil {
+		return nil, err
+	}
+	sig, err := ioutil.ReadFile(filepath.Join(dir, BundleSignatureFile))
+	if err != nil {
+		return nil, fmt.Errorf("bundle manifest is not signed: %v", err)
+	}
+	if err := verify(sig, publicKey, string(data)); err != nil {
+		return nil, fmt.Errorf("unable to verify bundle manifest signature: %v", err)
+	}
+	manifest := new(BundleManifest)
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, err
+	}
+	for _, entry := range manifest.Plugins {
+		checksum, err := getSha256Checksum(filepath.Join(dir, filepath.Base(entry.DistFile)))
+		if err != nil {
+			return nil, err
+		}
+		if checksum != entry.Checksum {
+			return nil, fmt.Errorf("plugin [%s] checksum mismatch: %s (manifest) != %s", entry.Provider, entry.Checksum, checksum)
+		}
+	}
+	return manifest, nil
+}
+
+// BundleVerifier verifies the plugins of a local Plugin Central mirror holding a plugin bundle.
+// A plugin must be listed in the verified bundle manifest with the same checksum, and its
+// signature is verified by the LocalVerifier.
+type BundleVerifier struct {
+	manifest *BundleManifest
+	local    *LocalVerifier
+}
+
+func NewBundleVerifier(manifest *BundleManifest, local *LocalVerifier) *BundleVerifier {
+	return &BundleVerifier{manifest: manifest, local: local}
+}
+
+func (v *BundleVerifier) VerifySignature(definition *PluginDefinition, checksum string) error {
+	for _, entry := range v.manifest.Plugins {
+		if entry.Name != definition.Name || entry.Version != definition.Version {
+			continue
+		}
+		if entry.Checksum != checksum {
+			return fmt.Errorf("checksum %s differs from the bundle manifest checksum %s", checksum, entry.Checksum)
+		}
+		return v.local.VerifySignature(definition, checksum)
+	}
+	return fmt.Errorf("plugin %s is not in the bundle", definition.FullName())
+}
+
+func bundlePlugin(centralClient *CentralClient, definition *PluginDefinition, pubKey []byte, dir string) (*BundleEntry, error) {
+	log.Info("Bundling plugin", "name", definition.Name, "version", definition.Version)
+	distFile := filepath.Join(dir, definition.DistFileName())
+	// download to a temporary file so a failed verification does not leave the distribution in the bundle
+	tmpFile := distFile + ".download"
+	defer os.Remove(tmpFile)
+	if err := centralClient.PluginDistribution(definition, tmpFile); err != nil {
+		return nil, fmt.Errorf("unable to download distribution: %v", err)
+	}
+	checksum, err := getSha256Checksum(tmpFile)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := centralClient.PluginSignature(definition)
+	if err != nil {
+		return nil, fmt.Errorf("unable to download signature: %v", err)
+	}
+	if err := verify(sig, pubKey, checksum); err != nil {
+		return nil, fmt.Errorf("unable to verify plugin signature: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, definition.SignatureFileName()), sig, 0644); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmpFile, distFile); err != nil {
+		return nil, err
+	}
+	return &BundleEntry{
+		Name:          definition.Name,
+		Version:       definition.Version,
+		DistFile:      definition.DistFileName(),
+		SignatureFile: definition.SignatureFileName(),
+		Checksum:      checksum,
+	}, nil
+}
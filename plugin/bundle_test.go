@@ -0,0 +1,257 @@
+package plugin
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/openpgp"       // nolint:staticcheck
+	"golang.org/x/crypto/openpgp/armor" // nolint:staticcheck
+)
+
+var arbitraryBundleDefinition = PluginDefinition{Name: "arbitrary-plugin", Version: "1.0.0"}
+
+// serves the distribution of arbitraryBundleDefinition and the signature of signedData
+// using a newly generated PGP key, whose armored public key is returned
+func newTestCentral(t *testing.T, distData []byte, signedData []byte) (*httptest.Server, *PluginCentralConfiguration, []byte) {
+	entity, pubKey := newTestPGPKey(t)
+	checksum := sha256.Sum256(signedData)
+	sig := new(bytes.Buffer)
+	require.NoError(t, openpgp.ArmoredDetachSign(sig, entity, strings.NewReader(hex.EncodeToString(checksum[:])), nil))
+
+	mux := http.NewServeMux()
+	for pattern, data := range map[string][]byte{
+		"/" + DefaultPublicKeyFile:                              pubKey,
+		"/dist/" + arbitraryBundleDefinition.DistFileName():     distData,
+		"/sig/" + arbitraryBundleDefinition.SignatureFileName(): sig.Bytes(),
+	} {
+		mux.Handle(pattern, newMux(pattern, data))
+	}
+	server := httptest.NewServer(mux)
+	return server, &PluginCentralConfiguration{
+		BaseURL:                server.URL,
+		PublicKeyURI:           DefaultPublicKeyFile,
+		PluginDistPathTemplate: "dist/{{.Name}}-{{.Version}}-{{.OS}}-{{.Arch}}.zip",
+		PluginSigPathTemplate:  "sig/{{.Name}}-{{.Version}}-{{.OS}}-{{.Arch}}.zip.sha256sum.asc",
+	}, pubKey
+}
+
+func newTestPGPKey(t *testing.T) (*openpgp.Entity, []byte) {
+	entity, err := openpgp.NewEntity("arbitrary", "", "arbitrary@example.com", nil)
+	require.NoError(t, err)
+	pubKey := new(bytes.Buffer)
+	w, err := armor.Encode(pubKey, openpgp.PublicKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, entity.Serialize(w))
+	require.NoError(t, w.Close())
+	return entity, pubKey.Bytes()
+}
+
+// creates a bundle of arbitraryBundleDefinition in bundleDir and returns the
+// mirror configuration with the bundle public key written outside of the bundle
+func newTestBundle(t *testing.T, tmpDir string, bundleDir string, distData []byte) (*PluginCentralConfiguration, []byte) {
+	server, config, centralPubKey := newTestCentral(t, distData, distData)
+	defer server.Close()
+	signer, bundlePubKey := newTestPGPKey(t)
+	_, err := CreateBundle(NewPluginCentralClient(config), map[PluginInterfaceName]PluginDefinition{
+		HelloWorldPluginInterfaceName: arbitraryBundleDefinition,
+	}, bundleDir, signer)
+	require.NoError(t, err)
+	bundlePubKeyFile := filepath.Join(tmpDir, "bundle.pub")
+	require.NoError(t, ioutil.WriteFile(bundlePubKeyFile, bundlePubKey, 0644))
+	mirrorConfig := &PluginCentralConfiguration{BaseURL: "file://" + bundleDir, BundlePublicKeyURI: "file://" + bundlePubKeyFile}
+	mirrorConfig.SetDefaults()
+	return mirrorConfig, centralPubKey
+}
+
+func newTestMirrorPluginManager(t *testing.T, pluginBaseDir string, mirrorConfig *PluginCentralConfiguration) *PluginManager {
+	require.NoError(t, os.MkdirAll(pluginBaseDir, 0755))
+	pm := &PluginManager{pluginBaseDir: pluginBaseDir, centralClient: NewPluginCentralClient(mirrorConfig)}
+	pm.downloader = NewDownloader(pm)
+	return pm
+}
+
+func TestCreateBundle_whenTypical(t *testing.T) {
+	tmpDir := t.TempDir()
+	bundleDir, pluginBaseDir := filepath.Join(tmpDir, "bundle"), filepath.Join(tmpDir, "plugins")
+	distData := []byte("arbitrary distribution")
+	server, config, centralPubKey := newTestCentral(t, distData, distData)
+	defer server.Close()
+	signer, bundlePubKey := newTestPGPKey(t)
+
+	manifest, err := CreateBundle(NewPluginCentralClient(config), map[PluginInterfaceName]PluginDefinition{
+		HelloWorldPluginInterfaceName: arbitraryBundleDefinition,
+	}, bundleDir, signer)
+
+	require.NoError(t, err)
+	checksum := sha256.Sum256(distData)
+	assert.Equal(t, &BundleManifest{
+		PublicKeyFile: DefaultPublicKeyFile,
+		Plugins: []BundleEntry{{
+			Provider:      HelloWorldPluginInterfaceName,
+			Name:          arbitraryBundleDefinition.Name,
+			Version:       arbitraryBundleDefinition.Version,
+			DistFile:      arbitraryBundleDefinition.DistFileName(),
+			SignatureFile: arbitraryBundleDefinition.SignatureFileName(),
+			Checksum:      hex.EncodeToString(checksum[:]),
+		}},
+	}, manifest)
+	assert.FileExists(t, filepath.Join(bundleDir, BundleManifestFile))
+	assert.FileExists(t, filepath.Join(bundleDir, BundleSignatureFile))
+	actualManifest, err := ReadBundle(bundleDir, bundlePubKey)
+	require.NoError(t, err)
+	assert.Equal(t, manifest, actualManifest)
+
+	// the bundle is used as a local Plugin Central mirror without network access,
+	// with the settings printed by the bundle command
+	server.Close()
+	bundlePubKeyFile := filepath.Join(tmpDir, "bundle.pub")
+	require.NoError(t, WriteBundlePublicKey(bundlePubKeyFile, signer))
+	printed, err := json.Marshal(BundleMirrorCentralConfiguration(bundleDir, bundlePubKeyFile))
+	require.NoError(t, err)
+	mirrorConfig := new(PluginCentralConfiguration)
+	require.NoError(t, json.Unmarshal(printed, mirrorConfig))
+	mirrorConfig.SetDefaults()
+	assert.Equal(t, "file://"+bundlePubKeyFile, mirrorConfig.BundlePublicKeyURI)
+	pm := newTestMirrorPluginManager(t, pluginBaseDir, mirrorConfig)
+	// the Plugin Central public key is configured on the node
+	require.NoError(t, ioutil.WriteFile(filepath.Join(pluginBaseDir, DefaultPublicKeyFile), centralPubKey, 0644))
+	verifier, err := NewVerifier(pm, false, "")
+	require.NoError(t, err)
+	assert.IsType(t, &BundleVerifier{}, verifier)
+
+	distFile, err := pm.downloader.Download(&arbitraryBundleDefinition)
+	require.NoError(t, err)
+	actual, err := ioutil.ReadFile(distFile)
+	require.NoError(t, err)
+	assert.Equal(t, distData, actual)
+	assert.NoError(t, verifier.VerifySignature(&arbitraryBundleDefinition, hex.EncodeToString(checksum[:])))
+	assert.Error(t, verifier.VerifySignature(&arbitraryBundleDefinition, "arbitrary checksum"))
+	assert.Error(t, verifier.VerifySignature(&PluginDefinition{Name: "other-plugin", Version: "1.0.0"}, hex.EncodeToString(checksum[:])))
+}
+
+func TestCreateBundle_whenNoSigner(t *testing.T) {
+	bundleDir := t.TempDir()
+	distData := []byte("arbitrary distribution")
+	server, config, _ := newTestCentral(t, distData, distData)
+	defer server.Close()
+	_, pubKey := newTestPGPKey(t)
+	publicOnly, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(pubKey))
+	require.NoError(t, err)
+
+	for _, signer := range []*openpgp.Entity{nil, publicOnly[0]} {
+		_, err := CreateBundle(NewPluginCentralClient(config), map[PluginInterfaceName]PluginDefinition{
+			HelloWorldPluginInterfaceName: arbitraryBundleDefinition,
+		}, bundleDir, signer)
+
+		assert.EqualError(t, err, "a private key is required to sign the bundle")
+	}
+	assert.NoFileExists(t, filepath.Join(bundleDir, BundleManifestFile))
+}
+
+func TestReadBundle_whenManifestTampered(t *testing.T) {
+	tmpDir := t.TempDir()
+	bundleDir := filepath.Join(tmpDir, "bundle")
+	_, _ = newTestBundle(t, tmpDir, bundleDir, []byte("arbitrary distribution"))
+	bundlePubKey, err := ioutil.ReadFile(filepath.Join(tmpDir, "bundle.pub"))
+	require.NoError(t, err)
+	manifestFile := filepath.Join(bundleDir, BundleManifestFile)
+	data, err := ioutil.ReadFile(manifestFile)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(manifestFile, bytes.Replace(data, []byte(`"checksum": "`), []byte(`"checksum": "00`), 1), 0644))
+
+	_, err = ReadBundle(bundleDir, bundlePubKey)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to verify bundle manifest signature")
+}
+
+func TestReadBundle_whenSignedByOtherKey(t *testing.T) {
+	tmpDir := t.TempDir()
+	bundleDir := filepath.Join(tmpDir, "bundle")
+	_, _ = newTestBundle(t, tmpDir, bundleDir, []byte("arbitrary distribution"))
+	_, otherPubKey := newTestPGPKey(t)
+
+	_, err := ReadBundle(bundleDir, otherPubKey)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to verify bundle manifest signature")
+}
+
+func TestReadBundle_whenDistributionTampered(t *testing.T) {
+	tmpDir := t.TempDir()
+	bundleDir := filepath.Join(tmpDir, "bundle")
+	_, _ = newTestBundle(t, tmpDir, bundleDir, []byte("arbitrary distribution"))
+	bundlePubKey, err := ioutil.ReadFile(filepath.Join(tmpDir, "bundle.pub"))
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(bundleDir, arbitraryBundleDefinition.DistFileName()), []byte("tampered distribution"), 0644))
+
+	_, err = ReadBundle(bundleDir, bundlePubKey)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "checksum mismatch")
+}
+
+func TestNewVerifier_whenBundlePublicKeyNotConfigured(t *testing.T) {
+	tmpDir := t.TempDir()
+	bundleDir, pluginBaseDir := filepath.Join(tmpDir, "bundle"), filepath.Join(tmpDir, "plugins")
+	mirrorConfig, centralPubKey := newTestBundle(t, tmpDir, bundleDir, []byte("arbitrary distribution"))
+	mirrorConfig.BundlePublicKeyURI = ""
+	pm := newTestMirrorPluginManager(t, pluginBaseDir, mirrorConfig)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(pluginBaseDir, DefaultPublicKeyFile), centralPubKey, 0644))
+
+	_, err := NewVerifier(pm, false, "")
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "bundlePublicKeyURI is required")
+}
+
+func TestNewVerifier_whenMirrorPublicKeyReplaced(t *testing.T) {
+	tmpDir := t.TempDir()
+	bundleDir, pluginBaseDir := filepath.Join(tmpDir, "bundle"), filepath.Join(tmpDir, "plugins")
+	distData := []byte("arbitrary distribution")
+	mirrorConfig, centralPubKey := newTestBundle(t, tmpDir, bundleDir, distData)
+	checksum := sha256.Sum256(distData)
+	pm := newTestMirrorPluginManager(t, pluginBaseDir, mirrorConfig)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(pluginBaseDir, DefaultPublicKeyFile), centralPubKey, 0644))
+	// the public key in the mirror is not trusted
+	_, otherPubKey := newTestPGPKey(t)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(bundleDir, DefaultPublicKeyFile), otherPubKey, 0644))
+
+	verifier, err := NewVerifier(pm, false, "")
+	require.NoError(t, err)
+	assert.NoError(t, verifier.VerifySignature(&arbitraryBundleDefinition, hex.EncodeToString(checksum[:])))
+
+	// signatures are verified with the configured public key
+	otherPubKeyFile := filepath.Join(tmpDir, "other.pub")
+	require.NoError(t, ioutil.WriteFile(otherPubKeyFile, otherPubKey, 0644))
+	verifier, err = NewVerifier(pm, false, "file://"+otherPubKeyFile)
+	require.NoError(t, err)
+	assert.Error(t, verifier.VerifySignature(&arbitraryBundleDefinition, hex.EncodeToString(checksum[:])))
+}
+
+func TestCreateBundle_whenSignatureInvalid(t *testing.T) {
+	bundleDir := t.TempDir()
+	server, config, _ := newTestCentral(t, []byte("tampered distribution"), []byte("arbitrary distribution"))
+	defer server.Close()
+	signer, _ := newTestPGPKey(t)
+
+	_, err := CreateBundle(NewPluginCentralClient(config), map[PluginInterfaceName]PluginDefinition{
+		HelloWorldPluginInterfaceName: arbitraryBundleDefinition,
+	}, bundleDir, signer)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to verify plugin signature")
+	assert.NoFileExists(t, filepath.Join(bundleDir, arbitraryBundleDefinition.DistFileName()))
+	assert.NoFileExists(t, filepath.Join(bundleDir, BundleManifestFile))
+}
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"text/template"
@@ -103,13 +104,37 @@ func (cc *CentralClient) PluginDistribution(definition *PluginDefinition, outFil
 	return cc.download(target, outFile)
 }
 
-// perform HTTP GET
+// LocalMirrorDir returns the directory of the local Plugin Central mirror
+// if the base URL uses the 'file' scheme
+func (cc *CentralClient) LocalMirrorDir() (string, bool) {
+	if cc == nil || cc.config == nil {
+		return "", false
+	}
+	return localMirrorDir(cc.config.BaseURL)
+}
+
+func localMirrorDir(baseURL string) (string, bool) {
+	u, err := url.Parse(baseURL)
+	if err != nil || u.Scheme != "file" {
+		return "", false
+	}
+	return filepath.Join(u.Host, u.Path), true
+}
+
+// perform HTTP GET or read from the local mirror
 //
 // caller needs to close the reader
 func (cc *CentralClient) get(target string) (io.ReadCloser, error) {
 	if err := isValidTargetURL(cc.config.BaseURL, target); err != nil {
 		return nil, err
 	}
+	if _, ok := cc.LocalMirrorDir(); ok {
+		u, err := url.Parse(target)
+		if err != nil {
+			return nil, err
+		}
+		return os.Open(filepath.Join(u.Host, u.Path))
+	}
 	res, err := cc.httpClient.Get(target)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,114 @@
+package eventsink
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/plugin/eventsink/proto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+type PluginGateway struct {
+	client proto.PluginEventSinkClient
+}
+
+var _ EventSink = &PluginGateway{}
+
+func (p *PluginGateway) DeliverBlock(ctx context.Context, event *BlockEvent) error {
+	block, err := toProtoBlock(event.Block)
+	if err != nil {
+		return err
+	}
+	req := &proto.DeliverBlock_Request{
+		Block:    block,
+		Receipts: toProtoReceipts(event.Receipts),
+	}
+	psis := make([]string, 0, len(event.PrivateReceipts))
+	for psi := range event.PrivateReceipts {
+		psis = append(psis, psi.String())
+	}
+	sort.Strings(psis)
+	for _, psi := range psis {
+		req.PrivateReceipts = append(req.PrivateReceipts, &proto.PrivateReceipts{
+			Psi:      psi,
+			Receipts: toProtoReceipts(event.PrivateReceipts[types.PrivateStateIdentifier(psi)]),
+		})
+	}
+	if _, err := p.client.DeliverBlock(ctx, req); err != nil {
+		return fmt.Errorf("deliver block %d: %w", event.Block.NumberU64(), err)
+	}
+	return nil
+}
+
+func (p *PluginGateway) DeliverReorg(ctx context.Context, event *ReorgEvent) error {
+	removed := make([][]byte, len(event.RemovedBlockHashes))
+	for i, hash := range event.RemovedBlockHashes {
+		removed[i] = hash.Bytes()
+	}
+	if _, err := p.client.DeliverReorg(ctx, &proto.DeliverReorg_Request{
+		CommonAncestorNumber: event.CommonAncestorNumber,
+		CommonAncestorHash:   event.CommonAncestorHash.Bytes(),
+		RemovedBlockHashes:   removed,
+	}); err != nil {
+		return fmt.Errorf("deliver reorg to block %d: %w", event.CommonAncestorNumber, err)
+	}
+	return nil
+}
+
+func toProtoBlock(block *types.Block) (*proto.Block, error) {
+	header, err := rlp.EncodeToBytes(block.Header())
+	if err != nil {
+		return nil, err
+	}
+	txs := make([][]byte, len(block.Transactions()))
+	for i, tx := range block.Transactions() {
+		if txs[i], err = tx.MarshalBinary(); err != nil {
+			return nil, err
+		}
+	}
+	return &proto.Block{
+		Number:       block.NumberU64(),
+		Hash:         block.Hash().Bytes(),
+		ParentHash:   block.ParentHash().Bytes(),
+		Timestamp:    block.Time(),
+		Header:       header,
+		Transactions: txs,
+	}, nil
+}
+
+func toProtoReceipts(receipts types.Receipts) []*proto.Receipt {
+	protoReceipts := make([]*proto.Receipt, len(receipts))
+	for i, r := range receipts {
+		protoReceipt := &proto.Receipt{
+			TxHash:            r.TxHash.Bytes(),
+			Status:            r.Status,
+			CumulativeGasUsed: r.CumulativeGasUsed,
+			GasUsed:           r.GasUsed,
+			Logs:              make([]*proto.Log, len(r.Logs)),
+		}
+		if r.ContractAddress != (common.Address{}) {
+			protoReceipt.ContractAddress = r.ContractAddress.Bytes()
+		}
+		for j, l := range r.Logs {
+			topics := make([][]byte, len(l.Topics))
+			for k, topic := range l.Topics {
+				topics[k] = topic.Bytes()
+			}
+			protoReceipt.Logs[j] = &proto.Log{
+				Address:     l.Address.Bytes(),
+				Topics:      topics,
+				Data:        l.Data,
+				BlockNumber: l.BlockNumber,
+				TxHash:      l.TxHash.Bytes(),
+				TxIndex:     uint32(l.TxIndex),
+				BlockHash:   l.BlockHash.Bytes(),
+				Index:       uint32(l.Index),
+			}
+		}
+		protoReceipts[i] = protoReceipt
+	}
+	return protoReceipts
+}
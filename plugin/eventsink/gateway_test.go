@@ -0,0 +1,97 @@
+package eventsink
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/plugin/eventsink/proto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPluginGateway_DeliverBlock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	tx := types.NewTransaction(0, common.Address{0x2}, big.NewInt(1), 21000, big.NewInt(1), nil)
+	header := &types.Header{Number: big.NewInt(7), ParentHash: common.Hash{0x1}, Time: 99}
+	block := types.NewBlockWithHeader(header).WithBody([]*types.Transaction{tx}, nil)
+	log := &types.Log{Address: common.Address{0x3}, Topics: []common.Hash{{0x4}}, Data: []byte("data"), BlockNumber: 7, TxHash: tx.Hash(), BlockHash: block.Hash(), Index: 1}
+	publicReceipt := &types.Receipt{TxHash: tx.Hash(), Status: types.ReceiptStatusSuccessful, CumulativeGasUsed: 21000, GasUsed: 21000, Logs: []*types.Log{log}}
+	privateReceipt := &types.Receipt{TxHash: common.Hash{0x5}, ContractAddress: common.Address{0x6}}
+	encodedHeader, _ := rlp.EncodeToBytes(header)
+	encodedTx, _ := tx.MarshalBinary()
+	protoPrivateReceipts := []*proto.Receipt{{TxHash: common.Hash{0x5}.Bytes(), ContractAddress: common.Address{0x6}.Bytes(), Logs: []*proto.Log{}}}
+
+	mockClient := proto.NewMockPluginEventSinkClient(ctrl)
+	mockClient.
+		EXPECT().
+		DeliverBlock(gomock.Any(), gomock.Eq(&proto.DeliverBlock_Request{
+			Block: &proto.Block{
+				Number:       7,
+				Hash:         block.Hash().Bytes(),
+				ParentHash:   common.Hash{0x1}.Bytes(),
+				Timestamp:    99,
+				Header:       encodedHeader,
+				Transactions: [][]byte{encodedTx},
+			},
+			Receipts: []*proto.Receipt{{
+				TxHash:            tx.Hash().Bytes(),
+				Status:            types.ReceiptStatusSuccessful,
+				CumulativeGasUsed: 21000,
+				GasUsed:           21000,
+				Logs: []*proto.Log{{
+					Address:     common.Address{0x3}.Bytes(),
+					Topics:      [][]byte{common.Hash{0x4}.Bytes()},
+					Data:        []byte("data"),
+					BlockNumber: 7,
+					TxHash:      tx.Hash().Bytes(),
+					BlockHash:   block.Hash().Bytes(),
+					Index:       1,
+				}},
+			}},
+			PrivateReceipts: []*proto.PrivateReceipts{
+				{Psi: "psi1", Receipts: protoPrivateReceipts},
+				{Psi: "psi2", Receipts: protoPrivateReceipts},
+			},
+		})).
+		Return(&proto.DeliverBlock_Response{}, nil)
+	testObject := &PluginGateway{client: mockClient}
+
+	err := testObject.DeliverBlock(context.Background(), &BlockEvent{
+		Block:    block,
+		Receipts: types.Receipts{publicReceipt},
+		PrivateReceipts: map[types.PrivateStateIdentifier]types.Receipts{
+			"psi2": {privateReceipt},
+			"psi1": {privateReceipt},
+		},
+	})
+
+	assert.NoError(t, err)
+}
+
+func TestPluginGateway_DeliverReorg(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := proto.NewMockPluginEventSinkClient(ctrl)
+	mockClient.
+		EXPECT().
+		DeliverReorg(gomock.Any(), gomock.Eq(&proto.DeliverReorg_Request{
+			CommonAncestorNumber: 3,
+			CommonAncestorHash:   common.Hash{0x3}.Bytes(),
+			RemovedBlockHashes:   [][]byte{common.Hash{0x5}.Bytes(), common.Hash{0x4}.Bytes()},
+		})).
+		Return(nil, assert.AnError)
+	testObject := &PluginGateway{client: mockClient}
+
+	err := testObject.DeliverReorg(context.Background(), &ReorgEvent{
+		CommonAncestorNumber: 3,
+		CommonAncestorHash:   common.Hash{0x3},
+		RemovedBlockHashes:   []common.Hash{{0x5}, {0x4}},
+	})
+
+	assert.ErrorIs(t, err, assert.AnError)
+}
@@ -0,0 +1,53 @@
+package eventsink
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	iplugin "github.com/ethereum/go-ethereum/internal/plugin"
+)
+
+// BlockEvent is a new canonical block with its receipts
+type BlockEvent struct {
+	Block    *types.Block
+	Receipts types.Receipts
+	// receipts of private transactions for each private state managed by the node
+	PrivateReceipts map[types.PrivateStateIdentifier]types.Receipts
+}
+
+// ReorgEvent notifies the blocks removed from the canonical chain
+type ReorgEvent struct {
+	CommonAncestorNumber uint64
+	CommonAncestorHash   common.Hash
+	RemovedBlockHashes   []common.Hash // newest first
+}
+
+// EventSink receives chain events pushed by the node
+type EventSink interface {
+	DeliverBlock(ctx context.Context, event *BlockEvent) error
+	DeliverReorg(ctx context.Context, event *ReorgEvent) error
+}
+
+type EventSinkDeferFunc func() (EventSink, error)
+
+type ReloadableEventSink struct {
+	DeferFunc     EventSinkDeferFunc
+	CallErrorFunc iplugin.CallErrorFunc // optional, translates the errors of calls to the plugin
+}
+
+func (d *ReloadableEventSink) DeliverBlock(ctx context.Context, event *BlockEvent) error {
+	p, err := d.DeferFunc()
+	if err != nil {
+		return err
+	}
+	return d.CallErrorFunc.Apply(p.DeliverBlock(ctx, event))
+}
+
+func (d *ReloadableEventSink) DeliverReorg(ctx context.Context, event *ReorgEvent) error {
+	p, err := d.DeferFunc()
+	if err != nil {
+		return err
+	}
+	return d.CallErrorFunc.Apply(p.DeliverReorg(ctx, event))
+}
@@ -12,8 +12,15 @@
 // generate stubs
 //go:generate protoc -I ../../vendor/github.com/jpmorganchase/quorum-plugin-definitions -I ../../vendor --go_out=plugins=grpc:proto_common init.proto
 
+//go:generate protoc -I ../txpolicy/proto --go_out=plugins=grpc,paths=source_relative:../txpolicy/proto txpolicy.proto
+//go:generate protoc -I ../eventsink/proto --go_out=plugins=grpc,paths=source_relative:../eventsink/proto eventsink.proto
+//go:generate protoc -I ../account/proto --go_out=plugins=grpc,paths=source_relative:../account/proto account_signing.proto
+
 // generate mocks for unit testing
 //go:generate mockgen -package proto_common -destination proto_common/mock_init.go -source proto_common/init.pb.go
+//go:generate mockgen -package proto -destination ../txpolicy/proto/mock_txpolicy.go -source ../txpolicy/proto/txpolicy.pb.go
+//go:generate mockgen -package proto -destination ../eventsink/proto/mock_eventsink.go -source ../eventsink/proto/eventsink.pb.go
+//go:generate mockgen -package proto -destination ../account/proto/mock_account_signing.go -source ../account/proto/account_signing.pb.go
 
 // fix fmt
 //go:generate goimports -w ./
@@ -1,6 +1,10 @@
 package helloworld
 
-import "context"
+import (
+	"context"
+
+	iplugin "github.com/ethereum/go-ethereum/internal/plugin"
+)
 
 type PluginHelloWorld interface {
 	Greeting(ctx context.Context, msg string) (string, error)
@@ -9,7 +13,8 @@ type PluginHelloWorld interface {
 type PluginHelloWorldDeferFunc func() (PluginHelloWorld, error)
 
 type ReloadablePluginHelloWorld struct {
-	DeferFunc PluginHelloWorldDeferFunc
+	DeferFunc     PluginHelloWorldDeferFunc
+	CallErrorFunc iplugin.CallErrorFunc // optional, translates the errors of calls to the plugin
 }
 
 func (d *ReloadablePluginHelloWorld) Greeting(ctx context.Context, msg string) (string, error) {
@@ -17,5 +22,6 @@ func (d *ReloadablePluginHelloWorld) Greeting(ctx context.Context, msg string) (
 	if err != nil {
 		return "", err
 	}
-	return p.Greeting(ctx, msg)
+	greeting, err := p.Greeting(ctx, msg)
+	return greeting, d.CallErrorFunc.Apply(err)
 }
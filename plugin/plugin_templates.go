@@ -5,9 +5,11 @@ import (
 
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/plugin/account"
+	"github.com/ethereum/go-ethereum/plugin/eventsink"
 	"github.com/ethereum/go-ethereum/plugin/helloworld"
 	"github.com/ethereum/go-ethereum/plugin/qlight"
 	"github.com/ethereum/go-ethereum/plugin/security"
+	"github.com/ethereum/go-ethereum/plugin/txpolicy"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -26,6 +28,7 @@ func (p *HelloWorldPluginTemplate) Get() (helloworld.PluginHelloWorld, error) {
 			}
 			return raw.(helloworld.PluginHelloWorld), nil
 		},
+		CallErrorFunc: p.callError,
 	}, nil
 }
 
@@ -77,7 +80,7 @@ func (sp *SecurityPluginTemplate) AuthenticationManager() (security.Authenticati
 			return security.NewDisabledAuthenticationManager(), nil
 		}
 	}
-	return security.NewDeferredAuthenticationManager(deferFunc), nil
+	return security.NewDeferredAuthenticationManager(deferFunc, sp.callError), nil
 }
 
 type ReloadableAccountServiceFactory struct {
@@ -93,6 +96,7 @@ func (f *ReloadableAccountServiceFactory) Create() (account.Service, error) {
 			}
 			return raw.(account.Service), nil
 		},
+		CallErrorFunc: f.callError,
 	}
 
 	return am, nil
@@ -111,6 +115,7 @@ func (p *QLightTokenManagerPluginTemplate) Get() (qlight.PluginTokenManager, err
 			}
 			return raw.(qlight.PluginTokenManager), nil
 		},
+		CallErrorFunc: p.callError,
 	}, nil
 }
 
@@ -128,3 +133,39 @@ type QLightTokenManagerPluginTemplateInterface interface {
 //go:generate mockgen -source=plugin_templates.go -destination plugin_templates_mockery.go -package plugin
 var _ QLightTokenManagerPluginTemplateInterface = &QLightTokenManagerPluginTemplate{}
 var _ QLightTokenManagerPluginTemplateInterface = &MockQLightTokenManagerPluginTemplateInterface{}
+
+// a template that returns the transaction policy plugin instance
+type TxPolicyPluginTemplate struct {
+	*basePlugin
+}
+
+func (p *TxPolicyPluginTemplate) Get() (txpolicy.TransactionPolicy, error) {
+	return &txpolicy.ReloadableTransactionPolicy{
+		DeferFunc: func() (txpolicy.TransactionPolicy, error) {
+			raw, err := p.dispense(txpolicy.ConnectorName)
+			if err != nil {
+				return nil, err
+			}
+			return raw.(txpolicy.TransactionPolicy), nil
+		},
+		CallErrorFunc: p.callError,
+	}, nil
+}
+
+// a template that returns the event sink plugin instance
+type EventSinkPluginTemplate struct {
+	*basePlugin
+}
+
+func (p *EventSinkPluginTemplate) Get() (eventsink.EventSink, error) {
+	return &eventsink.ReloadableEventSink{
+		DeferFunc: func() (eventsink.EventSink, error) {
+			raw, err := p.dispense(eventsink.ConnectorName)
+			if err != nil {
+				return nil, err
+			}
+			return raw.(eventsink.EventSink), nil
+		},
+		CallErrorFunc: p.callError,
+	}, nil
+}
@@ -1,6 +1,10 @@
 package qlight
 
-import "context"
+import (
+	"context"
+
+	iplugin "github.com/ethereum/go-ethereum/internal/plugin"
+)
 
 type PluginTokenManager interface {
 	TokenRefresh(ctx context.Context, currentToken, psi string) (string, error)
@@ -13,7 +17,8 @@ var _ PluginTokenManager = &MockPluginTokenManager{}
 type PluginTokenManagerDeferFunc func() (PluginTokenManager, error)
 
 type ReloadablePluginTokenManager struct {
-	DeferFunc PluginTokenManagerDeferFunc
+	DeferFunc     PluginTokenManagerDeferFunc
+	CallErrorFunc iplugin.CallErrorFunc // optional, translates the errors of calls to the plugin
 }
 
 func (d *ReloadablePluginTokenManager) TokenRefresh(ctx context.Context, currentToken, psi string) (string, error) {
@@ -21,7 +26,8 @@ func (d *ReloadablePluginTokenManager) TokenRefresh(ctx context.Context, current
 	if err != nil {
 		return "", err
 	}
-	return p.TokenRefresh(ctx, currentToken, psi)
+	token, err := p.TokenRefresh(ctx, currentToken, psi)
+	return token, d.CallErrorFunc.Apply(err)
 }
 
 func (d *ReloadablePluginTokenManager) PluginTokenManager(ctx context.Context) (int32, error) {
@@ -29,5 +35,6 @@ func (d *ReloadablePluginTokenManager) PluginTokenManager(ctx context.Context) (
 	if err != nil {
 		return 0, err
 	}
-	return p.PluginTokenManager(ctx)
+	refreshAnticipationInMillis, err := p.PluginTokenManager(ctx)
+	return refreshAnticipationInMillis, d.CallErrorFunc.Apply(err)
 }
@@ -0,0 +1,451 @@
+package security
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/jpmorganchase/quorum-security-plugin-sdk-go/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// scope prefix granting access to RPC APIs, e.g.: rpc://eth_* or rpc://admin_nodeInfo
+	rpcScopePrefix = "rpc://"
+	// separator between the RPC service and method in a scope
+	rpcScopeSeparator = "_"
+
+	defaultJWKSRefreshInterval = time.Hour
+	defaultScopeClaim          = "scope"
+	// minimum time between two attempts to refresh the key set when an unknown key id is seen,
+	// so that tokens with random key ids can't be used to hammer the issuer
+	minJWKSRefreshInterval = 30 * time.Second
+	jwksRequestTimeout     = 10 * time.Second
+)
+
+// validSigningMethods are the only algorithms accepted for token signatures.
+// Symmetric algorithms and "none" are deliberately excluded as keys come from a JWKS.
+var validSigningMethods = []string{
+	"RS256", "RS384", "RS512",
+	"PS256", "PS384", "PS512",
+	"ES256", "ES384", "ES512",
+}
+
+// JWTAuthConfig configures the built-in authentication manager which
+// validates JWT access tokens without requiring the security plugin.
+type JWTAuthConfig struct {
+	// JWKSFile is the local JSON Web Key Set used to verify token signatures
+	JWKSFile string `toml:",omitempty"`
+	// JWKSURL is an optional issuer endpoint serving the JSON Web Key Set.
+	// When set, the key set is refreshed from it periodically and when a token
+	// is signed by an unknown key. Its keys are used together with the keys in JWKSFile.
+	// It must use https unless AllowInsecureJWKSURL is set
+	JWKSURL string `toml:",omitempty"`
+	// AllowInsecureJWKSURL allows JWKSURL to use plain http, e.g.: for a local issuer
+	AllowInsecureJWKSURL bool `toml:",omitempty"`
+	// RefreshInterval is how often the key set is refreshed from JWKSURL
+	RefreshInterval time.Duration `toml:",omitempty"`
+	// Issuer, if set, must match the "iss" claim
+	Issuer string `toml:",omitempty"`
+	// Audience, if set, must be contained in the "aud" claim
+	Audience string `toml:",omitempty"`
+	// ScopeClaim is the claim carrying the granted scopes, either as a space separated
+	// string or an array of strings. Defaults to "scope"
+	ScopeClaim string `toml:",omitempty"`
+}
+
+// SetDefaults populates unset optional fields
+func (c *JWTAuthConfig) SetDefaults() {
+	if c.RefreshInterval == 0 {
+		c.RefreshInterval = defaultJWKSRefreshInterval
+	}
+	if c.ScopeClaim == "" {
+		c.ScopeClaim = defaultScopeClaim
+	}
+}
+
+// Validate checks the configuration is usable
+func (c *JWTAuthConfig) Validate() error {
+	if c.JWKSFile == "" && c.JWKSURL == "" {
+		return errors.New("jwt auth: either JWKSFile or JWKSURL must be configured")
+	}
+	if c.RefreshInterval < 0 {
+		return errors.New("jwt auth: RefreshInterval must not be negative")
+	}
+	if c.JWKSURL != "" {
+		u, err := url.Parse(c.JWKSURL)
+		if err != nil {
+			return fmt.Errorf("jwt auth: invalid JWKSURL: %w", err)
+		}
+		switch {
+		case u.Scheme == "https":
+		case u.Scheme == "http" && c.AllowInsecureJWKSURL:
+		case u.Scheme == "http":
+			return errors.New("jwt auth: JWKSURL must use https, set AllowInsecureJWKSURL to allow http")
+		default:
+			return fmt.Errorf("jwt auth: unsupported JWKSURL scheme %q", u.Scheme)
+		}
+	}
+	return nil
+}
+
+// JWTAuthenticationManager is an AuthenticationManager validating JWT access tokens
+// against a JSON Web Key Set. Granted scopes in the token are mapped to authorities:
+//   - rpc://<service>_<method> grants access to RPC APIs, '*' can be used as wildcard
+//   - any other scope, e.g.: psi://PS1?self.eoa=0x0, is kept as raw authority and is
+//     used for multitenancy authorization
+type JWTAuthenticationManager struct {
+	config *JWTAuthConfig
+	parser *jwt.Parser
+	client *http.Client
+
+	fileKeys map[string]interface{} // keys from JWKSFile, always kept in the key set
+
+	mu            sync.RWMutex
+	keys          map[string]interface{} // key id -> public key
+	lastRefresh   time.Time              // last successful refresh from JWKSURL
+	lastAttempted time.Time              // last attempt to refresh from JWKSURL
+	refreshing    chan struct{}          // closed when the refresh in progress completes
+}
+
+func NewJWTAuthenticationManager(config *JWTAuthConfig) (*JWTAuthenticationManager, error) {
+	cfg := *config
+	cfg.SetDefaults()
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	m := &JWTAuthenticationManager{
+		config: &cfg,
+		parser: jwt.NewParser(jwt.WithValidMethods(validSigningMethods)),
+		client: &http.Client{Timeout: jwksRequestTimeout},
+		keys:   make(map[string]interface{}),
+	}
+	if cfg.JWKSFile != "" {
+		data, err := ioutil.ReadFile(cfg.JWKSFile)
+		if err != nil {
+			return nil, fmt.Errorf("jwt auth: unable to read JWKS file: %w", err)
+		}
+		keys, err := parseJWKS(data)
+		if err != nil {
+			return nil, fmt.Errorf("jwt auth: invalid JWKS file %s: %w", cfg.JWKSFile, err)
+		}
+		m.fileKeys = keys
+		m.keys = keys
+	} else if err := m.refresh(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *JWTAuthenticationManager) Authenticate(_ context.Context, token string) (*proto.PreAuthenticatedAuthenticationToken, error) {
+	rawToken := strings.TrimSpace(token)
+	if len(rawToken) > 7 && strings.EqualFold(rawToken[:7], "bearer ") {
+		rawToken = strings.TrimSpace(rawToken[7:])
+	}
+	claims := jwt.MapClaims{}
+	if _, err := m.parser.ParseWithClaims(rawToken, claims, m.keyFunc); err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+	exp, ok := claims["exp"]
+	if !ok {
+		return nil, errors.New("invalid token: missing exp claim")
+	}
+	expiredAt, err := toTime(exp)
+	if err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+	if m.config.Issuer != "" && !claims.VerifyIssuer(m.config.Issuer, true) {
+		return nil, errors.New("invalid token: issuer mismatch")
+	}
+	if m.config.Audience != "" && !claims.VerifyAudience(m.config.Audience, true) {
+		return nil, errors.New("invalid token: audience mismatch")
+	}
+	scopes, err := toScopes(claims[m.config.ScopeClaim])
+	if err != nil {
+		return nil, fmt.Errorf("invalid token: %s claim: %w", m.config.ScopeClaim, err)
+	}
+	return &proto.PreAuthenticatedAuthenticationToken{
+		RawToken:    []byte(rawToken),
+		ExpiredAt:   timestamppb.New(expiredAt),
+		Authorities: toAuthorities(scopes),
+	}, nil
+}
+
+func (m *JWTAuthenticationManager) IsEnabled(_ context.Context) (bool, error) {
+	return true, nil
+}
+
+// keyFunc looks up the verification key for the token, refreshing the key set
+// from the issuer endpoint if it is stale or the key id is unknown
+func (m *JWTAuthenticationManager) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	m.mu.RLock()
+	key, found := m.lookup(kid)
+	stale := time.Since(m.lastRefresh) > m.config.RefreshInterval
+	m.mu.RUnlock()
+	if m.config.JWKSURL != "" && found && stale {
+		// the known key is used while the key set is refreshed
+		go m.refreshOrWarn()
+	} else if m.config.JWKSURL != "" && !found {
+		m.refreshOrWarn()
+		m.mu.RLock()
+		key, found = m.lookup(kid)
+		m.mu.RUnlock()
+	}
+	if !found {
+		return nil, fmt.Errorf("no verification key found for kid %q", kid)
+	}
+	return key, nil
+}
+
+// lookup must be called with the lock held.
+// If the token has no key id, it's only accepted when the key set contains a single key
+func (m *JWTAuthenticationManager) lookup(kid string) (interface{}, bool) {
+	if kid == "" {
+		if len(m.keys) != 1 {
+			return nil, false
+		}
+		for _, k := range m.keys {
+			return k, true
+		}
+	}
+	k, ok := m.keys[kid]
+	return k, ok
+}
+
+// refreshOrWarn refreshes the key set, keeping the keys we already have on failure
+func (m *JWTAuthenticationManager) refreshOrWarn() {
+	if err := m.refresh(); err != nil {
+		log.Warn("Unable to refresh JWKS", "url", m.config.JWKSURL, "err", err)
+	}
+}
+
+// refresh fetches the key set from the issuer endpoint, rate limited by minJWKSRefreshInterval.
+// The lock is not held during the fetch, concurrent callers wait for the refresh in progress
+func (m *JWTAuthenticationManager) refresh() error {
+	m.mu.Lock()
+	if done := m.refreshing; done != nil {
+		m.mu.Unlock()
+		<-done
+		return nil
+	}
+	if !m.lastAttempted.IsZero() && time.Since(m.lastAttempted) < minJWKSRefreshInterval {
+		m.mu.Unlock()
+		return nil
+	}
+	m.lastAttempted = time.Now()
+	done := make(chan struct{})
+	m.refreshing = done
+	m.mu.Unlock()
+
+	keys, err := m.fetch()
+
+	m.mu.Lock()
+	m.refreshing = nil
+	if err == nil {
+		// keys from JWKSFile take precedence over the issuer keys with the same id
+		for kid, key := range m.fileKeys {
+			keys[kid] = key
+		}
+		m.keys = keys
+		m.lastRefresh = time.Now()
+	}
+	m.mu.Unlock()
+	close(done)
+	if err != nil {
+		return err
+	}
+	log.Debug("Refreshed JWKS", "url", m.config.JWKSURL, "keys", len(keys))
+	return nil
+}
+
+// fetch returns the signature verification keys served by the issuer endpoint
+func (m *JWTAuthenticationManager) fetch() (map[string]interface{}, error) {
+	resp, err := m.client.Get(m.config.JWKSURL)
+	if err != nil {
+		return nil, fmt.Errorf("jwt auth: unable to fetch JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("jwt auth: unable to fetch JWKS: %s", resp.Status)
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("jwt auth: unable to read JWKS: %w", err)
+	}
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return nil, fmt.Errorf("jwt auth: invalid JWKS from %s: %w", m.config.JWKSURL, err)
+	}
+	return keys, nil
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	// RSA
+	N string `json:"n"`
+	E string `json:"e"`
+	// EC
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// parseJWKS returns the signature verification keys in a JSON Web Key Set, indexed by key id.
+// Keys not meant for signatures and unsupported key types are skipped
+func parseJWKS(data []byte) (map[string]interface{}, error) {
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &jwks); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]interface{})
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		var (
+			key interface{}
+			err error
+		)
+		switch jwk.Kty {
+		case "RSA":
+			key, err = jwk.rsaPublicKey()
+		case "EC":
+			key, err = jwk.ecdsaPublicKey()
+		default:
+			log.Debug("Skipping unsupported JWK", "kid", jwk.Kid, "kty", jwk.Kty)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", jwk.Kid, err)
+		}
+		keys[jwk.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no signature verification keys")
+	}
+	return keys, nil
+}
+
+func (k *jsonWebKey) rsaPublicKey() (*rsa.PublicKey, error) {
+	n, err := decodeBigInt(k.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := decodeBigInt(k.E)
+	if err != nil {
+		return nil, err
+	}
+	if !e.IsInt64() || e.Int64() > 1<<31-1 {
+		return nil, errors.New("invalid RSA exponent")
+	}
+	return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+}
+
+func (k *jsonWebKey) ecdsaPublicKey() (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+	switch k.Crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+	}
+	x, err := decodeBigInt(k.X)
+	if err != nil {
+		return nil, err
+	}
+	y, err := decodeBigInt(k.Y)
+	if err != nil {
+		return nil, err
+	}
+	if !curve.IsOnCurve(x, y) {
+		return nil, errors.New("point is not on curve")
+	}
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, errors.New("empty value")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+func toTime(v interface{}) (time.Time, error) {
+	switch exp := v.(type) {
+	case float64:
+		return time.Unix(int64(exp), 0), nil
+	case json.Number:
+		i, err := exp.Int64()
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.Unix(i, 0), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid exp claim type %T", v)
+}
+
+// toScopes accepts a space separated string or an array of strings
+func toScopes(v interface{}) ([]string, error) {
+	switch s := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return strings.Fields(s), nil
+	case []interface{}:
+		scopes := make([]string, 0, len(s))
+		for _, e := range s {
+			str, ok := e.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid scope type %T", e)
+			}
+			scopes = append(scopes, str)
+		}
+		return scopes, nil
+	}
+	return nil, fmt.Errorf("invalid type %T", v)
+}
+
+func toAuthorities(scopes []string) []*proto.GrantedAuthority {
+	authorities := make([]*proto.GrantedAuthority, 0, len(scopes))
+	for _, scope := range scopes {
+		authority := &proto.GrantedAuthority{Raw: scope}
+		if strings.HasPrefix(scope, rpcScopePrefix) {
+			api := strings.TrimPrefix(scope, rpcScopePrefix)
+			if api == "*" {
+				authority.Service, authority.Method = "*", "*"
+			} else if elem := strings.SplitN(api, rpcScopeSeparator, 2); len(elem) == 2 && elem[0] != "" && elem[1] != "" {
+				authority.Service, authority.Method = elem[0], elem[1]
+			}
+		}
+		authorities = append(authorities, authority)
+	}
+	return authorities
+}
@@ -0,0 +1,249 @@
+package security
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/jpmorganchase/quorum-security-plugin-sdk-go/proto"
+	testifyassert "github.com/stretchr/testify/assert"
+	testifyrequire "github.com/stretchr/testify/require"
+)
+
+func encodeBigInt(i *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(i.Bytes())
+}
+
+func rsaJWK(kid string, key *rsa.PublicKey) map[string]string {
+	return map[string]string{
+		"kty": "RSA",
+		"kid": kid,
+		"use": "sig",
+		"n":   encodeBigInt(key.N),
+		"e":   encodeBigInt(big.NewInt(int64(key.E))),
+	}
+}
+
+func writeJWKS(t *testing.T, keys ...map[string]string) string {
+	data, err := json.Marshal(map[string]interface{}{"keys": keys})
+	testifyrequire.NoError(t, err)
+	file := filepath.Join(t.TempDir(), "jwks.json")
+	testifyrequire.NoError(t, ioutil.WriteFile(file, data, 0600))
+	return file
+}
+
+func signToken(t *testing.T, method jwt.SigningMethod, kid string, key interface{}, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	s, err := token.SignedString(key)
+	testifyrequire.NoError(t, err)
+	return s
+}
+
+func validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":   "https://issuer.example",
+		"aud":   "node1",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"scope": "rpc://eth_* rpc://admin_nodeInfo psi://PS1?self.eoa=0x0&node.eoa=0x0",
+	}
+}
+
+func TestJWTAuthenticationManager_Authenticate_whenTypical(t *testing.T) {
+	assert := testifyassert.New(t)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	testifyrequire.NoError(t, err)
+	testObject, err := NewJWTAuthenticationManager(&JWTAuthConfig{
+		JWKSFile: writeJWKS(t, rsaJWK("key1", &key.PublicKey)),
+		Issuer:   "https://issuer.example",
+		Audience: "node1",
+	})
+	testifyrequire.NoError(t, err)
+	claims := validClaims()
+	token := signToken(t, jwt.SigningMethodRS256, "key1", key, claims)
+
+	authToken, err := testObject.Authenticate(context.Background(), "Bearer "+token)
+
+	testifyrequire.NoError(t, err)
+	assert.Equal([]byte(token), authToken.RawToken)
+	assert.Equal(claims["exp"], authToken.ExpiredAt.AsTime().Unix())
+	testifyrequire.Len(t, authToken.Authorities, 3)
+	assert.Equal("eth", authToken.Authorities[0].Service)
+	assert.Equal("*", authToken.Authorities[0].Method)
+	assert.Equal("admin", authToken.Authorities[1].Service)
+	assert.Equal("nodeInfo", authToken.Authorities[1].Method)
+	assert.Equal(&proto.GrantedAuthority{Raw: "psi://PS1?self.eoa=0x0&node.eoa=0x0"}, authToken.Authorities[2])
+	isEnabled, err := testObject.IsEnabled(context.Background())
+	assert.NoError(err)
+	assert.True(isEnabled)
+}
+
+func TestJWTAuthenticationManager_Authenticate_whenECDSAKeyAndScopeArray(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	testifyrequire.NoError(t, err)
+	testObject, err := NewJWTAuthenticationManager(&JWTAuthConfig{
+		JWKSFile:   writeJWKS(t, map[string]string{"kty": "EC", "crv": "P-256", "x": encodeBigInt(key.X), "y": encodeBigInt(key.Y)}),
+		ScopeClaim: "scp",
+	})
+	testifyrequire.NoError(t, err)
+	// no kid is accepted as there is a single key
+	token := signToken(t, jwt.SigningMethodES256, "", key, jwt.MapClaims{
+		"exp": time.Now().Add(time.Hour).Unix(),
+		"scp": []string{"rpc://*"},
+	})
+
+	authToken, err := testObject.Authenticate(context.Background(), token)
+
+	testifyrequire.NoError(t, err)
+	testifyrequire.Len(t, authToken.Authorities, 1)
+	testifyassert.Equal(t, "*", authToken.Authorities[0].Service)
+	testifyassert.Equal(t, "*", authToken.Authorities[0].Method)
+}
+
+func TestJWTAuthenticationManager_Authenticate_whenInvalid(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	testifyrequire.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	testifyrequire.NoError(t, err)
+	testObject, err := NewJWTAuthenticationManager(&JWTAuthConfig{
+		JWKSFile: writeJWKS(t, rsaJWK("key1", &key.PublicKey)),
+		Issuer:   "https://issuer.example",
+		Audience: "node1",
+	})
+	testifyrequire.NoError(t, err)
+	withClaim := func(name string, value interface{}) jwt.MapClaims {
+		c := validClaims()
+		if value == nil {
+			delete(c, name)
+		} else {
+			c[name] = value
+		}
+		return c
+	}
+
+	for name, token := range map[string]string{
+		"expired":         signToken(t, jwt.SigningMethodRS256, "key1", key, withClaim("exp", time.Now().Add(-time.Minute).Unix())),
+		"missing exp":     signToken(t, jwt.SigningMethodRS256, "key1", key, withClaim("exp", nil)),
+		"wrong issuer":    signToken(t, jwt.SigningMethodRS256, "key1", key, withClaim("iss", "https://other.example")),
+		"wrong audience":  signToken(t, jwt.SigningMethodRS256, "key1", key, withClaim("aud", "node2")),
+		"wrong signature": signToken(t, jwt.SigningMethodRS256, "key1", otherKey, validClaims()),
+		"unknown kid":     signToken(t, jwt.SigningMethodRS256, "key2", key, validClaims()),
+		"hmac":            signToken(t, jwt.SigningMethodHS256, "key1", []byte("secret"), validClaims()),
+		"malformed":       "not-a-token",
+	} {
+		_, err := testObject.Authenticate(context.Background(), token)
+
+		testifyassert.Error(t, err, name)
+	}
+}
+
+func TestJWTAuthenticationManager_Authenticate_whenKeyRotatedAtIssuer(t *testing.T) {
+	key1, err := rsa.GenerateKey(rand.Reader, 2048)
+	testifyrequire.NoError(t, err)
+	key2, err := rsa.GenerateKey(rand.Reader, 2048)
+	testifyrequire.NoError(t, err)
+	served := []map[string]string{rsaJWK("key1", &key1.PublicKey), rsaJWK("key2", &key2.PublicKey)}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"keys": served})
+	}))
+	defer server.Close()
+	testObject, err := NewJWTAuthenticationManager(&JWTAuthConfig{
+		JWKSFile:             writeJWKS(t, rsaJWK("key1", &key1.PublicKey)),
+		JWKSURL:              server.URL,
+		AllowInsecureJWKSURL: true,
+	})
+	testifyrequire.NoError(t, err)
+
+	_, err = testObject.Authenticate(context.Background(), signToken(t, jwt.SigningMethodRS256, "key2", key2, validClaims()))
+
+	testifyassert.NoError(t, err)
+}
+
+func TestJWTAuthenticationManager_Authenticate_whenFileKeyNotServedByIssuer(t *testing.T) {
+	key1, err := rsa.GenerateKey(rand.Reader, 2048)
+	testifyrequire.NoError(t, err)
+	key2, err := rsa.GenerateKey(rand.Reader, 2048)
+	testifyrequire.NoError(t, err)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"keys": []map[string]string{rsaJWK("key2", &key2.PublicKey)}})
+	}))
+	defer server.Close()
+	testObject, err := NewJWTAuthenticationManager(&JWTAuthConfig{
+		JWKSFile:             writeJWKS(t, rsaJWK("key1", &key1.PublicKey)),
+		JWKSURL:              server.URL,
+		AllowInsecureJWKSURL: true,
+	})
+	testifyrequire.NoError(t, err)
+
+	// refreshes the key set from the issuer
+	_, err = testObject.Authenticate(context.Background(), signToken(t, jwt.SigningMethodRS256, "key2", key2, validClaims()))
+	testifyrequire.NoError(t, err)
+
+	_, err = testObject.Authenticate(context.Background(), signToken(t, jwt.SigningMethodRS256, "key1", key1, validClaims()))
+	testifyassert.NoError(t, err)
+}
+
+func TestJWTAuthenticationManager_Authenticate_whenRefreshInProgress(t *testing.T) {
+	key1, err := rsa.GenerateKey(rand.Reader, 2048)
+	testifyrequire.NoError(t, err)
+	fetching, release := make(chan struct{}), make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(fetching)
+		<-release
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"keys": []map[string]string{rsaJWK("key1", &key1.PublicKey)}})
+	}))
+	defer server.Close()
+	defer close(release)
+	testObject, err := NewJWTAuthenticationManager(&JWTAuthConfig{
+		JWKSFile:             writeJWKS(t, rsaJWK("key1", &key1.PublicKey)),
+		JWKSURL:              server.URL,
+		AllowInsecureJWKSURL: true,
+	})
+	testifyrequire.NoError(t, err)
+	token := signToken(t, jwt.SigningMethodRS256, "key1", key1, validClaims())
+
+	// the key set is stale so the first authentication starts a refresh
+	_, err = testObject.Authenticate(context.Background(), token)
+	testifyrequire.NoError(t, err)
+	<-fetching
+
+	authenticated := make(chan error)
+	go func() {
+		_, err := testObject.Authenticate(context.Background(), token)
+		authenticated <- err
+	}()
+	select {
+	case err := <-authenticated:
+		testifyassert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("authentication blocked by the refresh in progress")
+	}
+}
+
+func TestNewJWTAuthenticationManager_whenInsecureJWKSURL(t *testing.T) {
+	_, err := NewJWTAuthenticationManager(&JWTAuthConfig{
+		JWKSURL: "http://issuer.example/jwks",
+	})
+
+	testifyassert.EqualError(t, err, "jwt auth: JWKSURL must use https, set AllowInsecureJWKSURL to allow http")
+}
+
+func TestNewJWTAuthenticationManager_whenNoKeySource(t *testing.T) {
+	_, err := NewJWTAuthenticationManager(&JWTAuthConfig{})
+
+	testifyassert.EqualError(t, err, "jwt auth: either JWKSFile or JWKSURL must be configured")
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/tls"
 	"errors"
 
+	iplugin "github.com/ethereum/go-ethereum/internal/plugin"
 	"github.com/jpmorganchase/quorum-security-plugin-sdk-go/proto"
 )
 
@@ -20,7 +21,8 @@ type AuthenticationManager interface {
 type AuthenticationManagerDeferFunc func() (AuthenticationManager, error)
 
 type DeferredAuthenticationManager struct {
-	deferFunc AuthenticationManagerDeferFunc
+	deferFunc     AuthenticationManagerDeferFunc
+	callErrorFunc iplugin.CallErrorFunc
 }
 
 func (d *DeferredAuthenticationManager) Authenticate(ctx context.Context, token string) (*proto.PreAuthenticatedAuthenticationToken, error) {
@@ -28,7 +30,8 @@ func (d *DeferredAuthenticationManager) Authenticate(ctx context.Context, token
 	if err != nil {
 		return nil, err
 	}
-	return am.Authenticate(ctx, token)
+	authToken, err := am.Authenticate(ctx, token)
+	return authToken, d.callErrorFunc.Apply(err)
 }
 
 func (d *DeferredAuthenticationManager) IsEnabled(ctx context.Context) (bool, error) {
@@ -36,12 +39,14 @@ func (d *DeferredAuthenticationManager) IsEnabled(ctx context.Context) (bool, er
 	if err != nil {
 		return false, err
 	}
-	return am.IsEnabled(ctx)
+	enabled, err := am.IsEnabled(ctx)
+	return enabled, d.callErrorFunc.Apply(err)
 }
 
-func NewDeferredAuthenticationManager(deferFunc AuthenticationManagerDeferFunc) *DeferredAuthenticationManager {
+func NewDeferredAuthenticationManager(deferFunc AuthenticationManagerDeferFunc, callErrorFunc iplugin.CallErrorFunc) *DeferredAuthenticationManager {
 	return &DeferredAuthenticationManager{
-		deferFunc: deferFunc,
+		deferFunc:     deferFunc,
+		callErrorFunc: callErrorFunc,
 	}
 }
 
@@ -5,10 +5,13 @@ import (
 	"reflect"
 	"sync"
 	"sync/atomic"
+	"time"
 	"unsafe"
 
 	"github.com/ethereum/go-ethereum/accounts/pluggable"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/plugin/eventsink"
+	"github.com/ethereum/go-ethereum/plugin/txpolicy"
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
@@ -22,6 +25,14 @@ type PluginManagerInterface interface {
 	GetPluginTemplate(name PluginInterfaceName, v managedPlugin) error
 }
 
+var (
+	// how often the supervisor pings started plugins
+	pluginHealthCheckInterval = 5 * time.Second
+	// bounds of the exponential backoff between restart attempts of a crashed plugin
+	pluginRestartMinBackoff = time.Second
+	pluginRestartMaxBackoff = time.Minute
+)
+
 //go:generate mockgen -source=service.go -destination plugin_manager_mockery.go -package plugin
 var _ PluginManagerInterface = &PluginManager{}
 var _ PluginManagerInterface = &MockPluginManagerInterface{}
@@ -38,6 +49,9 @@ type PluginManager struct {
 	plugins            map[PluginInterfaceName]managedPlugin // lazy load the actual plugin templates
 	initializedPlugins map[PluginInterfaceName]managedPlugin // prepopulate during initialization of plugin manager, needed for starting/stopping/getting info
 	pluginsStarted     *int32
+	lifecycleMux       sync.Mutex    // serializes plugin restarts by the supervisor and reloads via the API
+	supervisorQuit     chan struct{} // closed to stop the supervisor
+	supervisorWg       sync.WaitGroup
 }
 
 // this is called after PluginManager service has been successfully started
@@ -78,10 +92,83 @@ func (s *PluginManager) Start() (err error) {
 		}
 	} else {
 		atomic.StoreInt32(s.pluginsStarted, 1)
+		s.startSupervisor()
 	}
 	return
 }
 
+func (s *PluginManager) startSupervisor() {
+	s.supervisorQuit = make(chan struct{})
+	s.supervisorWg.Add(1)
+	go s.supervise(s.supervisorQuit)
+}
+
+func (s *PluginManager) stopSupervisor() {
+	if s.supervisorQuit == nil {
+		return
+	}
+	close(s.supervisorQuit)
+	s.supervisorWg.Wait()
+	s.supervisorQuit = nil
+}
+
+// supervise periodically pings all started plugins and restarts those which
+// are no longer responsive. Consecutive failed restarts of the same plugin
+// are spaced out using an exponential backoff.
+func (s *PluginManager) supervise(quit chan struct{}) {
+	defer s.supervisorWg.Done()
+	type restartState struct {
+		backoff     time.Duration
+		nextAttempt time.Time
+	}
+	restarts := make(map[PluginInterfaceName]*restartState)
+	ticker := time.NewTicker(pluginHealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-quit:
+			return
+		case now := <-ticker.C:
+			for name, p := range s.initializedPlugins {
+				sp, ok := p.(supervisedPlugin)
+				if !ok {
+					continue
+				}
+				err := sp.ping()
+				if err == nil {
+					delete(restarts, name)
+					continue
+				}
+				rs, ok := restarts[name]
+				if !ok {
+					log.Warn("Plugin is not responding", "provider", name, "error", err)
+					rs = &restartState{backoff: pluginRestartMinBackoff}
+					restarts[name] = rs
+				}
+				if now.Before(rs.nextAttempt) {
+					continue
+				}
+				log.Info("Restarting plugin", "provider", name)
+				if err := s.restart(sp); err != nil {
+					log.Error("Unable to restart plugin", "provider", name, "error", err, "retryIn", rs.backoff)
+					rs.nextAttempt = now.Add(rs.backoff)
+					if rs.backoff *= 2; rs.backoff > pluginRestartMaxBackoff {
+						rs.backoff = pluginRestartMaxBackoff
+					}
+					continue
+				}
+				delete(restarts, name)
+			}
+		}
+	}
+}
+
+func (s *PluginManager) restart(p supervisedPlugin) error {
+	s.lifecycleMux.Lock()
+	defer s.lifecycleMux.Unlock()
+	return p.restart()
+}
+
 func (s *PluginManager) getPlugin(name PluginInterfaceName) (managedPlugin, bool) {
 	s.mux.Lock()
 	defer s.mux.Unlock()
@@ -150,6 +237,7 @@ func (s *PluginManager) GetPluginTemplate(name PluginInterfaceName, v managedPlu
 func (s *PluginManager) Stop() error {
 	initializedPluginsCount := len(s.initializedPlugins)
 	log.Info("Stopping all plugins", "count", initializedPluginsCount)
+	s.stopSupervisor()
 	allErrors := make([]error, 0)
 	for _, p := range s.initializedPlugins {
 		if err := p.Stop(); err != nil {
@@ -196,11 +284,31 @@ func (s *PluginManager) AddAccountPluginToBackend(b *pluggable.Backend) error {
 	return nil
 }
 
+// TransactionPolicy returns the transaction policy backed by the txpolicy plugin
+func (s *PluginManager) TransactionPolicy() (txpolicy.TransactionPolicy, error) {
+	v := new(TxPolicyPluginTemplate)
+	if err := s.GetPluginTemplate(TxPolicyPluginInterfaceName, v); err != nil {
+		return nil, err
+	}
+	return v.Get()
+}
+
+// EventSink returns the event sink backed by the eventsink plugin
+func (s *PluginManager) EventSink() (eventsink.EventSink, error) {
+	v := new(EventSinkPluginTemplate)
+	if err := s.GetPluginTemplate(EventSinkPluginInterfaceName, v); err != nil {
+		return nil, err
+	}
+	return v.Get()
+}
+
 func (s *PluginManager) Reload(name PluginInterfaceName) (bool, error) {
 	p, ok := s.getPlugin(name)
 	if !ok {
 		return false, fmt.Errorf("no such plugin provider: %s", name)
 	}
+	s.lifecycleMux.Lock()
+	defer s.lifecycleMux.Unlock()
 	_ = p.Stop()
 	if err := p.Start(); err != nil {
 		return false, err
@@ -1,12 +1,19 @@
 package plugin
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"runtime"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/plugin/helloworld"
 	"github.com/hashicorp/go-plugin"
 	testifyassert "github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func typicalPluginManager(t *testing.T) *PluginManager {
@@ -143,3 +150,91 @@ func (i invalidPluginTemplate) Stop() error {
 func (i invalidPluginTemplate) Info() (PluginInterfaceName, interface{}) {
 	panic("implement me")
 }
+
+type crashingPlugin struct {
+	invalidPluginTemplate
+	up              int32
+	failedRestarts  int32 // number of restarts to fail before succeeding
+	restartAttempts int32
+}
+
+func (p *crashingPlugin) ping() error {
+	if atomic.LoadInt32(&p.up) == 0 {
+		return errors.New("plugin has exited")
+	}
+	return nil
+}
+
+func (p *crashingPlugin) restart() error {
+	atomic.AddInt32(&p.restartAttempts, 1)
+	if atomic.AddInt32(&p.failedRestarts, -1) >= 0 {
+		return errors.New("unable to start")
+	}
+	atomic.StoreInt32(&p.up, 1)
+	return nil
+}
+
+func TestPluginManager_supervise_restartsUnresponsivePlugin(t *testing.T) {
+	defer func(interval, minBackoff time.Duration) {
+		pluginHealthCheckInterval, pluginRestartMinBackoff = interval, minBackoff
+	}(pluginHealthCheckInterval, pluginRestartMinBackoff)
+	pluginHealthCheckInterval, pluginRestartMinBackoff = time.Millisecond, time.Millisecond
+
+	p := &crashingPlugin{failedRestarts: 2}
+	testObject := &PluginManager{
+		initializedPlugins: map[PluginInterfaceName]managedPlugin{HelloWorldPluginInterfaceName: p},
+	}
+
+	testObject.startSupervisor()
+	testifyassert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&p.up) == 1
+	}, time.Second, time.Millisecond)
+	testObject.stopSupervisor()
+
+	// two failed restarts followed by a successful one
+	testifyassert.Equal(t, int32(3), atomic.LoadInt32(&p.restartAttempts))
+}
+
+func TestBasePlugin_whenNotRunning(t *testing.T) {
+	assert := testifyassert.New(t)
+	testObject := typicalPluginManager(t)
+	p := testObject.initializedPlugins[HelloWorldPluginInterfaceName].(*basePlugin)
+
+	_, err := p.dispense("arbitrary")
+	assert.EqualError(err, "plugin [helloworld] is not running")
+	assert.EqualError(p.ping(), "plugin [helloworld] is not running")
+
+	_, info := p.Info()
+	assert.Equal("down", info.(map[string]interface{})["state"])
+	assert.Equal(uint64(0), info.(map[string]interface{})["restarts"])
+}
+
+type stubHelloWorld struct {
+	err error
+}
+
+func (s *stubHelloWorld) Greeting(_ context.Context, _ string) (string, error) {
+	return "", s.err
+}
+
+func TestBasePlugin_callError_whenPluginExitedDuringCall(t *testing.T) {
+	assert := testifyassert.New(t)
+	testObject := typicalPluginManager(t)
+	p := testObject.initializedPlugins[HelloWorldPluginInterfaceName].(*basePlugin)
+	transportErr := status.Error(codes.Unavailable, "transport is closing")
+	greeter := &helloworld.ReloadablePluginHelloWorld{
+		DeferFunc: func() (helloworld.PluginHelloWorld, error) {
+			return &stubHelloWorld{err: transportErr}, nil
+		},
+		CallErrorFunc: p.callError,
+	}
+
+	_, err := greeter.Greeting(context.Background(), "arbitrary")
+
+	assert.EqualError(err, "plugin [helloworld] has exited unexpectedly: rpc error: code = Unavailable desc = transport is closing")
+	assert.True(errors.Is(err, transportErr))
+
+	otherErr := status.Error(codes.Internal, "arbitrary error")
+	assert.Equal(otherErr, p.callError(otherErr))
+	assert.NoError(p.callError(nil))
+}
@@ -12,9 +12,11 @@ import (
 	"strings"
 
 	"github.com/ethereum/go-ethereum/plugin/account"
+	"github.com/ethereum/go-ethereum/plugin/eventsink"
 	"github.com/ethereum/go-ethereum/plugin/helloworld"
 	"github.com/ethereum/go-ethereum/plugin/qlight"
 	"github.com/ethereum/go-ethereum/plugin/security"
+	"github.com/ethereum/go-ethereum/plugin/txpolicy"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/hashicorp/go-plugin"
 	"github.com/naoina/toml"
@@ -25,6 +27,8 @@ const (
 	SecurityPluginInterfaceName           = PluginInterfaceName("security")
 	AccountPluginInterfaceName            = PluginInterfaceName("account")
 	QLightTokenManagerPluginInterfaceName = PluginInterfaceName("qlighttokenmanager")
+	TxPolicyPluginInterfaceName           = PluginInterfaceName("txpolicy")
+	EventSinkPluginInterfaceName          = PluginInterfaceName("eventsink")
 )
 
 var (
@@ -83,6 +87,16 @@ var (
 				qlight.ConnectorName: &qlight.PluginConnector{},
 			},
 		},
+		TxPolicyPluginInterfaceName: {
+			pluginSet: plugin.PluginSet{
+				txpolicy.ConnectorName: &txpolicy.PluginConnector{},
+			},
+		},
+		EventSinkPluginInterfaceName: {
+			pluginSet: plugin.PluginSet{
+				eventsink.ConnectorName: &eventsink.PluginConnector{},
+			},
+		},
 	}
 
 	// this is the place holder for future solution of the plugin central
@@ -259,21 +273,47 @@ type PluginCentralConfiguration struct {
 	// URL path template to the plugin sha256 checksum signature file.
 	// It uses Golang text template.
 	PluginSigPathTemplate string `json:"pluginSigPathTemplate" toml:""`
+	// URI of the PGP public key verifying the manifest of a plugin bundle
+	// used as a local mirror. It must not point into the bundle.
+	BundlePublicKeyURI string `json:"bundlePublicKeyURI" toml:""`
 }
 
-// populate default values from quorumPluginCentralConfiguration
+// populate default values from quorumPluginCentralConfiguration,
+// or from the local mirror layout if BaseURL uses the 'file' scheme
 func (c *PluginCentralConfiguration) SetDefaults() {
 	if len(c.BaseURL) == 0 {
 		c.BaseURL = quorumPluginCentralConfiguration.BaseURL
 	}
+	defaults := quorumPluginCentralConfiguration
+	if dir, ok := localMirrorDir(c.BaseURL); ok {
+		defaults = LocalMirrorCentralConfiguration(dir)
+		// files are resolved relative to the mirror directory
+		if !strings.HasSuffix(c.BaseURL, "/") {
+			c.BaseURL += "/"
+		}
+	}
 	if len(c.PublicKeyURI) == 0 {
-		c.PublicKeyURI = quorumPluginCentralConfiguration.PublicKeyURI
+		c.PublicKeyURI = defaults.PublicKeyURI
 	}
 	if len(c.PluginDistPathTemplate) == 0 {
-		c.PluginDistPathTemplate = quorumPluginCentralConfiguration.PluginDistPathTemplate
+		c.PluginDistPathTemplate = defaults.PluginDistPathTemplate
 	}
 	if len(c.PluginSigPathTemplate) == 0 {
-		c.PluginSigPathTemplate = quorumPluginCentralConfiguration.PluginSigPathTemplate
+		c.PluginSigPathTemplate = defaults.PluginSigPathTemplate
+	}
+}
+
+// LocalMirrorCentralConfiguration returns the Plugin Central configuration
+// resolving plugins from a local mirror directory, e.g.: a plugin bundle.
+//
+// Files are laid out flat in the directory, using the same names as the
+// plugin files in the plugin base directory.
+func LocalMirrorCentralConfiguration(dir string) *PluginCentralConfiguration {
+	return &PluginCentralConfiguration{
+		BaseURL:                (&url.URL{Scheme: "file", Path: filepath.ToSlash(dir) + "/"}).String(),
+		PublicKeyURI:           DefaultPublicKeyFile,
+		PluginDistPathTemplate: "{{.Name}}-{{.Version}}-{{.OS}}-{{.Arch}}.zip",
+		PluginSigPathTemplate:  "{{.Name}}-{{.Version}}-{{.OS}}-{{.Arch}}.zip.sha256sum.asc",
 	}
 }
 
@@ -0,0 +1,54 @@
+package txpolicy
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/plugin/txpolicy/proto"
+)
+
+type PluginGateway struct {
+	client proto.PluginTransactionPolicyClient
+}
+
+var _ TransactionPolicy = &PluginGateway{}
+
+func (p *PluginGateway) Evaluate(ctx context.Context, req *EvaluationRequest) (*Decision, error) {
+	tx := req.Transaction
+	protoTx := &proto.Transaction{
+		Hash:            tx.Hash().Bytes(),
+		From:            req.From.Bytes(),
+		Nonce:           tx.Nonce(),
+		Value:           tx.Value().Bytes(),
+		Gas:             tx.Gas(),
+		GasPrice:        tx.GasPrice().Bytes(),
+		Data:            tx.Data(),
+		IsPrivate:       tx.IsPrivate(),
+		IsPrivacyMarker: tx.IsPrivacyMarker(),
+		Type:            uint32(tx.Type()),
+		PrivacyFlag:     uint64(tx.PrivacyFlag()),
+	}
+	if tx.To() != nil {
+		protoTx.To = tx.To().Bytes()
+	}
+	var privateArgs *proto.PrivateArgs
+	if req.PrivateArgs != nil {
+		privateArgs = &proto.PrivateArgs{
+			PrivateFrom:  req.PrivateArgs.PrivateFrom,
+			PrivateFor:   req.PrivateArgs.PrivateFor,
+			PrivacyFlag:  req.PrivateArgs.PrivacyFlag,
+			MandatoryFor: req.PrivateArgs.MandatoryFor,
+		}
+	}
+	resp, err := p.client.Evaluate(ctx, &proto.EvaluateTransaction_Request{
+		Source:      req.Source,
+		Transaction: protoTx,
+		PrivateArgs: privateArgs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Decision{
+		Allowed: resp.Allowed,
+		Reason:  resp.Reason,
+	}, nil
+}
@@ -0,0 +1,123 @@
+package txpolicy
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/plugin/txpolicy/proto"
+	"github.com/ethereum/go-ethereum/private/engine"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPluginGateway_Evaluate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	to := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	from := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	tx := types.NewTransaction(1, to, big.NewInt(10), 21000, big.NewInt(2), []byte("arbitrary data"))
+	req := &proto.EvaluateTransaction_Request{
+		Source: SourceRPC,
+		Transaction: &proto.Transaction{
+			Hash:     tx.Hash().Bytes(),
+			From:     from.Bytes(),
+			To:       to.Bytes(),
+			Nonce:    1,
+			Value:    []byte{10},
+			Gas:      21000,
+			GasPrice: []byte{2},
+			Data:     []byte("arbitrary data"),
+		},
+		PrivateArgs: &proto.PrivateArgs{
+			PrivateFrom: "arbitrary from",
+			PrivateFor:  []string{"arbitrary for"},
+			PrivacyFlag: 1,
+		},
+	}
+	mockClient := proto.NewMockPluginTransactionPolicyClient(ctrl)
+	mockClient.
+		EXPECT().
+		Evaluate(gomock.Any(), gomock.Eq(req)).
+		Return(&proto.EvaluateTransaction_Response{
+			Allowed: false,
+			Reason:  "sanctioned",
+		}, nil)
+	testObject := &PluginGateway{client: mockClient}
+
+	decision, err := testObject.Evaluate(context.Background(), &EvaluationRequest{
+		Source:      SourceRPC,
+		Transaction: tx,
+		From:        from,
+		PrivateArgs: &PrivateArgs{
+			PrivateFrom: "arbitrary from",
+			PrivateFor:  []string{"arbitrary for"},
+			PrivacyFlag: 1,
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &Decision{Allowed: false, Reason: "sanctioned"}, decision)
+}
+
+func TestPluginGateway_Evaluate_whenTypedPrivateTransaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	to := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	from := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	tx := types.NewTx(&types.PrivateTx{
+		ChainID:     big.NewInt(1337),
+		Nonce:       1,
+		GasPrice:    big.NewInt(0),
+		Gas:         21000,
+		To:          &to,
+		Value:       big.NewInt(0),
+		Data:        []byte("arbitrary payload hash"),
+		PrivacyFlag: engine.PrivacyFlagStateValidation,
+	})
+	mockClient := proto.NewMockPluginTransactionPolicyClient(ctrl)
+	mockClient.
+		EXPECT().
+		Evaluate(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *proto.EvaluateTransaction_Request, _ ...interface{}) (*proto.EvaluateTransaction_Response, error) {
+			assert.Equal(t, uint32(types.PrivateTxType), req.Transaction.Type)
+			assert.Equal(t, uint64(engine.PrivacyFlagStateValidation), req.Transaction.PrivacyFlag)
+			assert.True(t, req.Transaction.IsPrivate)
+			return &proto.EvaluateTransaction_Response{Allowed: true}, nil
+		})
+	testObject := &PluginGateway{client: mockClient}
+
+	decision, err := testObject.Evaluate(context.Background(), &EvaluationRequest{
+		Source:      SourceP2P,
+		Transaction: tx,
+		From:        from,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &Decision{Allowed: true}, decision)
+}
+
+type stubPolicy struct {
+	decision *Decision
+}
+
+func (s *stubPolicy) Evaluate(ctx context.Context, req *EvaluationRequest) (*Decision, error) {
+	return s.decision, nil
+}
+
+func TestCheck(t *testing.T) {
+	req := &EvaluationRequest{Source: SourceRPC}
+
+	assert.NoError(t, Check(context.Background(), nil, req))
+	assert.NoError(t, Check(context.Background(), &stubPolicy{&Decision{Allowed: true}}, req))
+	err := Check(context.Background(), &stubPolicy{&Decision{Reason: "limit exceeded"}}, req)
+	assert.EqualError(t, err, "transaction denied by policy: limit exceeded")
+	assert.IsType(t, &DeniedError{}, err)
+
+	_, err = (&ReloadableTransactionPolicy{DeferFunc: func() (TransactionPolicy, error) {
+		return nil, assert.AnError
+	}}).Evaluate(context.Background(), req)
+	assert.Equal(t, assert.AnError, err)
+}
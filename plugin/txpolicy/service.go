@@ -0,0 +1,92 @@
+package txpolicy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	iplugin "github.com/ethereum/go-ethereum/internal/plugin"
+)
+
+const (
+	// SourceRPC indicates the evaluation is requested while submitting a transaction via the RPC API
+	SourceRPC = "rpc"
+	// SourceP2P indicates the evaluation is requested for a transaction received from a peer
+	SourceP2P = "p2p"
+
+	// evaluationTimeout is the maximum time to wait for the policy to evaluate a transaction
+	evaluationTimeout = 5 * time.Second
+)
+
+// PrivateArgs are the private transaction arguments given when a transaction is submitted via the RPC API
+type PrivateArgs struct {
+	PrivateFrom  string
+	PrivateFor   []string
+	PrivacyFlag  uint64
+	MandatoryFor []string
+}
+
+// EvaluationRequest contains the transaction to be evaluated
+type EvaluationRequest struct {
+	Source      string
+	Transaction *types.Transaction
+	From        common.Address
+	PrivateArgs *PrivateArgs // nil if not available
+}
+
+// Decision is the outcome of a transaction evaluation
+type Decision struct {
+	Allowed bool
+	Reason  string
+}
+
+// TransactionPolicy decides if a transaction is allowed into the node
+type TransactionPolicy interface {
+	Evaluate(ctx context.Context, req *EvaluationRequest) (*Decision, error)
+}
+
+// DeniedError is returned when a transaction is denied by the transaction policy
+type DeniedError struct {
+	Reason string
+}
+
+func (e *DeniedError) Error() string {
+	return fmt.Sprintf("transaction denied by policy: %s", e.Reason)
+}
+
+// Check evaluates the transaction using the given policy and returns an error
+// if the transaction is not allowed or the policy can't be evaluated.
+// A nil policy allows all transactions.
+func Check(ctx context.Context, policy TransactionPolicy, req *EvaluationRequest) error {
+	if policy == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, evaluationTimeout)
+	defer cancel()
+	decision, err := policy.Evaluate(ctx, req)
+	if err != nil {
+		return fmt.Errorf("unable to evaluate transaction policy: %w", err)
+	}
+	if !decision.Allowed {
+		return &DeniedError{Reason: decision.Reason}
+	}
+	return nil
+}
+
+type TransactionPolicyDeferFunc func() (TransactionPolicy, error)
+
+type ReloadableTransactionPolicy struct {
+	DeferFunc     TransactionPolicyDeferFunc
+	CallErrorFunc iplugin.CallErrorFunc // optional, translates the errors of calls to the plugin
+}
+
+func (d *ReloadableTransactionPolicy) Evaluate(ctx context.Context, req *EvaluationRequest) (*Decision, error) {
+	p, err := d.DeferFunc()
+	if err != nil {
+		return nil, err
+	}
+	decision, err := p.Evaluate(ctx, req)
+	return decision, d.CallErrorFunc.Apply(err)
+}
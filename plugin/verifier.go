@@ -2,7 +2,10 @@ package plugin
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
 	"path"
+	"path/filepath"
 
 	"github.com/ethereum/go-ethereum/log"
 )
@@ -39,7 +42,39 @@ func NewVerifier(pm *PluginManager, localVerify bool, publicKey string) (Verifie
 	}
 	if localVerify {
 		return NewLocalVerifier(publicKeyPath, pluginBaseDir)
+	} else if mirrorDir, ok := centralClient.LocalMirrorDir(); ok {
+		// signatures are available in the local mirror, they are verified with
+		// the configured public key rather than the copy in the mirror
+		localVerifier, err := NewLocalVerifier(publicKeyPath, mirrorDir)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := os.Stat(filepath.Join(mirrorDir, BundleManifestFile)); os.IsNotExist(err) {
+			return localVerifier, nil
+		}
+		manifest, err := readMirrorBundle(centralClient.config, mirrorDir)
+		if err != nil {
+			return nil, err
+		}
+		return NewBundleVerifier(manifest, localVerifier), nil
 	} else {
 		return NewOnlineVerifier(centralClient), nil
 	}
 }
+
+// readMirrorBundle reads the plugin bundle of a local mirror, verified with
+// the configured bundle public key
+func readMirrorBundle(config *PluginCentralConfiguration, mirrorDir string) (*BundleManifest, error) {
+	if config.BundlePublicKeyURI == "" {
+		return nil, fmt.Errorf("bundlePublicKeyURI is required to verify the plugin bundle in %s", mirrorDir)
+	}
+	bundlePublicKeyPath, err := resolveFilePath(config.BundlePublicKeyURI)
+	if err != nil {
+		return nil, err
+	}
+	bundlePublicKey, err := ioutil.ReadFile(bundlePublicKeyPath)
+	if err != nil {
+		return nil, err
+	}
+	return ReadBundle(mirrorDir, bundlePublicKey)
+}
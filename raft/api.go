@@ -2,6 +2,7 @@ package raft
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/coreos/etcd/pkg/types"
 )
@@ -43,8 +44,36 @@ func (s *PublicRaftAPI) checkIfNodeInCluster() error {
 	return nil
 }
 
-func (s *PublicRaftAPI) AddPeer(enodeId string) (uint16, error) {
+// checkMembershipChange rejects changes that would leave the reachable voters short of a quorum,
+// unless force is set.
+func (s *PublicRaftAPI) checkMembershipChange(change string, raftId uint16, force *bool) error {
+	if force != nil && *force {
+		return nil
+	}
+	plan, err := s.raftService.raftProtocolManager.PlanMembershipChange(change, raftId)
+	if err != nil {
+		return err
+	}
+	if !plan.Safe {
+		return fmt.Errorf("unsafe membership change: %s. pass force to apply it anyway", plan.Reason)
+	}
+	return nil
+}
+
+// PlanMembershipChange reports the voter and learner counts and the quorum risk resulting from
+// change (addPeer, addLearner, promoteToPeer or removePeer) without proposing it.
+func (s *PublicRaftAPI) PlanMembershipChange(change string, raftId uint16) (*MembershipPlan, error) {
 	if err := s.checkIfNodeInCluster(); err != nil {
+		return nil, err
+	}
+	return s.raftService.raftProtocolManager.PlanMembershipChange(change, raftId)
+}
+
+func (s *PublicRaftAPI) AddPeer(enodeId string, force *bool) (uint16, error) {
+	if err := s.checkIfNodeInCluster(); err != nil {
+		return 0, err
+	}
+	if err := s.checkMembershipChange(addPeerChange, 0, force); err != nil {
 		return 0, err
 	}
 	return s.raftService.raftProtocolManager.ProposeNewPeer(enodeId, false)
@@ -57,20 +86,52 @@ func (s *PublicRaftAPI) AddLearner(enodeId string) (uint16, error) {
 	return s.raftService.raftProtocolManager.ProposeNewPeer(enodeId, true)
 }
 
-func (s *PublicRaftAPI) PromoteToPeer(raftId uint16) (bool, error) {
+func (s *PublicRaftAPI) PromoteToPeer(raftId uint16, force *bool) (bool, error) {
 	if err := s.checkIfNodeInCluster(); err != nil {
 		return false, err
 	}
+	if err := s.checkMembershipChange(promoteToPeerChange, raftId, force); err != nil {
+		return false, err
+	}
 	return s.raftService.raftProtocolManager.PromoteToPeer(raftId)
 }
 
-func (s *PublicRaftAPI) RemovePeer(raftId uint16) error {
+func (s *PublicRaftAPI) RemovePeer(raftId uint16, force *bool) error {
 	if err := s.checkIfNodeInCluster(); err != nil {
 		return err
 	}
+	if err := s.checkMembershipChange(removePeerChange, raftId, force); err != nil {
+		return err
+	}
 	return s.raftService.raftProtocolManager.ProposePeerRemoval(raftId)
 }
 
+// TransferLeadership moves the leadership to the voter raftId and waits until it is elected.
+func (s *PublicRaftAPI) TransferLeadership(raftId uint16) error {
+	if err := s.checkIfNodeInCluster(); err != nil {
+		return err
+	}
+	return s.raftService.raftProtocolManager.TransferLeadership(raftId)
+}
+
+// Drain prepares the node for maintenance: it stops minting, moves the leadership to the most
+// up-to-date voter and waits until the committed entries are applied.
+func (s *PublicRaftAPI) Drain() error {
+	if err := s.checkIfNodeInCluster(); err != nil {
+		return err
+	}
+	return s.raftService.raftProtocolManager.Drain()
+}
+
+// Resume lets a drained node mint again.
+func (s *PublicRaftAPI) Resume() error {
+	if err := s.checkIfNodeInCluster(); err != nil {
+		return err
+	}
+	s.raftService.raftProtocolManager.Resume()
+	return nil
+}
+
 func (s *PublicRaftAPI) Leader() (string, error) {
 	addr, err := s.raftService.raftProtocolManager.LeaderAddress()
 	if err != nil {
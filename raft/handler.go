@@ -51,6 +51,7 @@ type ProtocolManager struct {
 	role          int    // Role: minter or verifier
 	appliedIndex  uint64 // The index of the last-applied raft entry
 	snapshotIndex uint64 // The index of the latest snapshot.
+	draining      bool   // Whether minting is suspended ahead of maintenance
 
 	// Remote peer state (protected by mu vs concurrent access via JS)
 	leader       uint16
@@ -685,7 +686,14 @@ func (pm *ProtocolManager) handleRoleChange(roleC <-chan interface{}) {
 			if !ok {
 				panic("Couldn't cast role to int")
 			}
-			if intRole == minterRole {
+			if intRole == minterRole && pm.isDraining() {
+				log.Warn("elected leader while draining, handing leadership over")
+				go func() {
+					if err := pm.handOffLeadership(); err != nil {
+						log.Error("failed to hand leadership over while draining", "err", err)
+					}
+				}()
+			} else if intRole == minterRole {
 				log.EmitCheckpoint(log.BecameMinter)
 				pm.minter.start()
 			} else { // verifier
@@ -0,0 +1,179 @@
+package raft
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	raftTypes "github.com/coreos/etcd/pkg/types"
+	etcdRaft "github.com/coreos/etcd/raft"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// How long to wait for a leadership transfer to complete. etcd raft aborts a transfer
+// after an election timeout (10 ticks), this leaves room for a couple of attempts.
+const leadershipTransferTimeout = 5 * time.Second
+
+// How long a drain waits for locally minted blocks to be applied.
+var drainTimeout = 30 * time.Second
+
+var (
+	errNoTransferee          = errors.New("no other voter available to take over leadership")
+	errLeadershipTransfer    = errors.New("timed out waiting for leadership transfer")
+	errDrainApplyTimeout     = errors.New("timed out waiting for committed entries to be applied")
+	errAlreadyLeader         = errors.New("node is already the leader")
+	errLearnerCannotBeMinter = errors.New("a learner can not become the leader")
+)
+
+// TransferLeadership hands the leadership over to the voter raftId and waits until it has been
+// elected. It can be called on any voter, followers forward the request to the current leader.
+func (pm *ProtocolManager) TransferLeadership(raftId uint16) error {
+	if pm.isLearnerNode() {
+		return errors.New("learner node can't transfer leadership")
+	}
+	if pm.isLearner(raftId) {
+		return errLearnerCannotBeMinter
+	}
+	if !pm.isVerifier(raftId) {
+		return fmt.Errorf("raft ID %d is not a voter of the cluster", raftId)
+	}
+	pm.mu.RLock()
+	leader := pm.leader
+	pm.mu.RUnlock()
+	if leader == uint16(etcdRaft.None) {
+		return errNoLeaderElected
+	}
+	if leader == raftId {
+		return errAlreadyLeader
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), leadershipTransferTimeout)
+	defer cancel()
+
+	log.Info("transferring raft leadership", "from", leader, "to", raftId)
+	pm.rawNode().TransferLeadership(ctx, uint64(leader), uint64(raftId))
+
+	return pm.waitFor(ctx, errLeadershipTransfer, func() bool {
+		pm.mu.RLock()
+		defer pm.mu.RUnlock()
+		return pm.leader == raftId
+	})
+}
+
+// Drain stops minting on the local node, hands the leadership over to the most up-to-date voter
+// if this node is the leader, and waits until every committed entry has been applied. The node
+// will not mint again, and gives the leadership away if it gets re-elected, until Resume is called.
+// If the drain fails the node resumes, so that the cluster keeps producing blocks.
+func (pm *ProtocolManager) Drain() (err error) {
+	if pm.isLearnerNode() {
+		return errors.New("learner node does not mint, nothing to drain")
+	}
+
+	pm.mu.Lock()
+	pm.draining = true
+	isMinter := pm.role == minterRole
+	pm.mu.Unlock()
+
+	defer func() {
+		if err != nil {
+			log.Warn("raft drain failed, resuming", "err", err)
+			pm.Resume()
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), drainTimeout)
+	defer cancel()
+
+	if isMinter {
+		// Let the blocks we already minted reach the chain so the new leader builds on them
+		// instead of dropping them along with our speculative chain.
+		head := pm.minter.pause()
+		log.Info("draining raft minter", "last minted", head.Number(), "hash", head.Hash())
+		if err := pm.waitFor(ctx, errDrainApplyTimeout, func() bool {
+			return pm.blockchain.HasBlock(head.Hash(), head.NumberU64())
+		}); err != nil {
+			return err
+		}
+		if err := pm.handOffLeadership(); err != nil {
+			return err
+		}
+	}
+
+	return pm.waitFor(ctx, errDrainApplyTimeout, func() bool {
+		commit := pm.rawNode().Status().Commit
+
+		pm.mu.RLock()
+		defer pm.mu.RUnlock()
+		return pm.appliedIndex >= commit
+	})
+}
+
+// Resume leaves drain mode. Minting restarts right away if this node is still the leader, or
+// once it is elected again.
+func (pm *ProtocolManager) Resume() {
+	pm.mu.Lock()
+	pm.draining = false
+	isMinter := pm.role == minterRole
+	pm.mu.Unlock()
+
+	if isMinter {
+		pm.minter.start()
+	}
+}
+
+func (pm *ProtocolManager) isDraining() bool {
+	pm.mu.RLock()
+	defer pm.mu.RUnlock()
+
+	return pm.draining
+}
+
+// handOffLeadership transfers the leadership of the local node to the voter whose log matches the
+// leader's the furthest, so that it can be elected without catching up first.
+func (pm *ProtocolManager) handOffLeadership() error {
+	transferee, ok := mostUpToDateVoter(pm.rawNode().Status(), pm.raftId, func(id uint64) bool {
+		return pm.isVerifier(uint16(id)) && !pm.transport.ActiveSince(raftTypes.ID(id)).IsZero()
+	})
+	if !ok {
+		return errNoTransferee
+	}
+	return pm.TransferLeadership(uint16(transferee))
+}
+
+// mostUpToDateVoter picks the voter other than self with the highest matched log index among
+// those accepted by eligible. Only the leader tracks progress, so this fails on followers.
+func mostUpToDateVoter(status etcdRaft.Status, self uint16, eligible func(uint64) bool) (uint64, bool) {
+	var (
+		best  uint64
+		match uint64
+		found bool
+	)
+	for id, pr := range status.Progress {
+		if id == uint64(self) || pr.IsLearner || !pr.RecentActive || !eligible(id) {
+			continue
+		}
+		if !found || pr.Match > match || (pr.Match == match && id < best) {
+			best, match, found = id, pr.Match, true
+		}
+	}
+	return best, found
+}
+
+// waitFor polls cond on every raft tick until it holds or ctx expires, in which case it returns
+// timeoutErr.
+func (pm *ProtocolManager) waitFor(ctx context.Context, timeoutErr error, cond func() bool) error {
+	ticker := time.NewTicker(tickerMS * time.Millisecond)
+	defer ticker.Stop()
+
+	for !cond() {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return timeoutErr
+		case <-pm.quitSync:
+			return errors.New("raft protocol manager stopped")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package raft
+
+import (
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	etcdRaft "github.com/coreos/etcd/raft"
+	"github.com/coreos/etcd/raft/raftpb"
+	"github.com/eapache/channels"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestMostUpToDateVoter(t *testing.T) {
+	status := etcdRaft.Status{
+		Progress: map[uint64]etcdRaft.Progress{
+			1: {Match: 100, RecentActive: true},
+			2: {Match: 90, RecentActive: true},
+			3: {Match: 98, RecentActive: true},
+			4: {Match: 99, RecentActive: false},
+			5: {Match: 100, RecentActive: true, IsLearner: true},
+		},
+	}
+	all := func(uint64) bool { return true }
+
+	if id, ok := mostUpToDateVoter(status, 1, all); !ok || id != 3 {
+		t.Errorf("transferee mismatch: have %d (%v), want 3", id, ok)
+	}
+	if id, ok := mostUpToDateVoter(status, 1, func(id uint64) bool { return id != 3 }); !ok || id != 2 {
+		t.Errorf("transferee mismatch: have %d (%v), want 2", id, ok)
+	}
+	if _, ok := mostUpToDateVoter(etcdRaft.Status{}, 1, all); ok {
+		t.Errorf("expected no transferee without progress")
+	}
+}
+
+func TestDrain_whenMintedBlocksNotApplied(t *testing.T) {
+	defer func(timeout time.Duration) { drainTimeout = timeout }(drainTimeout)
+	drainTimeout = 50 * time.Millisecond
+
+	db := rawdb.NewMemoryDatabase()
+	(&core.Genesis{Config: params.TestChainConfig}).MustCommit(db)
+	chain, err := core.NewBlockChain(db, nil, params.TestChainConfig, ethash.NewFaker(), vm.Config{}, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chain.Stop()
+
+	// the last minted block never makes it to the chain
+	speculative := newSpeculativeChain()
+	speculative.clear(types.NewBlockWithHeader(&types.Header{Number: big.NewInt(1)}))
+	pm := &ProtocolManager{
+		raftId:     1,
+		role:       minterRole,
+		confState:  raftpb.ConfState{Nodes: []uint64{1}},
+		blockchain: chain,
+		quitSync:   make(chan struct{}),
+		minter: &minter{
+			minting:          1,
+			speculativeChain: speculative,
+			shouldMine:       channels.NewRingChannel(1),
+		},
+	}
+
+	if err := pm.Drain(); err != errDrainApplyTimeout {
+		t.Fatalf("error mismatch: have %v, want %v", err, errDrainApplyTimeout)
+	}
+	if pm.isDraining() {
+		t.Errorf("node still draining after a failed drain")
+	}
+	if atomic.LoadInt32(&pm.minter.minting) != 1 {
+		t.Errorf("minting not resumed after a failed drain")
+	}
+}
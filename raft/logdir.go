@@ -0,0 +1,558 @@
+package raft
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/coreos/etcd/pkg/fileutil"
+	etcdRaft "github.com/coreos/etcd/raft"
+	"github.com/coreos/etcd/raft/raftpb"
+	"github.com/coreos/etcd/snap"
+	"github.com/coreos/etcd/wal"
+	"github.com/coreos/etcd/wal/walpb"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+// LogDir gives access to the raft state (WAL, snapshots and applied index) of a stopped node.
+// Every operation locks the files it touches, so they fail while the node is running.
+type LogDir struct {
+	waldir   string
+	snapdir  string
+	statedir string
+}
+
+// LogSummary describes the content of a raft log directory.
+type LogSummary struct {
+	Snapshot     *SnapshotSummary
+	HardState    raftpb.HardState
+	FirstIndex   uint64 // Index of the first entry in the WAL, 0 if there is none
+	LastIndex    uint64 // Index of the last entry in the WAL, 0 if there is none
+	AppliedIndex uint64 // Last index applied to the chain, from the quorum raft LevelDB
+	Entries      []EntrySummary
+}
+
+// SnapshotSummary describes the latest raft snapshot.
+type SnapshotSummary struct {
+	Index          uint64
+	Term           uint64
+	ConfState      raftpb.ConfState
+	Addresses      []Address
+	RemovedRaftIds []uint16
+	HeadBlockHash  common.Hash
+}
+
+// EntrySummary describes a single raft log entry.
+type EntrySummary struct {
+	Index       uint64
+	Term        uint64
+	Type        string // "block", "confChange" or "empty"
+	BlockNumber uint64
+	BlockHash   common.Hash
+	ConfChange  *raftpb.ConfChange
+}
+
+// NewLogDir returns the raft state kept in dir, laid out like a running node does.
+func NewLogDir(dir string) *LogDir {
+	return &LogDir{
+		waldir:   filepath.Join(dir, "raft-wal"),
+		snapdir:  filepath.Join(dir, "raft-snap"),
+		statedir: filepath.Join(dir, "quorum-raft-state"),
+	}
+}
+
+// Inspect reads the latest snapshot, the WAL entries following it and the applied index.
+func (d *LogDir) Inspect() (*LogSummary, error) {
+	unlock, err := d.lockWAL()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	return d.inspect()
+}
+
+func (d *LogDir) inspect() (*LogSummary, error) {
+	snapshot, err := d.loadSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	w, err := wal.OpenForRead(d.waldir, walSnapshot(snapshot))
+	if err != nil {
+		return nil, err
+	}
+	defer w.Close()
+	_, hardState, entries, err := w.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	applied, err := d.appliedIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &LogSummary{HardState: hardState, AppliedIndex: applied}
+	if snapshot != nil {
+		if summary.Snapshot, err = summarizeSnapshot(snapshot); err != nil {
+			return nil, err
+		}
+	}
+	if len(entries) > 0 {
+		summary.FirstIndex, summary.LastIndex = entries[0].Index, entries[len(entries)-1].Index
+	}
+	for _, entry := range entries {
+		s, err := summarizeEntry(entry)
+		if err != nil {
+			return nil, err
+		}
+		summary.Entries = append(summary.Entries, s)
+	}
+	return summary, nil
+}
+
+// Verify checks the snapshot and WAL for corruption and consistency, and returns every problem
+// found. An empty result means the node can be restarted from this directory.
+func (d *LogDir) Verify() []error {
+	var problems []error
+	unlock, err := d.lockWAL()
+	if err != nil {
+		return append(problems, err)
+	}
+	defer unlock()
+
+	snapshot, err := d.loadSnapshot()
+	if err != nil {
+		return append(problems, err)
+	}
+	if snapshot != nil {
+		if _, err := decodeSnapshot(snapshot.Data); err != nil {
+			problems = append(problems, fmt.Errorf("snapshot %d: %v", snapshot.Metadata.Index, err))
+		}
+	}
+	if err := wal.Verify(d.waldir, walSnapshot(snapshot)); err != nil {
+		return append(problems, fmt.Errorf("WAL: %v", err))
+	}
+
+	summary, err := d.inspect()
+	if err != nil {
+		return append(problems, err)
+	}
+	var snapshotIndex uint64
+	if snapshot != nil {
+		snapshotIndex = snapshot.Metadata.Index
+	}
+	if err := checkContiguous(snapshotIndex, summary.Entries); err != nil {
+		problems = append(problems, err)
+	}
+	if last := maxIndex(snapshotIndex, summary.LastIndex); summary.HardState.Commit > last {
+		problems = append(problems, fmt.Errorf("commit index %d is beyond the last entry %d", summary.HardState.Commit, last))
+	}
+	if summary.AppliedIndex > summary.HardState.Commit {
+		problems = append(problems, fmt.Errorf("applied index %d is beyond the commit index %d, it will be rolled back on restart", summary.AppliedIndex, summary.HardState.Commit))
+	}
+	if summary.AppliedIndex < snapshotIndex {
+		problems = append(problems, fmt.Errorf("applied index %d is behind the snapshot %d, it will be fast forwarded on restart", summary.AppliedIndex, snapshotIndex))
+	}
+	return problems
+}
+
+// Compact takes a snapshot at the applied index and discards the WAL segments and all but the
+// newest keep snapshots that precede it. It returns the index of the latest snapshot.
+func (d *LogDir) Compact(keep int) (uint64, error) {
+	if keep < 1 {
+		return 0, errors.New("at least one snapshot must be kept")
+	}
+	snapshot, err := d.loadSnapshot()
+	if err != nil {
+		return 0, err
+	}
+	w, err := wal.Open(d.waldir, walSnapshot(snapshot))
+	if err != nil {
+		return 0, err
+	}
+	defer w.Close()
+	_, hardState, entries, err := w.ReadAll()
+	if err != nil {
+		return 0, err
+	}
+	applied, err := d.appliedIndex()
+	if err != nil {
+		return 0, err
+	}
+
+	storage := etcdRaft.NewMemoryStorage()
+	var snapshotIndex uint64
+	if snapshot != nil {
+		if err := storage.ApplySnapshot(*snapshot); err != nil {
+			return 0, err
+		}
+		snapshotIndex = snapshot.Metadata.Index
+	}
+	// Entries past the applied index have not reached the chain yet, the node replays them
+	// from the WAL on restart so they must stay there.
+	index := applied
+	if hardState.Commit < index {
+		index = hardState.Commit
+	}
+	if index <= snapshotIndex {
+		return snapshotIndex, nil
+	}
+	summaries := make([]EntrySummary, len(entries))
+	for i, entry := range entries {
+		if summaries[i], err = summarizeEntry(entry); err != nil {
+			return 0, err
+		}
+	}
+	if err := checkContiguous(snapshotIndex, summaries); err != nil {
+		return 0, err
+	}
+	if err := storage.Append(entries); err != nil {
+		return 0, err
+	}
+
+	confState, membership, err := replayMembership(snapshot, summaries, index)
+	if err != nil {
+		return 0, err
+	}
+	newSnapshot, err := storage.CreateSnapshot(index, &confState, membership.toBytes())
+	if err != nil {
+		return 0, err
+	}
+	if err := os.MkdirAll(d.snapdir, 0750); err != nil {
+		return 0, err
+	}
+	if err := snap.New(d.snapdir).SaveSnap(newSnapshot); err != nil {
+		return 0, err
+	}
+	if err := w.SaveSnapshot(walpb.Snapshot{Index: index, Term: newSnapshot.Metadata.Term}); err != nil {
+		return 0, err
+	}
+	if err := w.ReleaseLockTo(index); err != nil {
+		return 0, err
+	}
+	if err := purgeWAL(d.waldir, index); err != nil {
+		return 0, err
+	}
+	return index, purgeSnapshots(d.snapdir, keep)
+}
+
+// Rebuild replaces the raft state of the node raftId with a snapshot file copied from a peer, so
+// that a node with a corrupted WAL can rejoin under its own raft ID and catch up from the leader.
+// The previous WAL and snapshots are moved aside, the returned suffix names the backup.
+func (d *LogDir) Rebuild(raftId uint16, snapshotFile string) (string, error) {
+	snapshot, err := snap.Read(snapshotFile)
+	if err != nil {
+		return "", err
+	}
+	membership, err := decodeSnapshot(snapshot.Data)
+	if err != nil {
+		return "", err
+	}
+	for _, removed := range membership.RemovedRaftIds {
+		if removed == raftId {
+			return "", fmt.Errorf("raft ID %d has been removed from the cluster", raftId)
+		}
+	}
+	if !confStateIdSet(snapshot.Metadata.ConfState).Contains(raftId) {
+		return "", fmt.Errorf("raft ID %d is not a member of the cluster in snapshot %d", raftId, snapshot.Metadata.Index)
+	}
+
+	db, err := openQuorumRaftDb(d.statedir)
+	if err != nil {
+		return "", err
+	}
+	defer db.Close()
+
+	suffix := ".bak-" + time.Now().Format("20060102150405")
+	for _, dir := range []string{d.waldir, d.snapdir} {
+		if fileutil.Exist(dir) {
+			if err := os.Rename(dir, dir+suffix); err != nil {
+				return "", err
+			}
+		}
+	}
+	if err := os.MkdirAll(d.snapdir, 0750); err != nil {
+		return "", err
+	}
+	if err := snap.New(d.snapdir).SaveSnap(*snapshot); err != nil {
+		return "", err
+	}
+	w, err := wal.Create(d.waldir, nil)
+	if err != nil {
+		return "", err
+	}
+	defer w.Close()
+	meta := snapshot.Metadata
+	if err := w.SaveSnapshot(walpb.Snapshot{Index: meta.Index, Term: meta.Term}); err != nil {
+		return "", err
+	}
+	if err := w.Save(raftpb.HardState{Term: meta.Term, Commit: meta.Index}, nil); err != nil {
+		return "", err
+	}
+	// The applied index may not exceed the commit index of the new log, the chain itself is
+	// ahead already and blocks it holds are skipped when the leader replays them.
+	return suffix, putAppliedIndex(db, meta.Index, &opt.WriteOptions{Sync: true})
+}
+
+func (d *LogDir) loadSnapshot() (*raftpb.Snapshot, error) {
+	if !fileutil.Exist(d.snapdir) {
+		return nil, nil
+	}
+	snapshot, err := snap.New(d.snapdir).Load()
+	if err == snap.ErrNoSnapshot {
+		return nil, nil
+	}
+	return snapshot, err
+}
+
+// lockWAL locks every WAL file the way a running node does, without opening the WAL for
+// writing, so that read only operations fail instead of racing with the node. The returned
+// function releases the locks.
+func (d *LogDir) lockWAL() (func(), error) {
+	var locks []*fileutil.LockedFile
+	unlock := func() {
+		for _, l := range locks {
+			l.Close()
+		}
+	}
+	names, err := fileutil.ReadDir(d.waldir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	for _, name := range names {
+		if !strings.HasSuffix(name, ".wal") {
+			continue
+		}
+		l, err := fileutil.TryLockFile(filepath.Join(d.waldir, name), os.O_RDWR, fileutil.PrivateFileMode)
+		if err != nil {
+			unlock()
+			if err == fileutil.ErrLocked {
+				return nil, fmt.Errorf("WAL file %s is locked, the node must be stopped", name)
+			}
+			return nil, err
+		}
+		locks = append(locks, l)
+	}
+	return unlock, nil
+}
+
+func (d *LogDir) appliedIndex() (uint64, error) {
+	if !fileutil.Exist(d.statedir) {
+		return 0, nil
+	}
+	db, err := openQuorumRaftDb(d.statedir)
+	if err != nil {
+		return 0, err
+	}
+	defer db.Close()
+	return getAppliedIndex(db)
+}
+
+func walSnapshot(snapshot *raftpb.Snapshot) walpb.Snapshot {
+	if snapshot == nil {
+		return walpb.Snapshot{}
+	}
+	return walpb.Snapshot{Index: snapshot.Metadata.Index, Term: snapshot.Metadata.Term}
+}
+
+func summarizeSnapshot(snapshot *raftpb.Snapshot) (*SnapshotSummary, error) {
+	membership, err := decodeSnapshot(snapshot.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &SnapshotSummary{
+		Index:          snapshot.Metadata.Index,
+		Term:           snapshot.Metadata.Term,
+		ConfState:      snapshot.Metadata.ConfState,
+		Addresses:      membership.Addresses,
+		RemovedRaftIds: membership.RemovedRaftIds,
+		HeadBlockHash:  membership.HeadBlockHash,
+	}, nil
+}
+
+func summarizeEntry(entry raftpb.Entry) (EntrySummary, error) {
+	summary := EntrySummary{Index: entry.Index, Term: entry.Term, Type: "empty"}
+	switch {
+	case entry.Type == raftpb.EntryConfChange:
+		var cc raftpb.ConfChange
+		if err := cc.Unmarshal(entry.Data); err != nil {
+			return summary, fmt.Errorf("entry %d: %v", entry.Index, err)
+		}
+		summary.Type, summary.ConfChange = "confChange", &cc
+	case len(entry.Data) > 0:
+		var block types.Block
+		if err := rlp.DecodeBytes(entry.Data, &block); err != nil {
+			return summary, fmt.Errorf("entry %d: %v", entry.Index, err)
+		}
+		summary.Type, summary.BlockNumber, summary.BlockHash = "block", block.NumberU64(), block.Hash()
+	}
+	return summary, nil
+}
+
+func checkContiguous(snapshotIndex uint64, entries []EntrySummary) error {
+	next := snapshotIndex + 1
+	for _, entry := range entries {
+		if entry.Index != next {
+			return fmt.Errorf("WAL entry %d found where %d was expected", entry.Index, next)
+		}
+		next++
+	}
+	return nil
+}
+
+// replayMembership applies the conf changes of entries up to index on top of snapshot, the way the
+// protocol manager does, and returns the resulting configuration and snapshot payload.
+func replayMembership(snapshot *raftpb.Snapshot, entries []EntrySummary, index uint64) (raftpb.ConfState, *SnapshotWithHostnames, error) {
+	var (
+		confState  raftpb.ConfState
+		membership = new(SnapshotWithHostnames)
+		err        error
+	)
+	if snapshot != nil {
+		confState = snapshot.Metadata.ConfState
+		if membership, err = decodeSnapshot(snapshot.Data); err != nil {
+			return confState, nil, err
+		}
+	}
+	for _, entry := range entries {
+		if entry.Index > index {
+			break
+		}
+		switch entry.Type {
+		case "block":
+			membership.HeadBlockHash = entry.BlockHash
+		case "confChange":
+			applyConfChange(&confState, membership, *entry.ConfChange)
+		}
+	}
+	sort.Sort(ByRaftId(membership.Addresses))
+	return confState, membership, nil
+}
+
+func applyConfChange(confState *raftpb.ConfState, membership *SnapshotWithHostnames, cc raftpb.ConfChange) {
+	raftId := uint16(cc.NodeID)
+	for _, removed := range membership.RemovedRaftIds {
+		if removed == raftId {
+			return
+		}
+	}
+	switch cc.Type {
+	case raftpb.ConfChangeAddNode:
+		confState.Learners = removeId(confState.Learners, cc.NodeID)
+		confState.Nodes = append(removeId(confState.Nodes, cc.NodeID), cc.NodeID)
+	case raftpb.ConfChangeAddLearnerNode:
+		if containsId(confState.Nodes, cc.NodeID) {
+			return
+		}
+		confState.Learners = append(removeId(confState.Learners, cc.NodeID), cc.NodeID)
+	case raftpb.ConfChangeRemoveNode:
+		confState.Nodes = removeId(confState.Nodes, cc.NodeID)
+		confState.Learners = removeId(confState.Learners, cc.NodeID)
+		membership.RemovedRaftIds = append(membership.RemovedRaftIds, raftId)
+		for i, address := range membership.Addresses {
+			if address.RaftId == raftId {
+				membership.Addresses = append(membership.Addresses[:i], membership.Addresses[i+1:]...)
+				break
+			}
+		}
+		return
+	}
+	if len(cc.Context) == 0 {
+		return
+	}
+	for _, address := range membership.Addresses {
+		if address.RaftId == raftId {
+			return
+		}
+	}
+	membership.Addresses = append(membership.Addresses, *bytesToAddress(cc.Context))
+}
+
+func containsId(ids []uint64, id uint64) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
+func removeId(ids []uint64, id uint64) []uint64 {
+	kept := ids[:0:0]
+	for _, i := range ids {
+		if i != id {
+			kept = append(kept, i)
+		}
+	}
+	return kept
+}
+
+// purgeWAL removes the WAL segments that only hold entries up to index. The segment a node opens
+// to replay the log after a snapshot at index is the last one starting at or before it.
+func purgeWAL(waldir string, index uint64) error {
+	names, err := listFiles(waldir, ".wal")
+	if err != nil {
+		return err
+	}
+	keepFrom := 0
+	for i, name := range names {
+		var seq, start uint64
+		if _, err := fmt.Sscanf(name, "%016x-%016x.wal", &seq, &start); err != nil {
+			return fmt.Errorf("unexpected WAL file %s: %v", name, err)
+		}
+		if start <= index {
+			keepFrom = i
+		}
+	}
+	for _, name := range names[:keepFrom] {
+		if err := os.Remove(filepath.Join(waldir, name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// purgeSnapshots removes all but the newest keep snapshots. Snapshot files are named after their
+// term and index, so lexical order is chronological.
+func purgeSnapshots(snapdir string, keep int) error {
+	names, err := listFiles(snapdir, ".snap")
+	if err != nil {
+		return err
+	}
+	for len(names) > keep {
+		if err := os.Remove(filepath.Join(snapdir, names[0])); err != nil {
+			return err
+		}
+		names = names[1:]
+	}
+	return nil
+}
+
+func listFiles(dir string, suffix string) ([]string, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, file := range files {
+		if !file.IsDir() && strings.HasSuffix(file.Name(), suffix) {
+			names = append(names, file.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func maxIndex(a, b uint64) uint64 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,177 @@
+package raft
+
+import (
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/coreos/etcd/raft/raftpb"
+	"github.com/coreos/etcd/wal"
+	"github.com/coreos/etcd/wal/walpb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+func confChangeEntry(t *testing.T, index uint64, cc raftpb.ConfChange) raftpb.Entry {
+	data, err := cc.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raftpb.Entry{Term: 1, Index: index, Type: raftpb.EntryConfChange, Data: data}
+}
+
+func blockEntry(t *testing.T, index uint64, block *types.Block) raftpb.Entry {
+	data, err := rlp.EncodeToBytes(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raftpb.Entry{Term: 1, Index: index, Type: raftpb.EntryNormal, Data: data}
+}
+
+// newTestLogDir writes the raft state of a two node cluster that minted three blocks and then
+// removed its second node, with every entry but the removal applied.
+func newTestLogDir(t *testing.T, dir string) *types.Block {
+	var entries []raftpb.Entry
+	for i := uint64(1); i <= 2; i++ {
+		node := enode.NewV4(&mustNewNodeKey(t).PublicKey, net.IPv4(127, 0, 0, 1), 21000, 21000)
+		entries = append(entries, confChangeEntry(t, i, raftpb.ConfChange{
+			Type:    raftpb.ConfChangeAddNode,
+			NodeID:  i,
+			Context: newAddress(uint16(i), 50400, node, false).toBytes(),
+		}))
+	}
+	var head *types.Block
+	for i := int64(1); i <= 3; i++ {
+		head = types.NewBlockWithHeader(&types.Header{Number: big.NewInt(i)})
+		entries = append(entries, blockEntry(t, uint64(i)+2, head))
+	}
+	entries = append(entries, confChangeEntry(t, 6, raftpb.ConfChange{Type: raftpb.ConfChangeRemoveNode, NodeID: 2}))
+
+	w, err := wal.Create(filepath.Join(dir, "raft-wal"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Save(raftpb.HardState{Term: 1, Commit: 6}, entries); err != nil {
+		t.Fatal(err)
+	}
+	w.Close()
+
+	db, err := openQuorumRaftDb(filepath.Join(dir, "quorum-raft-state"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if err := putAppliedIndex(db, 5, noFsync); err != nil {
+		t.Fatal(err)
+	}
+	return head
+}
+
+func TestLogDir_Compact(t *testing.T) {
+	dir, err := ioutil.TempDir("", "raftlog")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	head := newTestLogDir(t, dir)
+	logDir := NewLogDir(dir)
+
+	index, err := logDir.Compact(1)
+	if err != nil {
+		t.Fatalf("failed to compact: %v", err)
+	}
+	if index != 5 {
+		t.Errorf("snapshot index mismatch: have %d, want 5", index)
+	}
+	summary, err := logDir.Inspect()
+	if err != nil {
+		t.Fatalf("failed to inspect: %v", err)
+	}
+	if snapshot := summary.Snapshot; snapshot == nil || snapshot.Index != 5 || snapshot.HeadBlockHash != head.Hash() ||
+		len(snapshot.Addresses) != 2 || len(snapshot.ConfState.Nodes) != 2 {
+		t.Errorf("unexpected snapshot: %+v", snapshot)
+	}
+	if len(summary.Entries) != 1 || summary.Entries[0].ConfChange == nil || summary.Entries[0].ConfChange.Type != raftpb.ConfChangeRemoveNode {
+		t.Errorf("unexpected entries after compaction: %+v", summary.Entries)
+	}
+	if problems := logDir.Verify(); len(problems) != 0 {
+		t.Errorf("unexpected problems: %v", problems)
+	}
+}
+
+func TestLogDir_Rebuild(t *testing.T) {
+	peerDir, err := ioutil.TempDir("", "raftlog")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(peerDir)
+	newTestLogDir(t, peerDir)
+	if _, err := NewLogDir(peerDir).Compact(1); err != nil {
+		t.Fatalf("failed to compact: %v", err)
+	}
+	snapshots, err := listFiles(filepath.Join(peerDir, "raft-snap"), ".snap")
+	if err != nil || len(snapshots) != 1 {
+		t.Fatalf("expected a single snapshot, have %v (%v)", snapshots, err)
+	}
+	snapshotFile := filepath.Join(peerDir, "raft-snap", snapshots[0])
+
+	dir, err := ioutil.TempDir("", "raftlog")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	newTestLogDir(t, dir)
+	logDir := NewLogDir(dir)
+
+	if _, err := logDir.Rebuild(3, snapshotFile); err == nil {
+		t.Errorf("expected rebuild of an unknown raft ID to fail")
+	}
+	suffix, err := logDir.Rebuild(1, snapshotFile)
+	if err != nil {
+		t.Fatalf("failed to rebuild: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "raft-wal"+suffix)); err != nil {
+		t.Errorf("previous WAL not backed up: %v", err)
+	}
+	summary, err := logDir.Inspect()
+	if err != nil {
+		t.Fatalf("failed to inspect: %v", err)
+	}
+	if summary.Snapshot == nil || summary.Snapshot.Index != 5 || summary.HardState.Commit != 5 || summary.AppliedIndex != 5 || len(summary.Entries) != 0 {
+		t.Errorf("unexpected rebuilt state: %+v", summary)
+	}
+	if problems := logDir.Verify(); len(problems) != 0 {
+		t.Errorf("unexpected problems: %v", problems)
+	}
+}
+
+func TestLogDir_Inspect_whenNodeRunning(t *testing.T) {
+	dir, err := ioutil.TempDir("", "raftlog")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	newTestLogDir(t, dir)
+	logDir := NewLogDir(dir)
+
+	// a running node keeps its WAL open for writing
+	w, err := wal.Open(filepath.Join(dir, "raft-wal"), walpb.Snapshot{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := logDir.Inspect(); err == nil {
+		t.Errorf("expected inspect to fail while the WAL is locked")
+	}
+	if problems := logDir.Verify(); len(problems) == 0 {
+		t.Errorf("expected verify to fail while the WAL is locked")
+	}
+	w.Close()
+
+	if _, err := logDir.Inspect(); err != nil {
+		t.Errorf("failed to inspect once the WAL is released: %v", err)
+	}
+}
@@ -0,0 +1,139 @@
+package raft
+
+import (
+	"fmt"
+
+	raftTypes "github.com/coreos/etcd/pkg/types"
+	etcdRaft "github.com/coreos/etcd/raft"
+)
+
+// Membership changes understood by the planner, named after the RPC methods applying them.
+const (
+	addPeerChange       = "addPeer"
+	addLearnerChange    = "addLearner"
+	promoteToPeerChange = "promoteToPeer"
+	removePeerChange    = "removePeer"
+)
+
+// Risk levels reported for a membership change.
+const (
+	riskNone             = "none"             // quorum survives the loss of at least one more voter
+	riskNoFaultTolerance = "noFaultTolerance" // quorum holds but any further voter failure stalls the cluster
+	riskQuorumLoss       = "quorumLoss"       // the reachable voters can not form a quorum once the change applies
+)
+
+// MembershipPlan describes the cluster as it would be after a membership change.
+type MembershipPlan struct {
+	Change          string `json:"change"`
+	RaftId          uint16 `json:"raftId"`
+	Voters          int    `json:"voters"`
+	Learners        int    `json:"learners"`
+	Quorum          int    `json:"quorum"`
+	ReachableVoters int    `json:"reachableVoters"`
+	Risk            string `json:"risk"`
+	Safe            bool   `json:"safe"`
+	Reason          string `json:"reason,omitempty"`
+}
+
+// memberState is the role and liveness of a cluster member as seen by this node.
+type memberState struct {
+	learner   bool
+	reachable bool
+}
+
+// planMembership computes the outcome of applying change to raftId on a cluster made of members.
+// A voter being added is never counted as reachable since it still has to catch up with the log.
+func planMembership(change string, raftId uint16, members map[uint16]memberState) (*MembershipPlan, error) {
+	next := make(map[uint16]memberState, len(members)+1)
+	for id, m := range members {
+		next[id] = m
+	}
+
+	member, exists := members[raftId]
+	switch change {
+	case addPeerChange, addLearnerChange:
+		if exists {
+			return nil, fmt.Errorf("raft ID %d is already a member of the cluster", raftId)
+		}
+		next[raftId] = memberState{learner: change == addLearnerChange}
+	case promoteToPeerChange:
+		if !exists || !member.learner {
+			return nil, fmt.Errorf("%d is not a learner. only learner can be promoted to peer", raftId)
+		}
+		next[raftId] = memberState{reachable: member.reachable}
+	case removePeerChange:
+		if !exists {
+			return nil, fmt.Errorf("raft ID %d is not a member of the cluster", raftId)
+		}
+		delete(next, raftId)
+	default:
+		return nil, fmt.Errorf("unknown membership change %q", change)
+	}
+
+	plan := &MembershipPlan{Change: change, RaftId: raftId}
+	for _, m := range next {
+		if m.learner {
+			plan.Learners++
+			continue
+		}
+		plan.Voters++
+		if m.reachable {
+			plan.ReachableVoters++
+		}
+	}
+	plan.Quorum = plan.Voters/2 + 1
+
+	switch {
+	case plan.Voters == 0:
+		plan.Risk = riskQuorumLoss
+		plan.Reason = "no voter would be left in the cluster"
+	case plan.ReachableVoters < plan.Quorum:
+		plan.Risk = riskQuorumLoss
+		plan.Reason = fmt.Sprintf("only %d of %d voters would be reachable, quorum is %d", plan.ReachableVoters, plan.Voters, plan.Quorum)
+	case plan.ReachableVoters == plan.Quorum:
+		plan.Risk = riskNoFaultTolerance
+		plan.Reason = fmt.Sprintf("losing any of the %d reachable voters would stall the cluster", plan.ReachableVoters)
+	default:
+		plan.Risk = riskNone
+	}
+	plan.Safe = plan.Risk != riskQuorumLoss
+	return plan, nil
+}
+
+// PlanMembershipChange reports the effect of a membership change on the cluster quorum without
+// proposing it. raftId is ignored when adding a node, the plan uses the ID the node would receive.
+func (pm *ProtocolManager) PlanMembershipChange(change string, raftId uint16) (*MembershipPlan, error) {
+	if change == addPeerChange || change == addLearnerChange {
+		raftId = pm.nextRaftId()
+	}
+	return planMembership(change, raftId, pm.membershipState())
+}
+
+// membershipState returns every voter and learner of the current configuration. Liveness comes
+// from the leader's progress tracking when this node is the leader, and from the raft transport
+// otherwise.
+func (pm *ProtocolManager) membershipState() map[uint16]memberState {
+	status := pm.rawNode().Status()
+
+	pm.mu.RLock()
+	defer pm.mu.RUnlock()
+
+	members := make(map[uint16]memberState, len(pm.confState.Nodes)+len(pm.confState.Learners))
+	for _, id := range pm.confState.Nodes {
+		members[uint16(id)] = memberState{reachable: pm.isReachable(status, id)}
+	}
+	for _, id := range pm.confState.Learners {
+		members[uint16(id)] = memberState{learner: true, reachable: pm.isReachable(status, id)}
+	}
+	return members
+}
+
+func (pm *ProtocolManager) isReachable(status etcdRaft.Status, id uint64) bool {
+	if id == uint64(pm.raftId) {
+		return true
+	}
+	if pr, ok := status.Progress[id]; ok && !pr.RecentActive {
+		return false
+	}
+	return !pm.transport.ActiveSince(raftTypes.ID(id)).IsZero()
+}
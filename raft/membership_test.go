@@ -0,0 +1,70 @@
+package raft
+
+import (
+	"testing"
+)
+
+func TestPlanMembership(t *testing.T) {
+	threeUp := map[uint16]memberState{
+		1: {reachable: true},
+		2: {reachable: true},
+		3: {reachable: true},
+	}
+	oneDown := map[uint16]memberState{
+		1: {reachable: true},
+		2: {reachable: true},
+		3: {},
+		4: {learner: true, reachable: true},
+	}
+	tests := []struct {
+		name     string
+		change   string
+		raftId   uint16
+		members  map[uint16]memberState
+		voters   int
+		learners int
+		risk     string
+	}{
+		{"add learner", addLearnerChange, 4, threeUp, 3, 1, riskNone},
+		{"add peer", addPeerChange, 4, threeUp, 4, 0, riskNoFaultTolerance},
+		{"remove healthy peer", removePeerChange, 3, threeUp, 2, 0, riskNoFaultTolerance},
+		{"remove down peer", removePeerChange, 3, oneDown, 2, 1, riskNoFaultTolerance},
+		{"remove reachable peer", removePeerChange, 2, oneDown, 2, 1, riskQuorumLoss},
+		{"add peer while one is down", addPeerChange, 5, oneDown, 4, 1, riskQuorumLoss},
+		{"promote reachable learner", promoteToPeerChange, 4, oneDown, 4, 0, riskNoFaultTolerance},
+		{"remove last voter", removePeerChange, 1, map[uint16]memberState{1: {reachable: true}}, 0, 0, riskQuorumLoss},
+	}
+	for _, tt := range tests {
+		plan, err := planMembership(tt.change, tt.raftId, tt.members)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		if plan.Voters != tt.voters || plan.Learners != tt.learners || plan.Risk != tt.risk {
+			t.Errorf("%s: have voters=%d learners=%d risk=%s, want voters=%d learners=%d risk=%s",
+				tt.name, plan.Voters, plan.Learners, plan.Risk, tt.voters, tt.learners, tt.risk)
+		}
+		if plan.Safe != (tt.risk != riskQuorumLoss) {
+			t.Errorf("%s: safe mismatch for risk %s", tt.name, plan.Risk)
+		}
+	}
+}
+
+func TestPlanMembership_whenInvalid(t *testing.T) {
+	members := map[uint16]memberState{
+		1: {reachable: true},
+		2: {learner: true},
+	}
+	for _, c := range []struct {
+		change string
+		raftId uint16
+	}{
+		{addPeerChange, 1},
+		{promoteToPeerChange, 1},
+		{removePeerChange, 3},
+		{"demote", 1},
+	} {
+		if _, err := planMembership(c.change, c.raftId, members); err == nil {
+			t.Errorf("%s %d: expected error", c.change, c.raftId)
+		}
+	}
+}
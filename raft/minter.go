@@ -118,6 +118,16 @@ func (minter *minter) stop() {
 	atomic.StoreInt32(&minter.minting, 0)
 }
 
+// pause stops minting without discarding the speculative chain, and returns its head: the last
+// block minted locally, which may not have been applied yet.
+func (minter *minter) pause() *types.Block {
+	minter.mu.Lock()
+	defer minter.mu.Unlock()
+
+	atomic.StoreInt32(&minter.minting, 0)
+	return minter.speculativeChain.head
+}
+
 // Notify the minting loop that minting should occur, if it's not already been
 // requested. Due to the use of a RingChannel, this function is idempotent if
 // called multiple times before the minting occurs.
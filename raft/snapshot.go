@@ -250,12 +250,20 @@ func (snapshot *SnapshotWithHostnames) toBytes() []byte {
 }
 
 func bytesToSnapshot(input []byte) *SnapshotWithHostnames {
+	snapshot, err := decodeSnapshot(input)
+	if err != nil {
+		fatalf("%v", err)
+	}
+	return snapshot
+}
+
+func decodeSnapshot(input []byte) (*SnapshotWithHostnames, error) {
 	var err, errOld error
 
 	snapshot := new(SnapshotWithHostnames)
 	streamNewSnapshot := rlp.NewStream(bytes.NewReader(input), 0)
 	if err = streamNewSnapshot.Decode(snapshot); err == nil {
-		return snapshot
+		return snapshot, nil
 	}
 
 	// Build new snapshot with hostname from legacy Address struct
@@ -277,11 +285,10 @@ func bytesToSnapshot(input []byte) *SnapshotWithHostnames {
 			}
 		}
 
-		return &snapshotConverted
+		return &snapshotConverted, nil
 	}
 
-	fatalf("failed to RLP-decode Snapshot: %v, %v", err, errOld)
-	return nil
+	return nil, fmt.Errorf("failed to RLP-decode Snapshot: %v, %v", err, errOld)
 }
 
 func (snapshot *SnapshotWithHostnames) EncodeRLP(w io.Writer) error {
@@ -0,0 +1,201 @@
+// Quorum
+package rpc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+const defaultAuditLogMaxSize = 100 // megabytes
+
+// DefaultAuditedMethods are the state-changing methods being audited
+// unless AuditLogConfig.Methods is set
+var DefaultAuditedMethods = []string{
+	"eth_send*",
+	"eth_distributePrivateTransaction",
+	"personal_send*",
+	"personal_signAndSendTransaction",
+	"personal_newAccount",
+	"personal_importRawKey",
+	"personal_deriveAccount",
+	"personal_openWallet",
+	"personal_initializeWallet",
+	"personal_unpair",
+	"personal_unlockAccount",
+	"personal_lockAccount",
+	"admin_add*",
+	"admin_remove*",
+	"admin_start*",
+	"admin_stop*",
+	"admin_importChain",
+	"admin_reloadPlugin",
+	"debug_setHead",
+	"miner_*",
+	"raft_add*",
+	"raft_remove*",
+	"raft_promoteToPeer",
+	"raft_transferLeadership",
+	"raft_drain",
+	"raft_resume",
+	"istanbul_propose",
+	"istanbul_discard",
+	"clique_propose",
+	"clique_discard",
+	"quorumPermission_add*",
+	"quorumPermission_approve*",
+	"quorumPermission_assign*",
+	"quorumPermission_update*",
+	"quorumPermission_recover*",
+	"quorumPermission_transaction*",
+	"quorumPermission_remove*",
+	"quorumPermission_changeAccountRole",
+	"quorumPermission_proposeAction",
+	"quorumPermission_voteAction",
+	"quorumPermission_cancelAction",
+	"quorumPermission_setAccessRule",
+	"quorumExtension_extendContract",
+	"quorumExtension_extendContractToRecipients",
+	"quorumExtension_approveExtension",
+	"quorumExtension_cancelExtension",
+	"quorumExtension_removeParticipants",
+}
+
+var txHashPattern = regexp.MustCompile(`^"0x[0-9a-fA-F]{64}"$`)
+
+// AuditLogConfig configures the audit log of RPC calls
+type AuditLogConfig struct {
+	File       string   // path of the audit log, one JSON record per line
+	MaxSize    int      `toml:",omitempty"` // size in megabytes before the file is rotated, defaults to 100
+	MaxBackups int      `toml:",omitempty"` // number of rotated files kept, 0 keeps all
+	MaxAge     int      `toml:",omitempty"` // days rotated files are kept, 0 keeps them forever
+	Compress   bool     `toml:",omitempty"` // compress rotated files
+	Methods    []string `toml:",omitempty"` // methods or patterns being audited, defaults to DefaultAuditedMethods
+}
+
+// AuditRecord is written for each audited call. It never contains the call payload
+type AuditRecord struct {
+	Time       time.Time `json:"time"`
+	Remote     string    `json:"remote,omitempty"`    // remote address of the connection, empty for IPC
+	Principal  string    `json:"principal,omitempty"` // authenticated principal, empty if authentication is not enabled
+	PSI        string    `json:"psi,omitempty"`
+	Method     string    `json:"method"`
+	TxHash     string    `json:"txHash,omitempty"`
+	PrivateFor []string  `json:"privateFor,omitempty"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// AuditLog writes AuditRecords of state-changing calls as JSON lines. It is safe for
+// concurrent use and is meant to be shared by all RPC servers of a node
+type AuditLog struct {
+	methods []string
+	mu      sync.Mutex
+	out     io.WriteCloser
+}
+
+// NewAuditLog opens the audit log file which is rotated as per the config
+func NewAuditLog(config AuditLogConfig) (*AuditLog, error) {
+	if config.File == "" {
+		return nil, errors.New("audit log: File must be configured")
+	}
+	methods := config.Methods
+	if len(methods) == 0 {
+		methods = DefaultAuditedMethods
+	}
+	for _, pattern := range methods {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("audit log: invalid method pattern %q: %v", pattern, err)
+		}
+	}
+	maxSize := config.MaxSize
+	if maxSize <= 0 {
+		maxSize = defaultAuditLogMaxSize
+	}
+	return &AuditLog{
+		methods: methods,
+		out: &lumberjack.Logger{
+			Filename:   config.File,
+			MaxSize:    maxSize,
+			MaxBackups: config.MaxBackups,
+			MaxAge:     config.MaxAge,
+			Compress:   config.Compress,
+		},
+	}, nil
+}
+
+// IsAudited returns true if calls to the method are audited
+func (a *AuditLog) IsAudited(method string) bool {
+	for _, pattern := range a.methods {
+		if matched, _ := path.Match(pattern, method); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// Write appends the record to the audit log
+func (a *AuditLog) Write(record *AuditRecord) {
+	data, err := json.Marshal(record)
+	if err != nil {
+		log.Error("Unable to encode audit record", "method", record.Method, "err", err)
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, err := a.out.Write(append(data, '\n')); err != nil {
+		log.Error("Unable to write audit record", "method", record.Method, "err", err)
+	}
+}
+
+func (a *AuditLog) Close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.out.Close()
+}
+
+// newAuditRecord extracts what is audited from a call and its answer
+func newAuditRecord(msg *jsonrpcMessage, answer *jsonrpcMessage) *AuditRecord {
+	record := &AuditRecord{
+		Time:       time.Now().UTC(),
+		Method:     msg.Method,
+		PrivateFor: extractPrivateFor(msg.Params),
+	}
+	if answer != nil {
+		if answer.Error != nil {
+			record.Error = answer.Error.Message
+		} else if txHashPattern.Match(answer.Result) {
+			record.TxHash = string(answer.Result[1 : len(answer.Result)-1])
+		}
+	}
+	return record
+}
+
+// extractPrivateFor collects the privateFor recipients from the call arguments
+// without decoding anything else
+func extractPrivateFor(params json.RawMessage) []string {
+	var args []json.RawMessage
+	if err := json.Unmarshal(params, &args); err != nil {
+		return nil
+	}
+	var privateFor []string
+	for _, arg := range args {
+		if len(arg) == 0 || arg[0] != '{' {
+			continue
+		}
+		var privateArgs struct {
+			PrivateFor []string `json:"privateFor"`
+		}
+		if err := json.Unmarshal(arg, &privateArgs); err == nil {
+			privateFor = append(privateFor, privateArgs.PrivateFor...)
+		}
+	}
+	return privateFor
+}
@@ -0,0 +1,122 @@
+package rpc
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readAuditRecords(t *testing.T, file string) []*AuditRecord {
+	f, err := os.Open(file)
+	require.NoError(t, err)
+	defer f.Close()
+	var records []*AuditRecord
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		r := new(AuditRecord)
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), r))
+		records = append(records, r)
+	}
+	return records
+}
+
+func TestNewAuditRecord_whenTypical(t *testing.T) {
+	msg := &jsonrpcMessage{
+		Method: "eth_sendTransaction",
+		Params: json.RawMessage(`[{"from":"0x01","data":"0xdeadbeef","privateFor":["R1","R2"]}]`),
+	}
+	txHash := "0x0102030405060708091011121314151617181920212223242526272829303132"
+	answer := &jsonrpcMessage{Result: json.RawMessage(`"` + txHash + `"`)}
+
+	record := newAuditRecord(msg, answer)
+
+	assert.Equal(t, "eth_sendTransaction", record.Method)
+	assert.Equal(t, txHash, record.TxHash)
+	assert.Equal(t, []string{"R1", "R2"}, record.PrivateFor)
+	data, err := json.Marshal(record)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "deadbeef", "payload must never be audited")
+}
+
+func TestNewAuditRecord_whenError(t *testing.T) {
+	msg := &jsonrpcMessage{Method: "eth_sendRawTransaction", Params: json.RawMessage(`["0xf86c"]`)}
+	answer := &jsonrpcMessage{Error: &jsonError{Code: -32000, Message: "nonce too low"}}
+
+	record := newAuditRecord(msg, answer)
+
+	assert.Empty(t, record.TxHash)
+	assert.Empty(t, record.PrivateFor)
+	assert.Equal(t, "nonce too low", record.Error)
+}
+
+func TestAuditLog_IsAudited(t *testing.T) {
+	auditLog, err := NewAuditLog(AuditLogConfig{File: filepath.Join(t.TempDir(), "audit.log")})
+	require.NoError(t, err)
+	defer auditLog.Close()
+
+	assert.True(t, auditLog.IsAudited("eth_sendRawPrivateTransaction"))
+	assert.True(t, auditLog.IsAudited("personal_unlockAccount"))
+	assert.True(t, auditLog.IsAudited("quorumPermission_removeAccessRule"))
+	assert.True(t, auditLog.IsAudited("quorumExtension_removeParticipants"))
+	assert.True(t, auditLog.IsAudited("raft_transferLeadership"))
+	assert.False(t, auditLog.IsAudited("quorumPermission_pendingActions"))
+	assert.False(t, auditLog.IsAudited("eth_getBalance"))
+	assert.False(t, auditLog.IsAudited("admin_nodeInfo"))
+}
+
+func TestServer_whenAuditedOverInProc(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "audit.log")
+	auditLog, err := NewAuditLog(AuditLogConfig{File: file, Methods: []string{"test_echo"}})
+	require.NoError(t, err)
+	server := newTestServer()
+	server.SetAuditLog(auditLog)
+	client := DialInProc(server)
+	var result echoResult
+
+	require.NoError(t, client.Call(&result, "test_echo", "hello", 1, &echoArgs{S: "world"}))
+	require.NoError(t, client.Call(nil, "test_rets"))
+	client.Close()
+	require.NoError(t, auditLog.Close())
+
+	records := readAuditRecords(t, file)
+	require.Len(t, records, 1)
+	assert.Equal(t, "test_echo", records[0].Method)
+	assert.Empty(t, records[0].Principal)
+	assert.Empty(t, records[0].Error)
+}
+
+func TestServer_whenAuditedOverHTTPWithPrincipal(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "audit.log")
+	auditLog, err := NewAuditLog(AuditLogConfig{File: file, Methods: []string{"test_*"}})
+	require.NoError(t, err)
+	server := NewProtectedServer(&rateLimitTestAuthenticationManager{}, false)
+	server.SetAuditLog(auditLog)
+	require.NoError(t, server.RegisterName("test", new(testService)))
+	httpsrv := httptest.NewServer(server)
+	defer httpsrv.Close()
+	token := "e30." + base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"alice"}`)) + ".sig"
+	req, _ := http.NewRequest("POST", httpsrv.URL, strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"test_returnError","params":[]}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HttpAuthorizationHeader, token)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.NoError(t, auditLog.Close())
+
+	records := readAuditRecords(t, file)
+	require.Len(t, records, 1)
+	assert.Equal(t, "test_returnError", records[0].Method)
+	assert.Equal(t, "alice", records[0].Principal)
+	assert.NotEmpty(t, records[0].Remote)
+	assert.NotEmpty(t, records[0].Error)
+}
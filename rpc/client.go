@@ -78,6 +78,7 @@ type Client struct {
 	idgen    func() ID // for subscriptions
 	isHTTP   bool
 	services *serviceRegistry
+	auditLog *AuditLog // Quorum - audits calls served over this connection, nil if disabled
 
 	idCounter uint32
 
@@ -113,6 +114,7 @@ type clientConn struct {
 func (c *Client) newClientConn(conn ServerCodec) *clientConn {
 	ctx := context.WithValue(context.Background(), clientContextKey{}, c)
 	handler := newHandler(ctx, conn, c.idgen, c.services)
+	handler.auditLog = c.auditLog
 	return &clientConn{conn, handler}
 }
 
@@ -200,7 +202,7 @@ func newClient(initctx context.Context, connect reconnectFunc) (*Client, error)
 	if err != nil {
 		return nil, err
 	}
-	c := initClient(conn, randomIDGenerator(), new(serviceRegistry))
+	c := initClient(conn, randomIDGenerator(), new(serviceRegistry), nil)
 	c.reconnectFunc = connect
 	if providerFunc := PSIProviderFromContext(initctx); providerFunc != nil {
 		c = c.WithPSIProvider(providerFunc)
@@ -208,12 +210,14 @@ func newClient(initctx context.Context, connect reconnectFunc) (*Client, error)
 	return c, nil
 }
 
-func initClient(conn ServerCodec, idgen func() ID, services *serviceRegistry) *Client {
+// Quorum - added argument `auditLog` used when serving calls
+func initClient(conn ServerCodec, idgen func() ID, services *serviceRegistry, auditLog *AuditLog) *Client {
 	_, isHTTP := conn.(*httpConn)
 	c := &Client{
 		idgen:       idgen,
 		isHTTP:      isHTTP,
 		services:    services,
+		auditLog:    auditLog,
 		writeConn:   conn,
 		close:       make(chan struct{}),
 		closing:     make(chan struct{}),
@@ -31,6 +31,7 @@ const (
 	// keys used to save values in request context
 	ctxAuthenticationError   = securityContextKey("AUTHENTICATION_ERROR")   // key to save error during authentication before processing the request body
 	ctxPreauthenticatedToken = securityContextKey("PREAUTHENTICATED_TOKEN") // key to save the preauthenticated token once authenticated
+	ctxRateLimiter           = securityContextKey("RATE_LIMITER")           // key to save reference to the server's *rpc.RateLimiter
 )
 
 // WithIsMultitenant populates ctx with ctxIsMultitenant key and provided value
@@ -60,6 +60,7 @@ type handler struct {
 	conn           jsonWriter                     // where responses will be sent
 	log            log.Logger
 	allowSubscribe bool
+	auditLog       *AuditLog // Quorum - records state-changing calls, nil if disabled
 
 	subLock    sync.Mutex
 	serverSubs map[ID]*Subscription
@@ -290,11 +291,13 @@ func (h *handler) handleCallMsg(ctx *callProc, msg *jsonrpcMessage) *jsonrpcMess
 	start := time.Now()
 	switch {
 	case msg.isNotification():
-		h.handleCall(ctx, msg)
+		resp := h.handleCall(ctx, msg)
+		h.auditCall(ctx, msg, resp)
 		h.log.Debug("Served "+msg.Method, "t", time.Since(start))
 		return nil
 	case msg.isCall():
 		resp := h.handleCall(ctx, msg)
+		h.auditCall(ctx, msg, resp)
 		var ctx []interface{}
 		ctx = append(ctx, "reqid", idForLog{msg.ID}, "t", time.Since(start))
 		if resp.Error != nil {
@@ -319,7 +322,8 @@ func (h *handler) handleCallMsg(ctx *callProc, msg *jsonrpcMessage) *jsonrpcMess
 //
 //	This is where server handle the call requests hence we enforce authorization check
 //	before the actual processing of the call. It also populates context with preauthenticated
-//	token so the responsible RPC method can leverage if needed (e.g: in multi tenancy)
+//	token so the responsible RPC method can leverage if needed (e.g: in multi tenancy).
+//	Rate limits and concurrency caps configured for the caller are enforced here too
 func (h *handler) handleCall(cp *callProc, msg *jsonrpcMessage) *jsonrpcMessage {
 	if r, ok := h.conn.(SecurityContextResolver); ok {
 		secCtx, err := SecureCall(r, msg.Method)
@@ -333,6 +337,14 @@ func (h *handler) handleCall(cp *callProc, msg *jsonrpcMessage) *jsonrpcMessage
 		if psi, found := PrivateStateIdentifierFromContext(secCtx); found {
 			cp.ctx = WithPrivateStateIdentifier(cp.ctx, psi)
 		}
+		if limiter := RateLimiterFromContext(secCtx); limiter != nil && !msg.isUnsubscribe() {
+			psi, _ := PrivateStateIdentifierFromContext(secCtx)
+			release, err := limiter.acquire(principalFromToken(PreauthenticatedTokenFromContext(secCtx)), psi, msg.Method)
+			if err != nil {
+				return msg.errorResponse(err)
+			}
+			defer release()
+		}
 	}
 	// try to extract the PSI from the request ID if it is not already there in the context.
 	// this is mainly to serve IPC and InProc transport
@@ -374,6 +386,28 @@ func (h *handler) handleCall(cp *callProc, msg *jsonrpcMessage) *jsonrpcMessage
 	return answer
 }
 
+// Quorum
+// auditCall records a state-changing call in the audit log, including the ones being rejected.
+// The principal and PSI come from the security context, if any
+func (h *handler) auditCall(cp *callProc, msg *jsonrpcMessage, answer *jsonrpcMessage) {
+	if h.auditLog == nil || !h.auditLog.IsAudited(msg.Method) {
+		return
+	}
+	record := newAuditRecord(msg, answer)
+	record.Remote = h.conn.remoteAddr()
+	token := PreauthenticatedTokenFromContext(cp.ctx)
+	if r, ok := h.conn.(SecurityContextResolver); ok && token == nil {
+		if secCtx := r.Resolve(); secCtx != nil {
+			token = PreauthenticatedTokenFromContext(secCtx)
+		}
+	}
+	record.Principal = principalFromToken(token)
+	if psi, found := PrivateStateIdentifierFromContext(cp.ctx); found {
+		record.PSI = psi.String()
+	}
+	h.auditLog.Write(record)
+}
+
 // handleSubscribe processes *_subscribe method calls.
 func (h *handler) handleSubscribe(cp *callProc, msg *jsonrpcMessage) *jsonrpcMessage {
 	if !h.allowSubscribe {
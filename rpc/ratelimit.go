@@ -0,0 +1,339 @@
+// Quorum
+package rpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/metrics"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/jpmorganchase/quorum-security-plugin-sdk-go/proto"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// number of principal, method class and PSI buckets above which idle buckets are dropped.
+	// Buckets in use are kept so that limits can't be reset by making calls as other principals
+	rateLimitBucketsSoftLimit = 4096
+	// maximum number of principals whose usage metrics are exported at a time
+	principalMetricsCacheSize = 256
+)
+
+var (
+	// usage of all principals
+	rateLimitRequestsMeter   = metrics.NewRegisteredMeter("rpc/ratelimit/requests", nil)
+	rateLimitRejectedMeter   = metrics.NewRegisteredMeter("rpc/ratelimit/rejected", nil)
+	rateLimitInflightCounter = metrics.NewRegisteredCounter("rpc/ratelimit/inflight", nil)
+)
+
+// RateLimit caps the calls made against a bucket. Zero values mean unlimited
+type RateLimit struct {
+	RequestsPerSecond float64 `toml:",omitempty"` // sustained call rate
+	Burst             int     `toml:",omitempty"` // calls allowed above the sustained rate, defaults to 1
+	MaxConcurrent     int     `toml:",omitempty"` // calls being served at the same time
+}
+
+func (l RateLimit) isUnlimited() bool {
+	return l.RequestsPerSecond <= 0 && l.MaxConcurrent <= 0
+}
+
+// MethodClass groups RPC methods which share a limit, e.g.: heavy methods like
+// debug_traceBlock* or eth_getLogs
+type MethodClass struct {
+	Name    string
+	Methods []string // method names or patterns, e.g.: debug_trace*
+	Limit   RateLimit
+}
+
+// RateLimitConfig configures the limits applied to RPC calls. Limits only apply to calls
+// made by authenticated principals, they are enforced:
+//   - per principal for all calls
+//   - per principal for the calls matching a method class, the first matching class applies
+//   - per private state (PSI) for all calls made against it, regardless of the principal
+type RateLimitConfig struct {
+	Principal     RateLimit     `toml:",omitempty"`
+	MethodClasses []MethodClass `toml:",omitempty"`
+	PSI           RateLimit     `toml:",omitempty"`
+}
+
+// RateLimiter enforces a RateLimitConfig. It is safe for concurrent use and is meant to
+// be shared by all RPC servers of a node so that limits apply across transports
+type RateLimiter struct {
+	config           RateLimitConfig
+	buckets          map[string]*limitBucket
+	mu               sync.Mutex // guards buckets and the reservations made in them
+	principalMetrics *lru.Cache // principal -> *rateLimitMetrics
+}
+
+// NewRateLimiter validates the method class patterns and returns the limiter
+func NewRateLimiter(config RateLimitConfig) (*RateLimiter, error) {
+	for _, class := range config.MethodClasses {
+		if class.Name == "" {
+			return nil, fmt.Errorf("rate limit: method class must have a name")
+		}
+		for _, pattern := range class.Methods {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("rate limit: invalid method pattern %q in class %s: %v", pattern, class.Name, err)
+			}
+		}
+	}
+	principalMetrics, err := lru.NewWithEvict(principalMetricsCacheSize, func(_ interface{}, value interface{}) {
+		value.(*rateLimitMetrics).unregister()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &RateLimiter{
+		config:           config,
+		buckets:          make(map[string]*limitBucket),
+		principalMetrics: principalMetrics,
+	}, nil
+}
+
+type limitBucket struct {
+	limit    RateLimit
+	limiter  *rate.Limiter // nil if there is no rate limit
+	mu       sync.Mutex
+	inflight int
+	lastUsed time.Time
+}
+
+// methodClass returns the class the method belongs to, if any
+func (r *RateLimiter) methodClass(method string) *MethodClass {
+	for i, class := range r.config.MethodClasses {
+		for _, pattern := range class.Methods {
+			if matched, _ := path.Match(pattern, method); matched {
+				return &r.config.MethodClasses[i]
+			}
+		}
+	}
+	return nil
+}
+
+// take reserves a slot in the bucket of key, see limitBucket.take
+func (r *RateLimiter) take(key string, limit RateLimit, now time.Time) (release func(), cancel func(), ok bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	b, ok := r.buckets[key]
+	if !ok {
+		if len(r.buckets) >= rateLimitBucketsSoftLimit {
+			r.dropIdleBuckets(now)
+		}
+		b = &limitBucket{limit: limit}
+		if limit.RequestsPerSecond > 0 {
+			b.limiter = rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), limit.burst())
+		}
+		r.buckets[key] = b
+	}
+	return b.take(now)
+}
+
+// dropIdleBuckets must be called with the lock held. Only buckets which would behave as new ones
+// are dropped, buckets with calls being served or with a quota being replenished are kept
+func (r *RateLimiter) dropIdleBuckets(now time.Time) {
+	for key, b := range r.buckets {
+		if b.idle(now) {
+			delete(r.buckets, key)
+		}
+	}
+}
+
+func (l RateLimit) burst() int {
+	if l.Burst <= 0 {
+		return 1
+	}
+	return l.Burst
+}
+
+func (b *limitBucket) idle(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.inflight > 0 {
+		return false
+	}
+	if b.limiter == nil {
+		return true
+	}
+	replenished := time.Duration(float64(b.limit.burst()) / b.limit.RequestsPerSecond * float64(time.Second))
+	return now.Sub(b.lastUsed) >= replenished
+}
+
+// take reserves a slot in the bucket. It returns a function to give it back once the call
+// has been served and another to roll back the reservation if a subsequent bucket rejects the call
+func (b *limitBucket) take(now time.Time) (release func(), cancel func(), ok bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.limit.MaxConcurrent > 0 && b.inflight >= b.limit.MaxConcurrent {
+		return nil, nil, false
+	}
+	var reservation *rate.Reservation
+	if b.limiter != nil {
+		reservation = b.limiter.ReserveN(now, 1)
+		if !reservation.OK() || reservation.DelayFrom(now) > 0 {
+			reservation.CancelAt(now)
+			return nil, nil, false
+		}
+		b.lastUsed = now
+	}
+	b.inflight++
+	release = func() {
+		b.mu.Lock()
+		b.inflight--
+		b.mu.Unlock()
+	}
+	cancel = func() {
+		release()
+		if reservation != nil {
+			reservation.CancelAt(now)
+		}
+	}
+	return release, cancel, true
+}
+
+// acquire checks all limits applying to the call. When the call is allowed, the returned
+// function must be called once the call has been served
+func (r *RateLimiter) acquire(principal string, psi types.PrivateStateIdentifier, method string) (func(), error) {
+	type check struct {
+		key, name string
+		limit     RateLimit
+	}
+	var checks []check
+	if principal != "" {
+		checks = append(checks, check{"principal/" + principal, "principal " + principal, r.config.Principal})
+		if class := r.methodClass(method); class != nil {
+			checks = append(checks, check{"class/" + class.Name + "/" + principal, fmt.Sprintf("principal %s for %s methods", principal, class.Name), class.Limit})
+		}
+	}
+	if psi != "" {
+		checks = append(checks, check{"psi/" + psi.String(), "private state " + psi.String(), r.config.PSI})
+	}
+	now := time.Now()
+	var releases, cancels []func()
+	for _, c := range checks {
+		if c.limit.isUnlimited() {
+			continue
+		}
+		release, cancel, ok := r.take(c.key, c.limit, now)
+		if !ok {
+			for _, cancel := range cancels {
+				cancel()
+			}
+			if principal != "" {
+				rateLimitRejectedMeter.Mark(1)
+				r.metrics(principal).rejected.Mark(1)
+			}
+			return nil, &limitExceededError{fmt.Sprintf("rate limit exceeded for %s", c.name)}
+		}
+		releases = append(releases, release)
+		cancels = append(cancels, cancel)
+	}
+	if principal == "" {
+		return func() {
+			for _, release := range releases {
+				release()
+			}
+		}, nil
+	}
+	m := r.metrics(principal)
+	m.requests.Mark(1)
+	m.inflight.Inc(1)
+	rateLimitRequestsMeter.Mark(1)
+	rateLimitInflightCounter.Inc(1)
+	return func() {
+		m.inflight.Dec(1)
+		rateLimitInflightCounter.Dec(1)
+		for _, release := range releases {
+			release()
+		}
+	}, nil
+}
+
+type rateLimitMetrics struct {
+	prefix   string
+	requests metrics.Meter
+	rejected metrics.Meter
+	inflight metrics.Counter
+}
+
+// metrics returns the usage metrics of a principal. Only the most recently seen principals
+// are exported, the usage of all principals is exported by the rpc/ratelimit metrics
+func (r *RateLimiter) metrics(principal string) *rateLimitMetrics {
+	if m, ok := r.principalMetrics.Get(principal); ok {
+		return m.(*rateLimitMetrics)
+	}
+	prefix := "rpc/principal/" + metricsSafe(principal)
+	m := &rateLimitMetrics{
+		prefix:   prefix,
+		requests: metrics.GetOrRegisterMeter(prefix+"/requests", nil),
+		rejected: metrics.GetOrRegisterMeter(prefix+"/rejected", nil),
+		inflight: metrics.GetOrRegisterCounter(prefix+"/inflight", nil),
+	}
+	r.principalMetrics.Add(principal, m)
+	return m
+}
+
+func (m *rateLimitMetrics) unregister() {
+	for _, name := range []string{"/requests", "/rejected", "/inflight"} {
+		metrics.DefaultRegistry.Unregister(m.prefix + name)
+	}
+}
+
+func metricsSafe(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '.', r == '_':
+			return r
+		}
+		return '_'
+	}, s)
+}
+
+// principalFromToken identifies the caller of an authenticated request.
+// Access tokens are usually JWTs already verified by the authentication manager,
+// in which case the subject is used. Otherwise the token is identified by its hash
+func principalFromToken(token *proto.PreAuthenticatedAuthenticationToken) string {
+	if token == nil || len(token.RawToken) == 0 {
+		return ""
+	}
+	if parts := strings.Split(string(token.RawToken), "."); len(parts) == 3 {
+		if payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "=")); err == nil {
+			var claims struct {
+				Subject  string `json:"sub"`
+				ClientID string `json:"client_id"`
+			}
+			if err := json.Unmarshal(payload, &claims); err == nil {
+				if claims.Subject != "" {
+					return claims.Subject
+				}
+				if claims.ClientID != "" {
+					return claims.ClientID
+				}
+			}
+		}
+	}
+	hash := sha256.Sum256(token.RawToken)
+	return "token-" + hex.EncodeToString(hash[:8])
+}
+
+// WithRateLimiter populates ctx with ctxRateLimiter key and provided value
+func WithRateLimiter(ctx context.Context, limiter *RateLimiter) SecurityContext {
+	return context.WithValue(ctx, ctxRateLimiter, limiter)
+}
+
+// RateLimiterFromContext returns *RateLimiter value from ctx with ctxRateLimiter key
+// and returns nil if value does not exist in the ctx
+func RateLimiterFromContext(ctx SecurityContext) *RateLimiter {
+	if l, ok := ctx.Value(ctxRateLimiter).(*RateLimiter); ok {
+		return l
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package rpc
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/jpmorganchase/quorum-security-plugin-sdk-go/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestRateLimiter_whenRateExceeded(t *testing.T) {
+	limiter, err := NewRateLimiter(RateLimitConfig{
+		Principal: RateLimit{RequestsPerSecond: 0.001, Burst: 2},
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		release, err := limiter.acquire("alice", "", "eth_blockNumber")
+		require.NoError(t, err)
+		release()
+	}
+	_, err = limiter.acquire("alice", "", "eth_blockNumber")
+	assert.EqualError(t, err, "rate limit exceeded for principal alice")
+	assert.Equal(t, -32005, err.(Error).ErrorCode())
+
+	// other principals have their own quota
+	_, err = limiter.acquire("bob", "", "eth_blockNumber")
+	assert.NoError(t, err)
+}
+
+func TestRateLimiter_whenMethodClassConcurrencyExceeded(t *testing.T) {
+	limiter, err := NewRateLimiter(RateLimitConfig{
+		MethodClasses: []MethodClass{
+			{Name: "trace", Methods: []string{"debug_trace*", "eth_getLogs"}, Limit: RateLimit{MaxConcurrent: 1}},
+		},
+	})
+	require.NoError(t, err)
+
+	release, err := limiter.acquire("alice", "", "debug_traceBlockByNumber")
+	require.NoError(t, err)
+
+	_, err = limiter.acquire("alice", "", "eth_getLogs")
+	assert.EqualError(t, err, "rate limit exceeded for principal alice for trace methods")
+	_, err = limiter.acquire("alice", "", "eth_blockNumber")
+	assert.NoError(t, err, "methods not in the class are not capped")
+
+	release()
+	_, err = limiter.acquire("alice", "", "eth_getLogs")
+	assert.NoError(t, err)
+}
+
+func TestRateLimiter_whenPSIExceededThenPrincipalQuotaIsNotConsumed(t *testing.T) {
+	psi := types.PrivateStateIdentifier("PS1")
+	limiter, err := NewRateLimiter(RateLimitConfig{
+		Principal: RateLimit{RequestsPerSecond: 0.001, Burst: 1},
+		PSI:       RateLimit{MaxConcurrent: 1},
+	})
+	require.NoError(t, err)
+	_, err = limiter.acquire("alice", psi, "eth_blockNumber")
+	require.NoError(t, err)
+
+	_, err = limiter.acquire("bob", psi, "eth_blockNumber")
+	assert.EqualError(t, err, "rate limit exceeded for private state PS1")
+
+	_, err = limiter.acquire("bob", "PS2", "eth_blockNumber")
+	assert.NoError(t, err)
+}
+
+func TestRateLimiter_whenBucketsSoftLimitReachedThenBucketsInUseAreKept(t *testing.T) {
+	limiter, err := NewRateLimiter(RateLimitConfig{
+		Principal: RateLimit{MaxConcurrent: 1},
+	})
+	require.NoError(t, err)
+	_, err = limiter.acquire("alice", "", "eth_blockNumber")
+	require.NoError(t, err)
+
+	for i := 0; i < rateLimitBucketsSoftLimit; i++ {
+		release, err := limiter.acquire(fmt.Sprintf("principal-%d", i), "", "eth_blockNumber")
+		require.NoError(t, err)
+		release()
+	}
+
+	assert.Len(t, limiter.buckets, 2, "idle buckets are dropped")
+	_, err = limiter.acquire("alice", "", "eth_blockNumber")
+	assert.EqualError(t, err, "rate limit exceeded for principal alice")
+}
+
+func TestRateLimiter_whenBucketsSoftLimitReachedThenQuotaIsKept(t *testing.T) {
+	limiter, err := NewRateLimiter(RateLimitConfig{
+		Principal: RateLimit{RequestsPerSecond: 0.001, Burst: 1},
+	})
+	require.NoError(t, err)
+	_, err = limiter.acquire("alice", "", "eth_blockNumber")
+	require.NoError(t, err)
+
+	limiter.mu.Lock()
+	limiter.dropIdleBuckets(time.Now())
+	limiter.mu.Unlock()
+
+	_, err = limiter.acquire("alice", "", "eth_blockNumber")
+	assert.EqualError(t, err, "rate limit exceeded for principal alice")
+}
+
+func TestRateLimiter_principalMetricsAreCapped(t *testing.T) {
+	limiter, err := NewRateLimiter(RateLimitConfig{})
+	require.NoError(t, err)
+
+	for i := 0; i <= principalMetricsCacheSize; i++ {
+		release, err := limiter.acquire(fmt.Sprintf("metrics-principal-%d", i), "", "eth_blockNumber")
+		require.NoError(t, err)
+		release()
+	}
+
+	assert.Equal(t, principalMetricsCacheSize, limiter.principalMetrics.Len())
+	assert.Nil(t, metrics.DefaultRegistry.Get("rpc/principal/metrics-principal-0/requests"), "least recently seen principal is evicted")
+	assert.NotNil(t, metrics.DefaultRegistry.Get(fmt.Sprintf("rpc/principal/metrics-principal-%d/requests", principalMetricsCacheSize)))
+}
+
+func TestNewRateLimiter_whenInvalidPattern(t *testing.T) {
+	_, err := NewRateLimiter(RateLimitConfig{
+		MethodClasses: []MethodClass{{Name: "bad", Methods: []string{"eth_[getLogs"}}},
+	})
+
+	assert.Error(t, err)
+}
+
+func TestPrincipalFromToken(t *testing.T) {
+	jwt := func(payload string) []byte {
+		return []byte("e30." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".sig")
+	}
+
+	assert.Equal(t, "", principalFromToken(nil))
+	assert.Equal(t, "alice", principalFromToken(&proto.PreAuthenticatedAuthenticationToken{RawToken: jwt(`{"sub":"alice","client_id":"app"}`)}))
+	assert.Equal(t, "app", principalFromToken(&proto.PreAuthenticatedAuthenticationToken{RawToken: jwt(`{"client_id":"app"}`)}))
+	assert.True(t, strings.HasPrefix(principalFromToken(&proto.PreAuthenticatedAuthenticationToken{RawToken: []byte("opaque")}), "token-"))
+}
+
+type rateLimitTestAuthenticationManager struct{}
+
+func (*rateLimitTestAuthenticationManager) Authenticate(_ context.Context, token string) (*proto.PreAuthenticatedAuthenticationToken, error) {
+	return &proto.PreAuthenticatedAuthenticationToken{
+		RawToken:    []byte(token),
+		ExpiredAt:   timestamppb.New(time.Now().Add(time.Hour)),
+		Authorities: []*proto.GrantedAuthority{{Service: "*", Method: "*"}},
+	}, nil
+}
+
+func (*rateLimitTestAuthenticationManager) IsEnabled(_ context.Context) (bool, error) {
+	return true, nil
+}
+
+func TestServer_whenRateLimited(t *testing.T) {
+	limiter, err := NewRateLimiter(RateLimitConfig{Principal: RateLimit{RequestsPerSecond: 0.001, Burst: 1}})
+	require.NoError(t, err)
+	server := NewProtectedServer(&rateLimitTestAuthenticationManager{}, false)
+	server.SetRateLimiter(limiter)
+	require.NoError(t, server.RegisterName("test", new(testService)))
+	httpsrv := httptest.NewServer(server)
+	defer httpsrv.Close()
+	call := func(token string) string {
+		req, _ := http.NewRequest("POST", httpsrv.URL, strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"test_rets","params":[]}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(HttpAuthorizationHeader, token)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return string(body)
+	}
+
+	assert.Contains(t, call("token1"), `"result":""`)
+	assert.Contains(t, call("token1"), `"code":-32005`)
+	assert.Contains(t, call("token2"), `"result":""`)
+}
@@ -53,6 +53,8 @@ type Server struct {
 	// The implementation would authenticate the token coming from a request
 	authenticationManager security.AuthenticationManager
 	isMultitenant         bool
+	rateLimiter           *RateLimiter // limits calls from authenticated principals, nil if unlimited
+	auditLog              *AuditLog    // records state-changing calls, nil if disabled
 }
 
 // Quorum
@@ -104,7 +106,7 @@ func (s *Server) ServeCodec(codec ServerCodec, options CodecOption) {
 	s.codecs.Add(codec)
 	defer s.codecs.Remove(codec)
 
-	c := initClient(codec, s.idgen, &s.services)
+	c := initClient(codec, s.idgen, &s.services, s.auditLog)
 	<-codec.closed()
 	c.Close()
 }
@@ -120,6 +122,7 @@ func (s *Server) serveSingleRequest(ctx context.Context, codec ServerCodec) {
 
 	h := newHandler(ctx, codec, s.idgen, &s.services)
 	h.allowSubscribe = false
+	h.auditLog = s.auditLog
 	defer h.close(io.EOF, nil)
 
 	reqs, batch, err := codec.readBatch()
@@ -154,6 +157,9 @@ func (s *Server) Stop() {
 // for subsequent authorization-related activities
 func (s *Server) authenticateHttpRequest(r *http.Request, cfg securityContextConfigurer) {
 	securityContext := WithIsMultitenant(context.Background(), s.isMultitenant)
+	if s.rateLimiter != nil {
+		securityContext = WithRateLimiter(securityContext, s.rateLimiter)
+	}
 	securityContext = AuthenticateHttpRequest(securityContext, r, s.authenticationManager)
 	cfg.Configure(securityContext)
 }
@@ -162,6 +168,16 @@ func (s *Server) EnableMultitenancy(b bool) {
 	s.isMultitenant = b
 }
 
+// SetRateLimiter enables rate limiting of the calls made over HTTP and WebSocket
+func (s *Server) SetRateLimiter(l *RateLimiter) {
+	s.rateLimiter = l
+}
+
+// SetAuditLog enables auditing of the state-changing calls served over any transport
+func (s *Server) SetAuditLog(a *AuditLog) {
+	s.auditLog = a
+}
+
 // RPCService gives meta information about the server.
 // e.g. gives information about the loaded modules.
 type RPCService struct {
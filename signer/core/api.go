@@ -32,6 +32,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/usbwallet"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/internal/ethapi"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/plugin"
@@ -42,7 +43,7 @@ const (
 	// numberOfAccountsToDerive For hardware wallets, the number of accounts to derive
 	numberOfAccountsToDerive = 10
 	// ExternalAPIVersion -- see extapi_changelog.md
-	ExternalAPIVersion = "6.1.0"
+	ExternalAPIVersion = "6.2.0"
 	// InternalAPIVersion -- see intapi_changelog.md
 	InternalAPIVersion = "7.0.1"
 )
@@ -248,6 +249,8 @@ type (
 		Callinfo    []ValidationInfo        `json:"call_info"`
 		Hash        hexutil.Bytes           `json:"hash"`
 		Meta        Metadata                `json:"meta"`
+
+		typedData []byte // Quorum: JSON encoded EIP-712 typed data, given to wallets implementing accounts.TypedDataWallet
 	}
 	SignDataResponse struct {
 		Approved bool `json:"approved"`
@@ -534,8 +537,20 @@ func (api *SignerAPI) SignTransaction(ctx context.Context, args SendTxArgs, meth
 		result SignTxResponse
 		msgs   *ValidationMessages
 	)
-	if args.IsPrivate || args.isPrivacyMarker() {
+	// Quorum
+	if err := args.validatePrivateArgs(); err != nil {
+		return nil, err
+	}
+	args.IsPrivacyMarker = args.isPrivacyMarker()
+	if args.IsPrivate || args.IsPrivacyMarker {
+		// Quorum: the payload is encrypted so it can't be validated
 		msgs = new(ValidationMessages)
+		if args.IsPrivacyMarker {
+			msgs.Info("Privacy marker transaction")
+		}
+		if args.PrivateFor != nil {
+			msgs.Info(fmt.Sprintf("Private transaction for %d recipient(s)", len(args.PrivateFor)))
+		}
 	} else {
 		msgs, err = api.validator.ValidateTransaction(methodSelector, &args)
 		if err != nil {
@@ -590,7 +605,13 @@ func (api *SignerAPI) SignTransaction(ctx context.Context, args SendTxArgs, meth
 		return nil, err
 	}
 	// The one to sign is the one that was returned from the UI
-	signedTx, err := wallet.SignTxWithPassphrase(acc, pw, unsignedTx, api.chainID)
+	var signedTx *types.Transaction
+	if w, ok := wallet.(accounts.PrivateTxWallet); ok && result.Transaction.privateTxInfo() != nil {
+		// Quorum: the wallet is given the privacy details
+		signedTx, err = w.SignPrivateTxWithPassphrase(acc, pw, unsignedTx, api.chainID, result.Transaction.privateTxInfo())
+	} else {
+		signedTx, err = wallet.SignTxWithPassphrase(acc, pw, unsignedTx, api.chainID)
+	}
 	if err != nil {
 		api.UI.ShowError(err.Error())
 		return nil, err
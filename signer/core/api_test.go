@@ -33,6 +33,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/internal/ethapi"
+	"github.com/ethereum/go-ethereum/private/engine"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/signer/core"
 	"github.com/ethereum/go-ethereum/signer/fourbyte"
@@ -318,3 +319,45 @@ func TestSignTx(t *testing.T) {
 		t.Error("Expected tx to be modified by UI")
 	}
 }
+
+func TestSignTx_whenPrivate(t *testing.T) {
+	api, control := setup(t)
+	createAccount(control, api, t)
+	control.approveCh <- "A"
+	list, err := api.List(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := common.NewMixedcaseAddress(list[0])
+
+	// privacy details are rejected for a public transaction
+	tx := mkTestTx(a)
+	tx.PrivateFor = []string{"ROAZBWtSacxXQrOe3FGAqJDyJjFePR5ce4TSIzmJ0Bc="}
+	if _, err := api.SignTransaction(context.Background(), tx, nil); err == nil || err.Error() != "privacy details given for a public transaction" {
+		t.Errorf("Expected error for privacy details of a public transaction, got %v", err)
+	}
+
+	tx.IsPrivate = true
+	tx.PrivacyFlag = engine.PrivacyFlagMandatoryRecipients
+	if _, err := api.SignTransaction(context.Background(), tx, nil); err == nil || err.Error() != "missing mandatory recipients for privacy flag MandatoryRecipients" {
+		t.Errorf("Expected error for missing mandatory recipients, got %v", err)
+	}
+
+	tx.MandatoryFor = tx.PrivateFor
+	control.approveCh <- "Y"
+	control.inputCh <- "a_long_password"
+	res, err := api.SignTransaction(context.Background(), tx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Tx.IsPrivate() {
+		t.Error("Expected a private transaction")
+	}
+	sender, err := types.QuorumPrivateTxSigner{}.Sender(res.Tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sender != a.Address() {
+		t.Errorf("Expected sender %v, got %v", a.Address(), sender)
+	}
+}
@@ -135,6 +135,22 @@ func (ui *CommandlineUI) ApproveTx(request *SignTxRequest) (SignTxResponse, erro
 			fmt.Printf("data:     %v\n", hexutil.Encode(d))
 		}
 	}
+	// Quorum
+	if request.Transaction.IsPrivacyMarker {
+		fmt.Printf("\nPrivacy marker transaction, wrapping a private transaction\n")
+	} else if request.Transaction.IsPrivate {
+		fmt.Printf("\nPrivate transaction, data is the hash of the encrypted payload\n")
+	}
+	if tx := request.Transaction; tx.PrivateFor != nil {
+		fmt.Printf("Privacy details given by the caller, not verified:\n")
+		fmt.Printf("privateFrom:  %v\n", tx.PrivateFrom)
+		fmt.Printf("privateFor:   %v\n", strings.Join(tx.PrivateFor, ", "))
+		fmt.Printf("privacyFlag:  %v\n", tx.PrivacyFlag)
+		if tx.MandatoryFor != nil {
+			fmt.Printf("mandatoryFor: %v\n", strings.Join(tx.MandatoryFor, ", "))
+		}
+	}
+	// End Quorum
 	if request.Callinfo != nil {
 		fmt.Printf("\nTransaction validation:\n")
 		for _, m := range request.Callinfo {
@@ -19,6 +19,7 @@ package core
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
@@ -148,7 +149,13 @@ func (api *SignerAPI) sign(req *SignDataRequest, legacyV bool) (hexutil.Bytes, e
 		return nil, err
 	}
 	// Sign the data with the wallet
-	signature, err := wallet.SignDataWithPassphrase(account, pw, req.ContentType, req.Rawdata)
+	var signature []byte
+	if w, ok := wallet.(accounts.TypedDataWallet); ok && req.typedData != nil {
+		// Quorum: the wallet is given the typed data, not only its hash
+		signature, err = w.SignTypedDataWithPassphrase(account, pw, req.typedData, req.Rawdata)
+	} else {
+		signature, err = wallet.SignDataWithPassphrase(account, pw, req.ContentType, req.Rawdata)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -347,6 +354,11 @@ func (api *SignerAPI) signTypedData(ctx context.Context, addr common.MixedcaseAd
 	if validationMessages != nil {
 		req.Callinfo = validationMessages.Messages
 	}
+	// Quorum
+	if req.typedData, err = json.Marshal(typedData); err != nil {
+		return nil, nil, err
+	}
+	// End Quorum
 	signature, err := api.sign(req, true)
 	if err != nil {
 		api.UI.ShowError(err.Error())
@@ -18,13 +18,16 @@ package core
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/private/engine"
 )
 
 type ValidationInfo struct {
@@ -83,6 +86,17 @@ type SendTxArgs struct {
 
 	// QUORUM
 	IsPrivate bool `json:"isPrivate,omitempty"`
+	// Privacy details of a private transaction, or of the private transaction wrapped by a privacy
+	// marker transaction. The payload is already encrypted, so these are for display only: they are
+	// supplied by the caller and can not be verified against the private transaction manager.
+	PrivateFrom  string                 `json:"privateFrom,omitempty"`
+	PrivateFor   []string               `json:"privateFor,omitempty"`
+	PrivacyFlag  engine.PrivacyFlagType `json:"privacyFlag,omitempty"`
+	MandatoryFor []string               `json:"mandatoryFor,omitempty"`
+	// Set by the signer if To is the privacy precompile contract, ignored if given by the caller
+	IsPrivacyMarker bool `json:"isPrivacyMarker,omitempty"`
+	// Set for EIP-2718 typed private transactions, PrivacyFlag is then signed along with the transaction
+	IsTypedPrivate bool `json:"isTypedPrivate,omitempty"`
 }
 
 func (args SendTxArgs) String() string {
@@ -106,7 +120,24 @@ func (args *SendTxArgs) toTransaction() (tx *types.Transaction) {
 		to = &_to
 	}
 	var data types.TxData
-	if args.AccessList == nil {
+	if args.IsTypedPrivate {
+		// Quorum
+		var accessList types.AccessList
+		if args.AccessList != nil {
+			accessList = *args.AccessList
+		}
+		data = &types.PrivateTx{
+			To:          to,
+			ChainID:     (*big.Int)(args.ChainID),
+			Nonce:       uint64(args.Nonce),
+			Gas:         uint64(args.Gas),
+			GasPrice:    (*big.Int)(&args.GasPrice),
+			Value:       (*big.Int)(&args.Value),
+			Data:        input,
+			PrivacyFlag: args.PrivacyFlag,
+			AccessList:  accessList,
+		}
+	} else if args.AccessList == nil {
 		data = &types.LegacyTx{
 			To:       to,
 			Nonce:    uint64(args.Nonce),
@@ -138,3 +169,38 @@ func (args *SendTxArgs) toTransaction() (tx *types.Transaction) {
 func (args SendTxArgs) isPrivacyMarker() bool {
 	return args.To != nil && args.To.Address() == common.QuorumPrivacyPrecompileContractAddress()
 }
+
+// Quorum
+// validatePrivateArgs checks the privacy details are only given for a private transaction
+// or a privacy marker transaction
+func (args SendTxArgs) validatePrivateArgs() error {
+	hasPrivateArgs := args.PrivateFrom != "" || args.PrivateFor != nil || args.PrivacyFlag != engine.PrivacyFlagStandardPrivate || args.MandatoryFor != nil
+	if hasPrivateArgs && !args.IsPrivate && !args.isPrivacyMarker() {
+		return errors.New("privacy details given for a public transaction")
+	}
+	if args.IsTypedPrivate && !args.IsPrivate {
+		return errors.New("typed private transaction must be private")
+	}
+	if err := args.PrivacyFlag.Validate(); err != nil {
+		return err
+	}
+	if args.PrivacyFlag == engine.PrivacyFlagMandatoryRecipients && len(args.MandatoryFor) == 0 {
+		return errors.New("missing mandatory recipients for privacy flag MandatoryRecipients")
+	}
+	return nil
+}
+
+// Quorum
+// privateTxInfo returns the privacy details given to wallets implementing accounts.PrivateTxWallet,
+// nil if unknown
+func (args SendTxArgs) privateTxInfo() *accounts.PrivateTxInfo {
+	if args.PrivateFor == nil {
+		return nil
+	}
+	return &accounts.PrivateTxInfo{
+		PrivateFrom:         args.PrivateFrom,
+		PrivateFor:          args.PrivateFor,
+		PrivacyFlag:         args.PrivacyFlag,
+		MandatoryRecipients: args.MandatoryFor,
+	}
+}
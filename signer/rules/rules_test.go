@@ -27,6 +27,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/internal/ethapi"
+	"github.com/ethereum/go-ethereum/private/engine"
 	"github.com/ethereum/go-ethereum/signer/core"
 	"github.com/ethereum/go-ethereum/signer/storage"
 )
@@ -193,6 +194,46 @@ func TestSignTxRequest(t *testing.T) {
 	}
 }
 
+func TestSignTxRequest_whenPrivate(t *testing.T) {
+	// the privacy details are given by the caller, a rule can only rely on
+	// the transaction being private
+	js := `
+	function ApproveTx(r){
+		if(r.transaction.isPrivate || r.transaction.isPrivacyMarker){ return "Reject"}
+		return "Approve"
+	}`
+
+	r, err := initRuleEngine(js)
+	if err != nil {
+		t.Errorf("Couldn't create evaluator %v", err)
+		return
+	}
+	from, err := mixAddr("0000000000000000000000000000000000001337")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	for _, tc := range []struct {
+		transaction core.SendTxArgs
+		approved    bool
+	}{
+		{core.SendTxArgs{From: *from}, true},
+		{core.SendTxArgs{From: *from, IsPrivate: true, PrivateFor: []string{"ROAZBWtSacxXQrOe3FGAqJDyJjFePR5ce4TSIzmJ0Bc="}, PrivacyFlag: engine.PrivacyFlagStateValidation}, false},
+		{core.SendTxArgs{From: *from, IsPrivacyMarker: true, PrivateFor: []string{"ROAZBWtSacxXQrOe3FGAqJDyJjFePR5ce4TSIzmJ0Bc="}}, false},
+	} {
+		resp, err := r.ApproveTx(&core.SignTxRequest{
+			Transaction: tc.transaction,
+			Meta:        core.Metadata{Remote: "remoteip", Local: "localip", Scheme: "inproc"},
+		})
+		if err != nil {
+			t.Errorf("Unexpected error %v", err)
+		}
+		if resp.Approved != tc.approved {
+			t.Errorf("transaction %+v: expected approved %v, got %v", tc.transaction, tc.approved, resp.Approved)
+		}
+	}
+}
+
 type dummyUI struct {
 	calls []string
 }